@@ -0,0 +1,163 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo"
+	"github.com/topfreegames/khan/log"
+	"github.com/topfreegames/khan/models"
+	"github.com/uber-go/zap"
+)
+
+//CreateAPIKeyHandler is the handler responsible for issuing API keys
+func CreateAPIKeyHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "CreateAPIKey")
+		gameID := c.Param("gameID")
+
+		logger := app.Logger.With(
+			zap.String("source", "apiKeyHandler"),
+			zap.String("operation", "createAPIKey"),
+			zap.String("gameID", gameID),
+		)
+
+		var payload APIKeyPayload
+		if err := LoadJSONPayload(&payload, c, logger); err != nil {
+			log.E(logger, "Failed to parse json payload.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+			return FailWith(http.StatusBadRequest, err.Error(), c)
+		}
+
+		apiKey, key, err := createAPIKey(c.StdContext(), app, logger, gameID, &payload)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+
+		result := apiKey.Serialize()
+		result["key"] = key
+		return SucceedWith(result, c)
+	}
+}
+
+// createAPIKey issues an API key of the game with the scopes of the payload and returns it with its secret
+func createAPIKey(
+	ctx context.Context, app *App, logger zap.Logger, gameID string, payload *APIKeyPayload,
+) (*models.APIKey, string, error) {
+	start := time.Now()
+	log.D(logger, "Creating API key...")
+	apiKey, key, err := models.CreateAPIKey(app.Db(ctx), gameID, payload.Scopes)
+	if err != nil {
+		log.E(logger, "Failed to create the API key.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		if _, ok := err.(*models.InvalidAPIKeyScopeError); ok {
+			return nil, "", withStatus(http.StatusUnprocessableEntity, err)
+		}
+		return nil, "", err
+	}
+
+	log.I(logger, "Created API key successfully.", func(cm log.CM) {
+		cm.Write(
+			zap.String("apiKeyPublicID", apiKey.PublicID),
+			zap.Duration("duration", time.Now().Sub(start)),
+		)
+	})
+	return apiKey, key, nil
+}
+
+//ListAPIKeysHandler is the handler responsible for listing the API keys of a game
+func ListAPIKeysHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "ListAPIKeys")
+		gameID := c.Param("gameID")
+
+		logger := app.Logger.With(
+			zap.String("source", "apiKeyHandler"),
+			zap.String("operation", "listAPIKeys"),
+			zap.String("gameID", gameID),
+		)
+
+		apiKeys, err := listAPIKeys(c.StdContext(), app, logger, gameID)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+
+		serializedKeys := make([]map[string]interface{}, len(apiKeys))
+		for i, apiKey := range apiKeys {
+			serializedKeys[i] = apiKey.Serialize()
+		}
+
+		return SucceedWith(map[string]interface{}{
+			"apiKeys": serializedKeys,
+		}, c)
+	}
+}
+
+// listAPIKeys returns the API keys of the game
+func listAPIKeys(ctx context.Context, app *App, logger zap.Logger, gameID string) ([]*models.APIKey, error) {
+	start := time.Now()
+	log.D(logger, "Retrieving API keys...")
+	apiKeys, err := models.GetAPIKeys(app.Db(ctx), gameID)
+	if err != nil {
+		log.W(logger, "Failed to retrieve API keys.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, err
+	}
+
+	log.I(logger, "Retrieved API keys successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return apiKeys, nil
+}
+
+//RevokeAPIKeyHandler is the handler responsible for revoking API keys
+func RevokeAPIKeyHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "RevokeAPIKey")
+		gameID := c.Param("gameID")
+		publicID := c.Param("apiKeyPublicID")
+
+		logger := app.Logger.With(
+			zap.String("source", "apiKeyHandler"),
+			zap.String("operation", "revokeAPIKey"),
+			zap.String("gameID", gameID),
+			zap.String("apiKeyPublicID", publicID),
+		)
+
+		if err := revokeAPIKey(c.StdContext(), app, logger, gameID, publicID); err != nil {
+			return FailWithError(err, c)
+		}
+
+		return SucceedWith(map[string]interface{}{}, c)
+	}
+}
+
+// revokeAPIKey revokes the API key of the game
+func revokeAPIKey(ctx context.Context, app *App, logger zap.Logger, gameID, publicID string) error {
+	start := time.Now()
+	log.D(logger, "Revoking API key...")
+	apiKey, err := models.RevokeAPIKey(app.Db(ctx), gameID, publicID)
+	if err != nil {
+		log.W(logger, "Failed to revoke the API key.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return err
+	}
+	app.InvalidateAPIKey(apiKey)
+
+	log.I(logger, "Revoked API key successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return nil
+}
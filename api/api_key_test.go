@@ -0,0 +1,218 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/khan/api"
+	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+var _ = Describe("API Key API Handler", func() {
+	var db models.DB
+	var a *api.App
+	adminHeader := http.Header{api.AdminTokenHeader: []string{"admin-token"}}
+
+	BeforeEach(func() {
+		a = GetDefaultTestApp()
+		db = a.Db(nil)
+	})
+
+	Describe("Create API Key Handler", func() {
+		It("Should issue an API key", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			body, _ := json.Marshal(map[string]interface{}{"scopes": []string{"player-write"}})
+			route := GetGameRoute(game.PublicID, "/api-keys")
+			status, response, _ := doRequestWithHeader(a, "POST", route, string(body), adminHeader)
+
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(response), &result)
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["key"]).NotTo(BeEmpty())
+			Expect(result["scopes"]).To(Equal([]interface{}{"player-write"}))
+
+			apiKey, err := models.GetAPIKeyByKey(db, result["key"].(string))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apiKey.PublicID).To(Equal(result["publicID"]))
+		})
+
+		It("Should not issue an API key with invalid scopes", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			body, _ := json.Marshal(map[string]interface{}{"scopes": []string{"everything"}})
+			route := GetGameRoute(game.PublicID, "/api-keys")
+			status, _, _ := doRequestWithHeader(a, "POST", route, string(body), adminHeader)
+
+			Expect(status).To(Equal(http.StatusUnprocessableEntity))
+		})
+
+		It("Should not issue an API key without admin token", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			status, _ := PostJSON(a, GetGameRoute(game.PublicID, "/api-keys"), map[string]interface{}{
+				"scopes": []string{"game-admin"},
+			})
+
+			Expect(status).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	Describe("Revoke API Key Handler", func() {
+		It("Should revoke an API key", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+			apiKey, key, err := models.CreateAPIKey(db, game.PublicID, []string{"read-only"})
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(game.PublicID, fmt.Sprintf("/api-keys/%s", apiKey.PublicID))
+			status, _, _ := doRequestWithHeader(a, "DELETE", route, "", adminHeader)
+			Expect(status).To(Equal(http.StatusOK))
+
+			_, err = models.GetAPIKeyByKey(db, key)
+			Expect(err).To(BeAssignableToTypeOf(&models.ModelNotFoundError{}))
+
+			status, _, _ = doRequestWithHeader(a, "DELETE", route, "", adminHeader)
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("API Key Middleware", func() {
+		var keyApp *api.App
+		var game *models.Game
+
+		BeforeEach(func() {
+			keyApp = GetTestAppWithAPIKeys()
+			game = fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		keyHeader := func(key string) http.Header {
+			return http.Header{api.APIKeyHeader: []string{key}}
+		}
+
+		It("Should require an API key in game routes", func() {
+			status, _ := Get(keyApp, fmt.Sprintf("/games/%s", game.PublicID))
+			Expect(status).To(Equal(http.StatusUnauthorized))
+
+			status, _, _ = doRequestWithHeader(keyApp, "GET", fmt.Sprintf("/games/%s", game.PublicID), "", keyHeader("invalid"))
+			Expect(status).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("Should not require an API key in the healthcheck", func() {
+			status, _ := Get(keyApp, "/healthcheck")
+			Expect(status).To(Equal(http.StatusOK))
+		})
+
+		It("Should allow reads with any scope", func() {
+			_, key, err := models.CreateAPIKey(db, game.PublicID, []string{"player-write"})
+			Expect(err).NotTo(HaveOccurred())
+
+			status, _, _ := doRequestWithHeader(keyApp, "GET", fmt.Sprintf("/games/%s", game.PublicID), "", keyHeader(key))
+			Expect(status).To(Equal(http.StatusOK))
+		})
+
+		It("Should not allow writes without the route scope", func() {
+			_, key, err := models.CreateAPIKey(db, game.PublicID, []string{"read-only"})
+			Expect(err).NotTo(HaveOccurred())
+
+			body, _ := json.Marshal(map[string]interface{}{
+				"publicID": "player",
+				"name":     "player",
+				"metadata": map[string]interface{}{},
+			})
+			status, _, _ := doRequestWithHeader(keyApp, "POST", GetGameRoute(game.PublicID, "/players"), string(body), keyHeader(key))
+			Expect(status).To(Equal(http.StatusForbidden))
+		})
+
+		It("Should allow writes with the route scope", func() {
+			_, key, err := models.CreateAPIKey(db, game.PublicID, []string{"player-write"})
+			Expect(err).NotTo(HaveOccurred())
+
+			body, _ := json.Marshal(map[string]interface{}{
+				"publicID": "player",
+				"name":     "player",
+				"metadata": map[string]interface{}{},
+			})
+			status, _, _ := doRequestWithHeader(keyApp, "POST", GetGameRoute(game.PublicID, "/players"), string(body), keyHeader(key))
+			Expect(status).To(Equal(http.StatusOK))
+		})
+
+		It("Should only allow exporting players with the player-export scope", func() {
+			player := fixtures.PlayerFactory.MustCreateWithOption(map[string]interface{}{
+				"GameID": game.PublicID,
+			}).(*models.Player)
+			err := db.Insert(player)
+			Expect(err).NotTo(HaveOccurred())
+			route := GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s/export", player.PublicID))
+
+			_, key, err := models.CreateAPIKey(db, game.PublicID, []string{"read-only", "player-write"})
+			Expect(err).NotTo(HaveOccurred())
+			status, _, _ := doRequestWithHeader(keyApp, "GET", route, "", keyHeader(key))
+			Expect(status).To(Equal(http.StatusForbidden))
+
+			_, key, err = models.CreateAPIKey(db, game.PublicID, []string{"player-export"})
+			Expect(err).NotTo(HaveOccurred())
+			status, _, _ = doRequestWithHeader(keyApp, "GET", route, "", keyHeader(key))
+			Expect(status).To(Equal(http.StatusOK))
+		})
+
+		It("Should not allow API keys once they are revoked", func() {
+			apiKey, key, err := models.CreateAPIKey(db, game.PublicID, []string{"read-only"})
+			Expect(err).NotTo(HaveOccurred())
+			route := fmt.Sprintf("/games/%s", game.PublicID)
+			status, _, _ := doRequestWithHeader(keyApp, "GET", route, "", keyHeader(key))
+			Expect(status).To(Equal(http.StatusOK))
+
+			revokeRoute := GetGameRoute(game.PublicID, fmt.Sprintf("/api-keys/%s", apiKey.PublicID))
+			status, _, _ = doRequestWithHeader(keyApp, "DELETE", revokeRoute, "", adminHeader)
+			Expect(status).To(Equal(http.StatusOK))
+
+			status, _, _ = doRequestWithHeader(keyApp, "GET", route, "", keyHeader(key))
+			Expect(status).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("Should not allow API keys of other games", func() {
+			otherGame := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(otherGame)
+			Expect(err).NotTo(HaveOccurred())
+			_, key, err := models.CreateAPIKey(db, otherGame.PublicID, []string{"game-admin"})
+			Expect(err).NotTo(HaveOccurred())
+
+			status, _, _ := doRequestWithHeader(keyApp, "GET", fmt.Sprintf("/games/%s", game.PublicID), "", keyHeader(key))
+			Expect(status).To(Equal(http.StatusForbidden))
+		})
+
+		It("Should only allow admins to create games", func() {
+			_, key, err := models.CreateAPIKey(db, game.PublicID, []string{"game-admin"})
+			Expect(err).NotTo(HaveOccurred())
+
+			body, _ := json.Marshal(getGamePayload("", ""))
+			status, _, _ := doRequestWithHeader(keyApp, "POST", "/games", string(body), keyHeader(key))
+			Expect(status).To(Equal(http.StatusUnauthorized))
+
+			status, _, _ = doRequestWithHeader(keyApp, "POST", "/games", string(body), adminHeader)
+			Expect(status).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -9,15 +9,16 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
-	"os/signal"
 	"strconv"
 	"strings"
-	"syscall"
 	"time"
 
+	"github.com/garyburd/redigo/redis"
 	"github.com/jrallison/go-workers"
 	"github.com/labstack/echo"
 	"github.com/labstack/echo/engine"
@@ -41,35 +42,51 @@ import (
 	"github.com/topfreegames/khan/models"
 	"github.com/topfreegames/khan/mongo"
 	"github.com/topfreegames/khan/queues"
+	"github.com/topfreegames/khan/ratelimit"
+	"github.com/topfreegames/khan/util"
 	"github.com/uber-go/zap"
 	jaegercfg "github.com/uber/jaeger-client-go/config"
+	"google.golang.org/grpc"
 )
 
 // App is a struct that represents a Khan API Application
 type App struct {
-	ID                  string
-	Test                bool
-	Debug               bool
-	Port                int
-	Host                string
-	ConfigPath          string
-	Errors              metrics.EWMA
-	App                 *eecho.Echo
-	Engine              engine.Server
-	Config              *viper.Viper
-	Dispatcher          *Dispatcher
-	ESWorker            *models.ESWorker
-	MongoWorker         *models.MongoWorker
-	Logger              zap.Logger
-	ESClient            *es.Client
-	MongoDB             interfaces.MongoDB
-	ReadBufferSize      int
-	Fast                bool
-	DDStatsD            *extnethttpmiddleware.DogStatsD
-	EncryptionKey       []byte
-	getGameCache        *gocache.Cache
-	clansSummariesCache *caches.ClansSummaries
-	db                  gorp.Database
+	ID                   string
+	Test                 bool
+	Debug                bool
+	Port                 int
+	Host                 string
+	ConfigPath           string
+	Errors               metrics.EWMA
+	App                  *eecho.Echo
+	Engine               engine.Server
+	Config               *viper.Viper
+	Dispatcher           *Dispatcher
+	ESWorker             *models.ESWorker
+	MongoWorker          *models.MongoWorker
+	GameDeletionWorker   *models.GameDeletionWorker
+	PlayerBackfillWorker *models.PlayerBackfillWorker
+	Logger               zap.Logger
+	ESClient             *es.Client
+	MongoDB              interfaces.MongoDB
+	ReadBufferSize       int
+	Fast                 bool
+	DDStatsD             *extnethttpmiddleware.DogStatsD
+	Metrics              *PrometheusMetrics
+	EncryptionKey        []byte
+	getGameCache         caches.Cache
+	apiKeysCache         caches.Cache
+	clansSummariesCache  *caches.ClansSummaries
+	clanDetailsCache     *caches.Details
+	playerDetailsCache   *caches.Details
+	clanNamesCache       *caches.ClanNames
+	requestsGroup        caches.Group
+	cachesRedisPool      *redis.Pool
+	twoTierCaches        []*caches.TwoTier
+	rateLimitStore       ratelimit.Store
+	db                   gorp.Database
+	inFlight             int64
+	shuttingDown         int32
 }
 
 // GetApp returns a new Khan API Application
@@ -98,54 +115,197 @@ func (app *App) Configure() {
 	app.configureStatsD()
 	app.configureJaeger()
 	app.connectDatabase()
+	app.configurePrometheus()
 	app.configureApplication()
 	app.configureElasticsearch()
 	app.configureMongoDB()
 	app.initDispatcher()
 	app.initESWorker()
 	app.initMongoWorker()
+	app.initGameDeletionWorker()
+	app.initPlayerBackfillWorker()
 	app.configureGoWorkers()
 	app.configureCaches()
 }
 
 func (app *App) configureCaches() {
 	app.configureGetGameCache()
+	app.configureAPIKeysCache()
 	app.configureClansSummariesCache()
+	app.configureDetailsCaches()
+	app.configureClanNamesCache()
+	models.SetCacheInvalidator(app)
 }
 
-func (app *App) configureGetGameCache() {
+// newCache returns the cache configured under "caches.<name>", using the backend set in "caches.backend":
+// "memory" keeps entries in this process, "redis" shares them between processes and "twoTier" keeps a
+// local copy of the entries shared in Redis for at most "caches.twoTier.localTTL". Deleted entries cannot be
+// set again in Redis for "caches.twoTier.tombstoneTTL".
+func (app *App) newCache(name string) caches.Cache {
+	return app.newCacheWithTTL(name, time.Minute)
+}
+
+// newCacheWithTTL returns the cache configured under "caches.<name>", like newCache, whose entries expire
+// after defaultTTL unless "caches.<name>.ttl" is set
+func (app *App) newCacheWithTTL(name string, defaultTTL time.Duration) caches.Cache {
 	// TTL
-	ttlKey := "caches.getGame.ttl"
-	app.Config.SetDefault(ttlKey, time.Minute)
+	ttlKey := fmt.Sprintf("caches.%s.ttl", name)
+	app.Config.SetDefault(ttlKey, defaultTTL)
 	ttl := app.Config.GetDuration(ttlKey)
 	if ttl <= 0 {
-		ttl = time.Minute
+		ttl = defaultTTL
 	}
 
 	// cleanup
-	cleanupIntervalKey := "caches.getGame.cleanupInterval"
+	cleanupIntervalKey := fmt.Sprintf("caches.%s.cleanupInterval", name)
 	app.Config.SetDefault(cleanupIntervalKey, time.Minute)
 	cleanupInterval := app.Config.GetDuration(cleanupIntervalKey)
 
-	app.getGameCache = gocache.New(ttl, cleanupInterval)
+	backend := app.Config.GetString("caches.backend")
+	if backend != "redis" && backend != "twoTier" {
+		return caches.NewMemory(ttl, cleanupInterval)
+	}
+
+	if app.cachesRedisPool == nil {
+		app.cachesRedisPool = caches.NewRedisPool(app.Config)
+	}
+	prefix := fmt.Sprintf("%s%s:", app.Config.GetString("caches.redis.prefix"), name)
+	remote := caches.NewRedis(app.cachesRedisPool, prefix, ttl)
+	if backend == "redis" {
+		return remote
+	}
+
+	localTTL := app.Config.GetDuration("caches.twoTier.localTTL")
+	if localTTL <= 0 || localTTL > ttl {
+		localTTL = ttl
+	}
+	twoTier := caches.NewTwoTier(
+		caches.NewMemory(localTTL, cleanupInterval),
+		remote,
+		app.cachesRedisPool,
+		prefix+"invalidations",
+		app.Config.GetDuration("caches.twoTier.tombstoneTTL"),
+		app.Logger.With(zap.String("source", "app"), zap.String("cache", name)),
+	)
+	twoTier.Start()
+	app.twoTierCaches = append(app.twoTierCaches, twoTier)
+	return twoTier
+}
+
+// newRateLimitStore returns the store of the rate limit buckets set in "rateLimit.store": "memory" limits
+// requests to each process and "redis" limits requests to every process sharing the Redis instance.
+func (app *App) newRateLimitStore() ratelimit.Store {
+	if app.Config.GetString("rateLimit.store") != "redis" {
+		return ratelimit.NewMemory(app.Config.GetDuration("rateLimit.cleanupInterval"))
+	}
+
+	if app.cachesRedisPool == nil {
+		app.cachesRedisPool = caches.NewRedisPool(app.Config)
+	}
+	return ratelimit.NewRedis(app.cachesRedisPool, app.Config.GetString("rateLimit.redis.prefix"))
+}
+
+func (app *App) configureGetGameCache() {
+	app.getGameCache = app.newCache("getGame")
+}
+
+// configureAPIKeysCache configures the cache of verified API keys. Its TTL is short, since it bounds how
+// long revoked keys keep working in processes that do not share the cache
+func (app *App) configureAPIKeysCache() {
+	app.apiKeysCache = app.newCacheWithTTL("apiKeys", 10*time.Second)
+}
+
+// getCacheSoftTTL returns the age after which the entries of the cache configured under "caches.<name>"
+// are refreshed in background. Zero disables background refreshes.
+func (app *App) getCacheSoftTTL(name string) time.Duration {
+	softTTLKey := fmt.Sprintf("caches.%s.softTTL", name)
+	app.Config.SetDefault(softTTLKey, 0)
+	return app.Config.GetDuration(softTTLKey)
 }
 
 func (app *App) configureClansSummariesCache() {
+	app.clansSummariesCache = &caches.ClansSummaries{
+		Cache:   app.newCache("clansSummaries"),
+		SoftTTL: app.getCacheSoftTTL("clansSummaries"),
+		Logger:  app.Logger.With(zap.String("source", "clansSummariesCache")),
+	}
+	app.clansSummariesCache.Requests = app.Metrics.CacheRequests
+}
+
+func (app *App) configureDetailsCaches() {
+	app.clanDetailsCache = &caches.Details{
+		Cache:   app.newCache("clanDetails"),
+		Name:    "clanDetails",
+		SoftTTL: app.getCacheSoftTTL("clanDetails"),
+		Logger:  app.Logger.With(zap.String("source", "clanDetailsCache")),
+	}
+	app.playerDetailsCache = &caches.Details{
+		Cache:   app.newCache("playerDetails"),
+		Name:    "playerDetails",
+		SoftTTL: app.getCacheSoftTTL("playerDetails"),
+		Logger:  app.Logger.With(zap.String("source", "playerDetailsCache")),
+	}
+	app.clanDetailsCache.Requests = app.Metrics.CacheRequests
+	app.playerDetailsCache.Requests = app.Metrics.CacheRequests
+	if app.DDStatsD != nil {
+		app.clanDetailsCache.MetricsReporter = app.DDStatsD
+		app.playerDetailsCache.MetricsReporter = app.DDStatsD
+	}
+}
+
+// InvalidateGame removes a game from the caches after it is written
+func (app *App) InvalidateGame(gameID string) {
+	if err := app.getGameCache.Delete(gameID); err != nil {
+		log.E(app.Logger, "Game cache invalidation failed.", func(cm log.CM) {
+			cm.Write(zap.String("gameID", gameID), zap.Error(err))
+		})
+	}
+}
+
+// InvalidateClans removes clans from the caches after they are written
+func (app *App) InvalidateClans(gameID string, clanPublicIDs ...string) {
+	err := app.clansSummariesCache.Invalidate(gameID, clanPublicIDs...)
+	if err == nil {
+		err = app.clanDetailsCache.Invalidate(gameID, clanPublicIDs...)
+	}
+	if err != nil {
+		log.E(app.Logger, "Clans cache invalidation failed.", func(cm log.CM) {
+			cm.Write(zap.String("gameID", gameID), zap.Error(err))
+		})
+	}
+}
+
+// InvalidatePlayers removes players from the caches after they are written
+func (app *App) InvalidatePlayers(gameID string, playerPublicIDs ...string) {
+	if err := app.playerDetailsCache.Invalidate(gameID, playerPublicIDs...); err != nil {
+		log.E(app.Logger, "Players cache invalidation failed.", func(cm log.CM) {
+			cm.Write(zap.String("gameID", gameID), zap.Error(err))
+		})
+	}
+}
+
+func (app *App) configureClanNamesCache() {
 	// TTL
-	ttlKey := "caches.clansSummaries.ttl"
-	app.Config.SetDefault(ttlKey, time.Minute)
+	ttlKey := "caches.clanNames.ttl"
+	app.Config.SetDefault(ttlKey, 10*time.Minute)
 	ttl := app.Config.GetDuration(ttlKey)
 	if ttl <= 0 {
-		ttl = time.Minute
+		ttl = 10 * time.Minute
 	}
 
+	// refresh
+	refreshIntervalKey := "caches.clanNames.refreshInterval"
+	app.Config.SetDefault(refreshIntervalKey, 10*time.Second)
+	refreshInterval := app.Config.GetDuration(refreshIntervalKey)
+
 	// cleanup
-	cleanupIntervalKey := "caches.clansSummaries.cleanupInterval"
+	cleanupIntervalKey := "caches.clanNames.cleanupInterval"
 	app.Config.SetDefault(cleanupIntervalKey, time.Minute)
 	cleanupInterval := app.Config.GetDuration(cleanupIntervalKey)
 
-	app.clansSummariesCache = &caches.ClansSummaries{
-		Cache: gocache.New(ttl, cleanupInterval),
+	app.clanNamesCache = &caches.ClanNames{
+		Cache:           gocache.New(ttl, cleanupInterval),
+		RefreshInterval: refreshInterval,
 	}
 }
 
@@ -227,7 +387,9 @@ func (app *App) setConfigurationDefaults() {
 		zap.String("operation", "setConfigurationDefaults"),
 	)
 	app.Config.SetDefault("graceperiod.ms", 5000)
+	app.Config.SetDefault("graceperiod.readinessDelay", 0)
 	app.Config.SetDefault("healthcheck.workingText", "WORKING")
+	app.Config.SetDefault("healthcheck.timeout", time.Second)
 	app.Config.SetDefault("postgres.host", "localhost")
 	app.Config.SetDefault("postgres.user", "khan")
 	app.Config.SetDefault("postgres.dbName", "khan")
@@ -236,6 +398,7 @@ func (app *App) setConfigurationDefaults() {
 	app.Config.SetDefault("webhooks.timeout", 500)
 	app.Config.SetDefault("webhooks.maxIdleConnsPerHost", http.DefaultMaxIdleConnsPerHost)
 	app.Config.SetDefault("webhooks.maxIdleConns", 100)
+	app.Config.SetDefault("webhooks.metricsPort", 8890)
 	app.Config.SetDefault("elasticsearch.host", "localhost")
 	app.Config.SetDefault("elasticsearch.port", 9234)
 	app.Config.SetDefault("elasticsearch.sniff", true)
@@ -244,7 +407,49 @@ func (app *App) setConfigurationDefaults() {
 	app.Config.SetDefault("khan.maxPendingInvites", -1)
 	app.Config.SetDefault("khan.defaultCooldownBeforeInvite", -1)
 	app.Config.SetDefault("khan.defaultCooldownBeforeApply", -1)
+	app.Config.SetDefault("khan.listGames.defaultLimit", 100)
+	app.Config.SetDefault("khan.listGames.maxLimit", 1000)
+	app.Config.SetDefault("khan.gameVersions.retention", 50)
+	app.Config.SetDefault("players.bulk.maxPlayers", 1000)
+	app.Config.SetDefault("players.bulk.batchSize", 500)
+	app.Config.SetDefault("admin.token", "")
+	app.Config.SetDefault("apiKeys.enabled", false)
+	app.Config.SetDefault("playerTokens.enabled", false)
+	app.Config.SetDefault("playerTokens.maxTTL", time.Hour)
+	app.Config.SetDefault("idempotency.window", 24*time.Hour)
+	app.Config.SetDefault("idempotency.inProgressTTL", time.Minute)
+	app.Config.SetDefault("grpc.enabled", false)
+	app.Config.SetDefault("grpc.port", 8889)
+	app.Config.SetDefault("rateLimit.enabled", false)
+	app.Config.SetDefault("rateLimit.store", "memory")
+	app.Config.SetDefault("rateLimit.failOpen", true)
+	app.Config.SetDefault("rateLimit.cleanupInterval", time.Minute)
+	app.Config.SetDefault("rateLimit.redis.prefix", "khan:ratelimit:")
+	app.Config.SetDefault("rateLimit.groups.search.rate", 2)
+	app.Config.SetDefault("rateLimit.groups.search.burst", 10)
+	app.Config.SetDefault("rateLimit.groups.memberships.rate", 1)
+	app.Config.SetDefault("rateLimit.groups.memberships.burst", 10)
+	app.Config.SetDefault("rateLimit.groups.read.rate", 10)
+	app.Config.SetDefault("rateLimit.groups.read.burst", 50)
+	app.Config.SetDefault("rateLimit.groups.write.rate", 5)
+	app.Config.SetDefault("rateLimit.groups.write.burst", 20)
 	app.Config.SetDefault("security.encryptionKey", "")
+	app.Config.SetDefault("security.encryptionKeyFile", "")
+	app.Config.SetDefault("security.keyProvider", "")
+	app.Config.SetDefault("security.kms.timeout", time.Second)
+	app.Config.SetDefault("security.kms.cacheTTL", 5*time.Minute)
+	app.Config.SetDefault("search.defaults.minPrefixLength", models.DefaultSearchMinPrefixLength)
+	app.Config.SetDefault("search.defaults.accentSensitive", false)
+	app.Config.SetDefault("search.defaults.caseSensitive", false)
+	app.Config.SetDefault("search.defaults.cjkNGramSize", 0)
+	app.Config.SetDefault("search.defaults.nameWeight", mongo.DefaultClanNameWeight)
+	app.Config.SetDefault("search.defaults.namePrefixesWeight", mongo.DefaultClanNamePrefixesWeight)
+	app.Config.SetDefault("caches.backend", "memory")
+	app.Config.SetDefault("caches.redis.prefix", "khan:caches:")
+	app.Config.SetDefault("caches.twoTier.localTTL", 5*time.Second)
+	app.Config.SetDefault("caches.twoTier.tombstoneTTL", 5*time.Second)
+	app.Config.SetDefault("search.fuzzy.maxDistance", 2)
+	app.Config.SetDefault("search.suggest.pageSize", 10)
 
 	app.setHandlersConfigurationDefaults()
 
@@ -280,7 +485,47 @@ func (app *App) loadConfiguration() {
 		log.P(logger, "Config file failed to load.")
 	}
 
-	app.EncryptionKey = []byte(app.Config.GetString("security.encryptionKey"))
+	app.configureEncryption(logger)
+}
+
+func (app *App) configureEncryption(logger zap.Logger) {
+	keyring, err := util.NewKeyringFromConfig(app.Config)
+	if err != nil {
+		log.P(logger, "Could not load encryption keys.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+	keyProvider, err := util.NewKeyProviderFromConfig(app.Config)
+	if err != nil {
+		log.P(logger, "Could not configure key provider.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+
+	util.SetKeyProvider(keyProvider)
+	app.EncryptionKey = keyring.CurrentKey()
+	models.SetEncryptionKeyring(keyring)
+
+	// names are hashed with a key of their own, since hashes must not change when encryption keys rotate
+	nameHashKey := []byte(app.Config.GetString("security.playerNameHashKey"))
+	if len(nameHashKey) == 0 {
+		log.P(logger, "Could not load player name hash key, security.playerNameHashKey is required.")
+	}
+	models.SetPlayerNameHashKey(nameHashKey)
+}
+
+// validatePlayerTokenSettings fails startup if a game stores player token settings that cannot verify tokens
+func (app *App) validatePlayerTokenSettings() {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "validatePlayerTokenSettings"),
+	)
+
+	if err := models.ValidateGamesPlayerTokenSettings(app.Db(nil)); err != nil {
+		log.P(logger, "Could not validate player token settings.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
 }
 
 func (app *App) connectDatabase() {
@@ -332,45 +577,93 @@ func (app *App) onErrorHandler(err error, stack []byte) {
 	})
 }
 
-func (app *App) configureApplication() {
-	app.Engine = standard.New(fmt.Sprintf("%s:%d", app.Host, app.Port))
+// newEngine returns the HTTP server of the app, serving the connections of listener if it is not nil
+func (app *App) newEngine(listener net.Listener) engine.Server {
+	config := engine.Config{
+		Address:  fmt.Sprintf("%s:%d", app.Host, app.Port),
+		Listener: listener,
+	}
 	if app.Fast {
-		engine := fasthttp.New(fmt.Sprintf("%s:%d", app.Host, app.Port))
-		engine.ReadBufferSize = app.ReadBufferSize
-		app.Engine = engine
+		server := fasthttp.WithConfig(config)
+		server.ReadBufferSize = app.ReadBufferSize
+		return server
 	}
+	return standard.WithConfig(config)
+}
+
+func (app *App) configureApplication() {
+	app.Engine = app.newEngine(nil)
 	app.App = eecho.New()
 	a := app.App
 
 	_, w, _ := os.Pipe()
 	a.SetLogOutput(w)
 
+	playerTokensEnabled := app.Config.GetBool("playerTokens.enabled")
+	if playerTokensEnabled {
+		app.validatePlayerTokenSettings()
+	}
 	basicAuthUser := app.Config.GetString("basicauth.username")
+	a.Use(NewInFlightMiddleware(&app.inFlight).Serve)
+	// outside the recovery, so that requests that panic are observed with the status they are answered with
+	a.Use(NewPrometheusMiddleware(app.Metrics).Serve)
+	a.Use(NewRecoveryMiddleware(app.onErrorHandler).Serve)
+	a.Use(extechomiddleware.NewResponseTimeMetricsMiddleware(app.DDStatsD).Serve)
+	a.Use(NewVersionMiddleware().Serve)
+	a.Use(NewDeprecationMiddleware().Serve)
+	a.Use(NewLoggerMiddleware(app.Logger).Serve)
+	a.Use(NewBodyExtractionMiddleware().Serve)
+	if playerTokensEnabled {
+		a.Use(NewPlayerTokenMiddleware(app).Serve)
+	}
 	if basicAuthUser != "" {
 		basicAuthPass := app.Config.GetString("basicauth.password")
 
 		a.Use(middleware.BasicAuthWithConfig(middleware.BasicAuthConfig{
 			Skipper: func(c echo.Context) bool {
-				return c.Path() == "/healthcheck"
+				// the player token middleware already verified the token of the request
+				if c.Get(playerTokenSubjectKey) != nil {
+					return true
+				}
+				// probes and Prometheus scrapes do not send credentials
+				return strings.HasPrefix(c.Path(), "/healthcheck") || c.Path() == "/metrics"
 			},
 			Validator: func(username, password string) bool {
 				return username == basicAuthUser && password == basicAuthPass
 			},
 		}))
 	}
-
-	a.Use(NewRecoveryMiddleware(app.onErrorHandler).Serve)
-	a.Use(extechomiddleware.NewResponseTimeMetricsMiddleware(app.DDStatsD).Serve)
-	a.Use(NewVersionMiddleware().Serve)
-	a.Use(NewLoggerMiddleware(app.Logger).Serve)
-	a.Use(NewBodyExtractionMiddleware().Serve)
+	if app.Config.GetBool("apiKeys.enabled") {
+		a.Use(NewAPIKeyMiddleware(app).Serve)
+	}
+	if app.Config.GetBool("rateLimit.enabled") {
+		app.rateLimitStore = app.newRateLimitStore()
+		a.Use(NewRateLimitMiddleware(app, app.rateLimitStore).Serve)
+	}
+	a.Use(NewGameDeletionMiddleware(app).Serve)
+	a.Use(NewIdempotencyMiddleware(app).Serve)
+	admin := NewAdminMiddleware(app.Config.GetString("admin.token")).Serve
 
 	a.Get("/healthcheck", HealthCheckHandler(app))
+	a.Get("/healthcheck/live", LivenessHandler(app))
+	a.Get("/healthcheck/ready", ReadinessHandler(app))
 	a.Get("/status", StatusHandler(app))
+	a.Get("/metrics", MetricsHandler(app))
+	a.Get("/openapi.json", OpenAPIHandler(app))
 
 	// Game Routes
+	a.Get("/games", ListGamesHandler(app))
 	a.Post("/games", CreateGameHandler(app))
+	a.Get("/games/:gameID", RetrieveGameHandler(app))
 	a.Put("/games/:gameID", UpdateGameHandler(app))
+	a.Delete("/games/:gameID", DeleteGameHandler(app), admin)
+	a.Get("/games/:gameID/versions", ListGameVersionsHandler(app))
+	a.Post("/games/:gameID/versions/:version/rollback", RollbackGameHandler(app))
+
+	// API Key Routes
+	a.Get("/games/:gameID/api-keys", ListAPIKeysHandler(app), admin)
+	a.Post("/games/:gameID/api-keys", CreateAPIKeyHandler(app), admin)
+	a.Delete("/games/:gameID/api-keys/:apiKeyPublicID", RevokeAPIKeyHandler(app), admin)
 
 	// Hook Routes
 	a.Post("/games/:gameID/hooks", CreateHookHandler(app))
@@ -378,11 +671,17 @@ func (app *App) configureApplication() {
 
 	// Player Routes
 	a.Post("/games/:gameID/players", CreatePlayerHandler(app))
+	a.Put("/games/:gameID/players", UpsertPlayersHandler(app))
 	a.Put("/games/:gameID/players/:playerPublicID", UpdatePlayerHandler(app))
+	a.Patch("/games/:gameID/players/:playerPublicID", PatchPlayerHandler(app))
 	a.Get("/games/:gameID/players/:playerPublicID", RetrievePlayerHandler(app))
+	a.Delete("/games/:gameID/players/:playerPublicID", DeletePlayerHandler(app))
+	a.Get("/games/:gameID/players/:playerPublicID/export", ExportPlayerHandler(app))
+	a.Get("/games/:gameID/players/:playerPublicID/name-history", RetrievePlayerNameHistoryHandler(app))
 
 	// Clan Routes
 	a.Get("/games/:gameID/clans/search", SearchClansHandler(app))
+	a.Get("/games/:gameID/clans/suggest", SuggestClansHandler(app))
 	a.Get("/games/:gameID/clans", ListClansHandler(app))
 	a.Post("/games/:gameID/clans", CreateClanHandler(app))
 	a.Get("/games/:gameID/clans-summary", RetrieveClansSummariesHandler(app))
@@ -390,6 +689,7 @@ func (app *App) configureApplication() {
 	a.Get("/games/:gameID/clans/:clanPublicID/members", RetrieveClanMembersHandler(app))
 	a.Get("/games/:gameID/clans/:clanPublicID/summary", RetrieveClanSummaryHandler(app))
 	a.Put("/games/:gameID/clans/:clanPublicID", UpdateClanHandler(app))
+	a.Patch("/games/:gameID/clans/:clanPublicID", PatchClanHandler(app))
 	a.Post("/games/:gameID/clans/:clanPublicID/leave", LeaveClanHandler(app))
 	a.Post("/games/:gameID/clans/:clanPublicID/transfer-ownership", TransferOwnershipHandler(app))
 
@@ -402,6 +702,36 @@ func (app *App) configureApplication() {
 	a.Post("/games/:gameID/clans/:clanPublicID/memberships/promote", PromoteOrDemoteMembershipHandler(app, "promote"))
 	a.Post("/games/:gameID/clans/:clanPublicID/memberships/demote", PromoteOrDemoteMembershipHandler(app, "demote"))
 
+	// Version 2 Routes
+	v2 := a.Group(V2Prefix)
+	app.addV2Route(v2, echo.POST, "/games/:gameID/players", CreatePlayerHandler(app))
+	app.addV2Route(v2, echo.PUT, "/games/:gameID/players", UpsertPlayersHandler(app))
+	app.addV2Route(v2, echo.PUT, "/games/:gameID/players/:playerPublicID", UpdatePlayerHandler(app))
+	app.addV2Route(v2, echo.PATCH, "/games/:gameID/players/:playerPublicID", PatchPlayerHandler(app))
+	app.addV2Route(v2, echo.GET, "/games/:gameID/players/:playerPublicID", RetrievePlayerHandler(app))
+	app.addV2Route(v2, echo.DELETE, "/games/:gameID/players/:playerPublicID", DeletePlayerHandler(app))
+	app.addV2Route(v2, echo.GET, "/games/:gameID/players/:playerPublicID/export", ExportPlayerHandler(app))
+	app.addV2Route(v2, echo.GET, "/games/:gameID/players/:playerPublicID/name-history", RetrievePlayerNameHistoryHandler(app))
+	app.addV2Route(v2, echo.GET, "/games/:gameID/clans/search", SearchClansHandler(app))
+	app.addV2Route(v2, echo.GET, "/games/:gameID/clans/suggest", SuggestClansHandler(app))
+	app.addV2Route(v2, echo.GET, "/games/:gameID/clans", ListClansHandler(app))
+	app.addV2Route(v2, echo.POST, "/games/:gameID/clans", CreateClanHandler(app))
+	app.addV2Route(v2, echo.GET, "/games/:gameID/clans-summary", RetrieveClansSummariesHandler(app))
+	app.addV2Route(v2, echo.GET, "/games/:gameID/clans/:clanPublicID", RetrieveClanHandler(app))
+	app.addV2Route(v2, echo.GET, "/games/:gameID/clans/:clanPublicID/members", RetrieveClanMembersHandler(app))
+	app.addV2Route(v2, echo.GET, "/games/:gameID/clans/:clanPublicID/summary", RetrieveClanSummaryHandler(app))
+	app.addV2Route(v2, echo.PUT, "/games/:gameID/clans/:clanPublicID", UpdateClanHandler(app))
+	app.addV2Route(v2, echo.PATCH, "/games/:gameID/clans/:clanPublicID", PatchClanHandler(app))
+	app.addV2Route(v2, echo.POST, "/games/:gameID/clans/:clanPublicID/leave", LeaveClanHandler(app))
+	app.addV2Route(v2, echo.POST, "/games/:gameID/clans/:clanPublicID/transfer-ownership", TransferOwnershipHandler(app))
+	app.addV2Route(v2, echo.POST, "/games/:gameID/clans/:clanPublicID/memberships/application", ApplyForMembershipHandler(app))
+	app.addV2Route(v2, echo.POST, "/games/:gameID/clans/:clanPublicID/memberships/application/:action", ApproveOrDenyMembershipApplicationHandler(app))
+	app.addV2Route(v2, echo.POST, "/games/:gameID/clans/:clanPublicID/memberships/invitation", InviteForMembershipHandler(app))
+	app.addV2Route(v2, echo.POST, "/games/:gameID/clans/:clanPublicID/memberships/invitation/:action", ApproveOrDenyMembershipInvitationHandler(app))
+	app.addV2Route(v2, echo.POST, "/games/:gameID/clans/:clanPublicID/memberships/delete", DeleteMembershipHandler(app))
+	app.addV2Route(v2, echo.POST, "/games/:gameID/clans/:clanPublicID/memberships/promote", PromoteOrDemoteMembershipHandler(app, "promote"))
+	app.addV2Route(v2, echo.POST, "/games/:gameID/clans/:clanPublicID/memberships/demote", PromoteOrDemoteMembershipHandler(app, "demote"))
+
 	app.Errors = metrics.NewEWMA15()
 
 	go func() {
@@ -457,10 +787,20 @@ func (app *App) GetGame(ctx context.Context, gameID string) (*models.Game, error
 	)
 
 	key := gameID
-	value, present := app.getGameCache.Get(key)
+	value, present, err := app.getGameCache.Get(key)
+	if err != nil {
+		log.E(logger, "Game cache retrieval failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
 	if present {
-		return value.(*models.Game), nil
+		var game models.Game
+		if err = json.Unmarshal(value, &game); err == nil {
+			app.Metrics.CacheRequests.WithLabelValues("getGame", "hit").Inc()
+			return &game, nil
+		}
 	}
+	app.Metrics.CacheRequests.WithLabelValues("getGame", "miss").Inc()
 
 	start := time.Now()
 	log.D(logger, "Retrieving game...")
@@ -476,10 +816,63 @@ func (app *App) GetGame(ctx context.Context, gameID string) (*models.Game, error
 	log.D(logger, "Game retrieved succesfully.", func(cm log.CM) {
 		cm.Write(zap.Duration("gameRetrievalDuration", time.Now().Sub(start)))
 	})
-	app.getGameCache.Set(key, game, gocache.DefaultExpiration)
+	if value, err = json.Marshal(game); err == nil {
+		err = app.getGameCache.Set(key, value)
+	}
+	if err != nil {
+		log.E(logger, "Game cache update failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
 	return game, nil
 }
 
+// GetAPIKey returns the API key that was issued as key, unless it was revoked. Keys are cached by their hash
+func (app *App) GetAPIKey(ctx context.Context, key string) (*models.APIKey, error) {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "GetAPIKey"),
+	)
+
+	keyHash := util.HashToken(key)
+	value, present, err := app.apiKeysCache.Get(keyHash)
+	if err != nil {
+		log.E(logger, "API key cache retrieval failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+	if present {
+		var apiKey models.APIKey
+		if err = json.Unmarshal(value, &apiKey); err == nil {
+			return &apiKey, nil
+		}
+	}
+
+	apiKey, err := models.GetAPIKeyByKey(app.Db(ctx), key)
+	if err != nil {
+		return nil, err
+	}
+
+	if value, err = json.Marshal(apiKey); err == nil {
+		err = app.apiKeysCache.Set(keyHash, value)
+	}
+	if err != nil {
+		log.E(logger, "API key cache update failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+	return apiKey, nil
+}
+
+// InvalidateAPIKey removes a revoked API key from the cache
+func (app *App) InvalidateAPIKey(apiKey *models.APIKey) {
+	if err := app.apiKeysCache.Delete(apiKey.KeyHash); err != nil {
+		log.E(app.Logger, "API key cache invalidation failed.", func(cm log.CM) {
+			cm.Write(zap.String("apiKeyPublicID", apiKey.PublicID), zap.Error(err))
+		})
+	}
+}
+
 func (app *App) configureGoWorkers() {
 	redisHost := app.Config.GetString("redis.host")
 	redisPort := app.Config.GetInt("redis.port")
@@ -522,13 +915,16 @@ func (app *App) configureGoWorkers() {
 	workers.Configure(opts)
 
 	workers.Middleware.Append(extworkermiddleware.NewResponseTimeMetricsMiddleware(app.DDStatsD))
+	workers.Middleware.Append(&prometheusJobMiddleware{metrics: app.Metrics})
 	workers.Process(queues.KhanQueue, app.Dispatcher.PerformDispatchHook, workerCount)
 	workers.Process(queues.KhanESQueue, app.ESWorker.PerformUpdateES, workerCount)
 	workers.Process(queues.KhanMongoQueue, app.MongoWorker.PerformUpdateMongo, workerCount)
+	workers.Process(queues.KhanGameDeletionQueue, app.GameDeletionWorker.PerformDeleteGame, 1)
+	workers.Process(queues.KhanPlayerBackfillQueue, app.PlayerBackfillWorker.PerformBackfill, 1)
 	logger.Info("Worker configured.")
 }
 
-//StartWorkers "starts" the dispatcher
+//StartWorkers "starts" the dispatcher, until the app receives a shutdown signal
 func (app *App) StartWorkers() {
 	logger := app.Logger.With(
 		zap.String("source", "app"),
@@ -540,7 +936,20 @@ func (app *App) StartWorkers() {
 		jobsStatsPort := app.Config.GetInt("webhooks.statsPort")
 		go workers.StatsServer(jobsStatsPort)
 	}
-	workers.Run()
+	if metricsPort := app.Config.GetInt("webhooks.metricsPort"); metricsPort != 0 {
+		go app.serveWorkerMetrics(metricsPort)
+	}
+	workers.Start()
+
+	s := waitForShutdownSignal()
+	log.I(logger, "stopping workers", func(cm log.CM) {
+		cm.Write(
+			zap.String("signal", fmt.Sprintf("%v", s)),
+			zap.Duration("graceperiod", app.gracePeriod()),
+		)
+	})
+	app.stopWorkers()
+	log.I(logger, "workers stopped")
 }
 
 func (app *App) initESWorker() {
@@ -550,7 +959,7 @@ func (app *App) initESWorker() {
 	)
 
 	log.D(logger, "Initializing es worker...")
-	esWorker := models.NewESWorker(app.Logger)
+	esWorker := models.NewESWorker(app.Logger, app.db)
 	log.I(logger, "ES Worker initialized successfully")
 	app.ESWorker = esWorker
 }
@@ -562,11 +971,35 @@ func (app *App) initMongoWorker() {
 	)
 
 	log.D(logger, "Initializing mongo worker...")
-	mongoWorker := models.NewMongoWorker(app.Logger, app.Config)
+	mongoWorker := models.NewMongoWorker(app.Logger, app.Config, app.db)
 	log.I(logger, "Mongo Worker initialized successfully")
 	app.MongoWorker = mongoWorker
 }
 
+func (app *App) initGameDeletionWorker() {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "initGameDeletionWorker"),
+	)
+
+	log.D(logger, "Initializing game deletion worker...")
+	gameDeletionWorker := models.NewGameDeletionWorker(app.Logger, app.db)
+	log.I(logger, "Game Deletion Worker initialized successfully")
+	app.GameDeletionWorker = gameDeletionWorker
+}
+
+func (app *App) initPlayerBackfillWorker() {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "initPlayerBackfillWorker"),
+	)
+
+	log.D(logger, "Initializing player backfill worker...")
+	playerBackfillWorker := models.NewPlayerBackfillWorker(app.Logger, app.db, app.EncryptionKey)
+	log.I(logger, "Player Backfill Worker initialized successfully")
+	app.PlayerBackfillWorker = playerBackfillWorker
+}
+
 func (app *App) initDispatcher() {
 	logger := app.Logger.With(
 		zap.String("source", "app"),
@@ -614,6 +1047,30 @@ func (app *App) finalizeApp() {
 	log.D(logger, "Closing DB connection...")
 	app.db.Close()
 	log.I(logger, "DB connection closed succesfully.")
+
+	log.D(logger, "Closing Redis connections...")
+	for _, twoTier := range app.twoTierCaches {
+		twoTier.Stop()
+	}
+	if app.cachesRedisPool != nil {
+		app.cachesRedisPool.Close()
+	}
+	if workers.Config != nil {
+		workers.Config.Pool.Close()
+	}
+	log.I(logger, "Redis connections closed succesfully.")
+
+	if app.MongoDB != nil {
+		log.D(logger, "Closing MongoDB connection...")
+		app.MongoDB.Close()
+		log.I(logger, "MongoDB connection closed succesfully.")
+	}
+
+	if app.ESClient != nil {
+		log.D(logger, "Stopping elasticsearch client...")
+		app.ESClient.Client.Stop()
+		log.I(logger, "Elasticsearch client stopped succesfully.")
+	}
 }
 
 //BeginTrans in the current Db connection
@@ -631,8 +1088,8 @@ func (app *App) BeginTrans(ctx context.Context, logger zap.Logger) (gorp.Transac
 }
 
 //Rollback transaction
-func (app *App) Rollback(tx gorp.Transaction, msg string, c echo.Context, logger zap.Logger, err error) error {
-	txErr := tx.Rollback()
+func (app *App) Rollback(tx gorp.Transaction, msg string, logger zap.Logger, err error) error {
+	txErr := models.Rollback(tx)
 	if txErr != nil {
 		log.E(logger, fmt.Sprintf("%s and failed to rollback transaction.", msg), func(cm log.CM) {
 			cm.Write(zap.Error(txErr), zap.String("originalError", err.Error()))
@@ -643,8 +1100,8 @@ func (app *App) Rollback(tx gorp.Transaction, msg string, c echo.Context, logger
 }
 
 //Commit transaction
-func (app *App) Commit(tx gorp.Transaction, msg string, c echo.Context, logger zap.Logger) error {
-	txErr := tx.Commit()
+func (app *App) Commit(tx gorp.Transaction, msg string, logger zap.Logger) error {
+	txErr := models.Commit(tx)
 	if txErr != nil {
 		log.E(logger, fmt.Sprintf("%s failed to commit transaction.", msg), func(cm log.CM) {
 			cm.Write(zap.Error(txErr))
@@ -672,14 +1129,20 @@ func (app *App) Db(ctx context.Context) gorp.Database {
 	return app.db.WithContext(ctx).(gorp.Database)
 }
 
-// Start starts listening for web requests at specified host and port
+// Start starts listening for web requests at specified host and port, until the app receives a shutdown signal
 func (app *App) Start() {
 	logger := app.Logger.With(
 		zap.String("source", "app"),
 		zap.String("operation", "Start"),
 	)
 
-	defer app.finalizeApp()
+	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", app.Host, app.Port))
+	if err != nil {
+		log.P(logger, "App failed to start.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+	app.Engine = app.newEngine(listener)
 	log.I(logger, "app started", func(cm log.CM) {
 		cm.Write(zap.String("host", app.Host), zap.Int("port", app.Port))
 	})
@@ -688,18 +1151,26 @@ func (app *App) Start() {
 		app.App.Run(app.Engine)
 	}()
 
-	sg := make(chan os.Signal)
-	signal.Notify(sg, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGKILL, syscall.SIGTERM)
-
-	// stop server
-	select {
-	case s := <-sg:
-		graceperiod := app.Config.GetInt("graceperiod.ms")
-		log.I(logger, "shutting down", func(cm log.CM) {
-			cm.Write(zap.String("signal", fmt.Sprintf("%v", s)),
-				zap.Int("graceperiod", graceperiod))
+	var grpcServer *grpc.Server
+	if app.Config.GetBool("grpc.enabled") {
+		grpcServer, err = app.startGRPC()
+		if err != nil {
+			log.P(logger, "gRPC server failed to start.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+		}
+		log.I(logger, "gRPC server started", func(cm log.CM) {
+			cm.Write(zap.Int("port", app.Config.GetInt("grpc.port")))
 		})
-		time.Sleep(time.Duration(graceperiod) * time.Millisecond)
 	}
+
+	s := waitForShutdownSignal()
+	log.I(logger, "shutting down", func(cm log.CM) {
+		cm.Write(
+			zap.String("signal", fmt.Sprintf("%v", s)),
+			zap.Duration("graceperiod", app.gracePeriod()),
+		)
+	})
+	app.shutdown(listener, grpcServer)
 	log.I(logger, "app stopped")
 }
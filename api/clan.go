@@ -8,7 +8,10 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -20,7 +23,7 @@ import (
 	"github.com/uber-go/zap"
 )
 
-func logClanOwnerID(app *App, c echo.Context, gameID, clanPublicID, when, operation string) {
+func logClanOwnerID(ctx context.Context, app *App, gameID, clanPublicID, when, operation string) {
 	logger := app.Logger.With(
 		zap.String("source", "clanHandler"),
 		zap.String("operation", operation),
@@ -29,15 +32,7 @@ func logClanOwnerID(app *App, c echo.Context, gameID, clanPublicID, when, operat
 		zap.String("when", when),
 	)
 
-	db, err := app.GetCtxDB(c)
-	if err != nil {
-		log.E(logger, "Failed to fetch db when logging clan ownerID.", func(cm log.CM) {
-			cm.Write(zap.Error(err))
-		})
-		return
-	}
-
-	clan, err := models.GetClanByPublicID(db, gameID, clanPublicID)
+	clan, err := models.GetClanByPublicID(app.Db(ctx), gameID, clanPublicID)
 	if err != nil {
 		log.E(logger, "Failed to fetch clan when logging clan ownerID.", func(cm log.CM) {
 			cm.Write(zap.Error(err))
@@ -62,7 +57,6 @@ func logClanOwnerID(app *App, c echo.Context, gameID, clanPublicID, when, operat
 func CreateClanHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "CreateClan")
-		start := time.Now()
 		gameID := c.Param("gameID")
 
 		logger := app.Logger.With(
@@ -79,113 +73,124 @@ func CreateClanHandler(app *App) func(c echo.Context) error {
 			return FailWith(400, err.Error(), c)
 		}
 
-		game, err := app.GetGame(c.StdContext(), gameID)
+		clan, err := createClan(c.StdContext(), app, logger, gameID, &payload)
 		if err != nil {
-			log.W(logger, "Could not find game.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(404, err.Error(), c)
+			return FailWithError(err, c)
 		}
 
-		var clan *models.Clan
-		var tx interfaces.Transaction
+		return SucceedWithTyped(map[string]interface{}{
+			"publicID": clan.PublicID,
+		}, func() (EasyJSONMarshaler, error) {
+			return &PublicIDResponse{Success: true, PublicID: clan.PublicID}, nil
+		}, c)
+	}
+}
 
-		rollback := func(err error) error {
-			txErr := app.Rollback(tx, "Creating clan failed", c, logger, err)
-			if txErr != nil {
-				return txErr
-			}
+// createClan creates the clan of the payload in the game
+func createClan(
+	ctx context.Context, app *App, logger zap.Logger, gameID string, payload *CreateClanPayload,
+) (*models.Clan, error) {
+	start := time.Now()
+	game, err := app.GetGame(ctx, gameID)
+	if err != nil {
+		log.W(logger, "Could not find game.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, withStatus(404, err)
+	}
 
-			return nil
-		}
+	var clan *models.Clan
+	var tx interfaces.Transaction
 
-		tx, err = app.BeginTrans(c.StdContext(), logger)
-		if err != nil {
-			return FailWithError(err, c)
+	rollback := func(err error) error {
+		txErr := app.Rollback(tx, "Creating clan failed", logger, err)
+		if txErr != nil {
+			return txErr
 		}
 
-		log.D(logger, "DB Tx begun successful.")
+		return nil
+	}
 
-		log.D(logger, "Creating clan...")
-		clan, err = models.CreateClan(
-			tx,
-			app.EncryptionKey,
-			gameID,
-			payload.PublicID,
-			payload.Name,
-			payload.OwnerPublicID,
-			payload.Metadata,
-			payload.AllowApplication,
-			payload.AutoJoin,
-			game.MaxClansPerPlayer,
-		)
+	tx, err = app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
 
-		if err != nil {
-			txErr := rollback(err)
-			if txErr == nil {
-				log.E(logger, "Create clan failed.", func(cm log.CM) {
-					cm.Write(zap.Error(err))
-				})
-			}
-			return FailWithError(err, c)
-		}
+	log.D(logger, "DB Tx begun successful.")
+
+	log.D(logger, "Creating clan...")
+	clan, err = models.CreateClan(
+		tx,
+		app.EncryptionKey,
+		gameID,
+		payload.PublicID,
+		payload.Name,
+		payload.OwnerPublicID,
+		payload.Metadata,
+		payload.AllowApplication,
+		payload.AutoJoin,
+		game.MaxClansPerPlayer,
+	)
 
-		clanJSON := map[string]interface{}{
-			"publicID":         clan.PublicID,
-			"name":             clan.Name,
-			"membershipCount":  clan.MembershipCount,
-			"ownerPublicID":    payload.OwnerPublicID,
-			"metadata":         clan.Metadata,
-			"allowApplication": clan.AllowApplication,
-			"autoJoin":         clan.AutoJoin,
+	if err != nil {
+		txErr := rollback(err)
+		if txErr == nil {
+			log.E(logger, "Create clan failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
 		}
+		return nil, err
+	}
 
-		result := map[string]interface{}{
-			"gameID": gameID,
-			"clan":   clanJSON,
-		}
+	clanJSON := map[string]interface{}{
+		"publicID":         clan.PublicID,
+		"name":             clan.Name,
+		"membershipCount":  clan.MembershipCount,
+		"ownerPublicID":    payload.OwnerPublicID,
+		"metadata":         clan.Metadata,
+		"allowApplication": clan.AllowApplication,
+		"autoJoin":         clan.AutoJoin,
+	}
 
-		log.D(logger, "Dispatching hooks")
-		err = app.DispatchHooks(gameID, models.ClanCreatedHook, result)
-		if err != nil {
-			txErr := rollback(err)
-			if txErr == nil {
-				log.E(logger, "Clan created hook dispatch failed.", func(cm log.CM) {
-					cm.Write(zap.Error(err))
-				})
-			}
-			return FailWith(500, err.Error(), c)
-		}
-		log.D(logger, "Hook dispatched successfully.")
+	result := map[string]interface{}{
+		"gameID": gameID,
+		"clan":   clanJSON,
+	}
 
-		err = app.Commit(tx, "Clan created", c, logger)
-		if err != nil {
-			return FailWith(500, err.Error(), c)
+	log.D(logger, "Dispatching hooks")
+	err = app.DispatchHooks(gameID, models.ClanCreatedHook, result)
+	if err != nil {
+		txErr := rollback(err)
+		if txErr == nil {
+			log.E(logger, "Clan created hook dispatch failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
 		}
+		return nil, withStatus(500, err)
+	}
+	log.D(logger, "Hook dispatched successfully.")
 
-		log.D(logger, "Clan created successfully.", func(cm log.CM) {
-			cm.Write(
-				zap.String("clanPublicID", clan.PublicID),
-				zap.Duration("duration", time.Now().Sub(start)),
-			)
-		})
-
-		return SucceedWith(map[string]interface{}{
-			"publicID": clan.PublicID,
-		}, c)
+	err = app.Commit(tx, "Clan created", logger)
+	if err != nil {
+		return nil, withStatus(500, err)
 	}
+
+	log.D(logger, "Clan created successfully.", func(cm log.CM) {
+		cm.Write(
+			zap.String("clanPublicID", clan.PublicID),
+			zap.Duration("duration", time.Now().Sub(start)),
+		)
+	})
+	return clan, nil
 }
 
 // UpdateClanHandler is the handler responsible for updating existing clans
 func UpdateClanHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "UpdateClan")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		publicID := c.Param("clanPublicID")
 
-		db := app.Db(c.StdContext())
-
 		logger := app.Logger.With(
 			zap.String("source", "clanHandler"),
 			zap.String("operation", "updateClan"),
@@ -201,91 +206,250 @@ func UpdateClanHandler(app *App) func(c echo.Context) error {
 			return FailWith(400, err.Error(), c)
 		}
 
-		var clan, beforeUpdateClan *models.Clan
+		expectedVersion, err := getIfMatchVersion(c)
+		if err != nil {
+			return FailWith(400, err.Error(), c)
+		}
 
-		log.D(logger, "Retrieving game...")
-		game, err := models.GetGameByPublicID(db, gameID)
+		clan, err := updateClan(c.StdContext(), app, logger, gameID, publicID, &payload, expectedVersion)
 		if err != nil {
-			log.E(logger, "Updating clan failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
 			return FailWithError(err, c)
 		}
-		log.D(logger, "Game retrieved successfully")
 
-		log.D(logger, "Retrieving clan...")
-		beforeUpdateClan, err = models.GetClanByPublicID(db, gameID, publicID)
+		setETag(c, clan.Version)
+		return SucceedWithTyped(map[string]interface{}{}, successResponse, c)
+	}
+}
+
+// updateClan updates the clan with the payload. expectedVersion is the version the clan must be at, zero for
+// any version
+func updateClan(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, publicID string, payload *UpdateClanPayload, expectedVersion int64,
+) (*models.Clan, error) {
+	start := time.Now()
+	db := app.Db(ctx)
+
+	var clan, beforeUpdateClan *models.Clan
+
+	log.D(logger, "Retrieving game...")
+	game, err := models.GetGameByPublicID(db, gameID)
+	if err != nil {
+		log.E(logger, "Updating clan failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, err
+	}
+	log.D(logger, "Game retrieved successfully")
+
+	log.D(logger, "Retrieving clan...")
+	beforeUpdateClan, err = models.GetClanByPublicID(db, gameID, publicID)
+	if err != nil {
+		log.E(logger, "Updating clan failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, err
+	}
+	log.D(logger, "Clan retrieved successfully")
+
+	log.D(logger, "Updating clan...")
+	clan, err = models.UpdateClan(
+		db,
+		gameID,
+		publicID,
+		payload.Name,
+		payload.OwnerPublicID,
+		payload.Metadata,
+		payload.AllowApplication,
+		payload.AutoJoin,
+		expectedVersion,
+	)
+	if err != nil {
+		log.E(logger, "Updating clan failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, err
+	}
+
+	clanJSON := map[string]interface{}{
+		"publicID":         clan.PublicID,
+		"name":             clan.Name,
+		"membershipCount":  clan.MembershipCount,
+		"ownerPublicID":    payload.OwnerPublicID,
+		"metadata":         clan.Metadata,
+		"allowApplication": clan.AllowApplication,
+		"autoJoin":         clan.AutoJoin,
+	}
+
+	result := map[string]interface{}{
+		"gameID": gameID,
+		"clan":   clanJSON,
+	}
+
+	shouldDispatch := validateUpdateClanDispatch(game, beforeUpdateClan, clan, payload.Metadata, logger)
+	if shouldDispatch {
+		log.D(logger, "Dispatching clan update hooks...")
+		err = app.DispatchHooks(gameID, models.ClanUpdatedHook, result)
 		if err != nil {
-			log.E(logger, "Updating clan failed.", func(cm log.CM) {
+			log.E(logger, "Clan updated hook dispatch failed.", func(cm log.CM) {
 				cm.Write(zap.Error(err))
 			})
-			return FailWithError(err, c)
+			return nil, withStatus(500, err)
 		}
-		log.D(logger, "Clan retrieved successfully")
+	}
 
-		log.D(logger, "Updating clan...")
-		clan, err = models.UpdateClan(
-			db,
-			gameID,
-			publicID,
-			payload.Name,
-			payload.OwnerPublicID,
-			payload.Metadata,
-			payload.AllowApplication,
-			payload.AutoJoin,
+	log.D(logger, "Clan updated successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return clan, nil
+}
+
+// PatchClanHandler is the handler responsible for applying a JSON Merge Patch to a clan
+func PatchClanHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "PatchClan")
+		gameID := c.Param("gameID")
+		publicID := c.Param("clanPublicID")
+
+		logger := app.Logger.With(
+			zap.String("source", "clanHandler"),
+			zap.String("operation", "patchClan"),
+			zap.String("gameID", gameID),
+			zap.String("clanPublicID", publicID),
 		)
-		if err != nil {
-			log.E(logger, "Updating clan failed.", func(cm log.CM) {
+
+		var payload PatchClanPayload
+		if err := LoadJSONPayload(&payload, c, logger); err != nil {
+			log.E(logger, "Could not load payload.", func(cm log.CM) {
 				cm.Write(zap.Error(err))
 			})
-			return FailWithError(err, c)
+			return FailWith(400, err.Error(), c)
 		}
-
-		clanJSON := map[string]interface{}{
-			"publicID":         clan.PublicID,
-			"name":             clan.Name,
-			"membershipCount":  clan.MembershipCount,
-			"ownerPublicID":    payload.OwnerPublicID,
-			"metadata":         clan.Metadata,
-			"allowApplication": clan.AllowApplication,
-			"autoJoin":         clan.AutoJoin,
+		clearMetadata, err := isNullInPayload(c, "metadata")
+		if err != nil {
+			return FailWith(400, err.Error(), c)
 		}
 
-		result := map[string]interface{}{
-			"gameID": gameID,
-			"clan":   clanJSON,
+		expectedVersion, err := getIfMatchVersion(c)
+		if err != nil {
+			return FailWith(400, err.Error(), c)
 		}
 
-		shouldDispatch := validateUpdateClanDispatch(game, beforeUpdateClan, clan, payload.Metadata, logger)
-		if shouldDispatch {
-			log.D(logger, "Dispatching clan update hooks...")
-			err = app.DispatchHooks(gameID, models.ClanUpdatedHook, result)
-			if err != nil {
-				log.E(logger, "Clan updated hook dispatch failed.", func(cm log.CM) {
-					cm.Write(zap.Error(err))
-				})
-				return FailWith(500, err.Error(), c)
-			}
+		clan, clanJSON, err := patchClan(
+			c.StdContext(), app, logger, gameID, publicID, &payload, clearMetadata, expectedVersion,
+		)
+		if err != nil {
+			return FailWithError(err, c)
 		}
 
-		log.D(logger, "Clan updated successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+		setETag(c, clan.Version)
+		return SucceedWithTyped(map[string]interface{}{
+			"clan": clanJSON,
+		}, func() (EasyJSONMarshaler, error) {
+			return newPatchClanResponse(clanJSON)
+		}, c)
+	}
+}
+
+// patchClan applies the JSON Merge Patch of the payload to the clan, removing all its metadata if clearMetadata
+// is set, and returns the patched clan and its serialization. expectedVersion is the version the clan must be
+// at, zero for any version
+func patchClan(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, publicID string, payload *PatchClanPayload, clearMetadata bool, expectedVersion int64,
+) (*models.Clan, map[string]interface{}, error) {
+	start := time.Now()
+	log.D(logger, "Retrieving game...")
+	game, err := models.GetGameByPublicID(app.Db(ctx), gameID)
+	if err != nil {
+		log.E(logger, "Patching clan failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
-		return SucceedWith(map[string]interface{}{}, c)
+		return nil, nil, err
+	}
+	log.D(logger, "Game retrieved successfully")
+
+	tx, err := app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, nil, withStatus(500, err)
+	}
+	rollback := func(err error) error {
+		log.E(logger, "Patching clan failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		txErr := app.Rollback(tx, "Patching clan failed", logger, err)
+		if txErr != nil {
+			return withStatus(500, txErr)
+		}
+		return err
+	}
+
+	log.D(logger, "Retrieving clan...")
+	// the clan is locked so that the hooks compare the patch with the version it was applied to
+	beforeUpdateClan, err := models.GetClanByPublicIDForUpdate(tx, gameID, publicID)
+	if err != nil {
+		return nil, nil, rollback(err)
+	}
+	log.D(logger, "Clan retrieved successfully")
+
+	log.D(logger, "Patching clan...")
+	clan, err := models.PatchClan(tx, gameID, publicID, payload.OwnerPublicID, &models.ClanPatch{
+		Name:             payload.Name,
+		AllowApplication: payload.AllowApplication,
+		AutoJoin:         payload.AutoJoin,
+		Metadata:         payload.Metadata,
+		ClearMetadata:    clearMetadata,
+		ExpectedVersion:  expectedVersion,
+	})
+	if err != nil {
+		return nil, nil, rollback(err)
+	}
+
+	err = app.Commit(tx, "Clan patched", logger)
+	if err != nil {
+		return nil, nil, withStatus(500, err)
+	}
+
+	clanJSON := map[string]interface{}{
+		"publicID":         clan.PublicID,
+		"name":             clan.Name,
+		"membershipCount":  clan.MembershipCount,
+		"ownerPublicID":    payload.OwnerPublicID,
+		"metadata":         clan.Metadata,
+		"allowApplication": clan.AllowApplication,
+		"autoJoin":         clan.AutoJoin,
 	}
+
+	result := map[string]interface{}{
+		"gameID": gameID,
+		"clan":   clanJSON,
+	}
+
+	shouldDispatch := validateUpdateClanDispatch(game, beforeUpdateClan, clan, clan.Metadata, logger)
+	if shouldDispatch {
+		log.D(logger, "Dispatching clan update hooks...")
+		err = app.DispatchHooks(gameID, models.ClanUpdatedHook, result)
+		if err != nil {
+			log.E(logger, "Clan updated hook dispatch failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+			return nil, nil, withStatus(500, err)
+		}
+	}
+
+	log.D(logger, "Clan patched successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return clan, clanJSON, nil
 }
 
 // LeaveClanHandler is the handler responsible for changing the clan ownership when the owner leaves it
 func LeaveClanHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "LeaveClan")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		publicID := c.Param("clanPublicID")
 
-		logClanOwnerID(app, c, gameID, publicID, "before", "leaveClan")
-		defer logClanOwnerID(app, c, gameID, publicID, "after", "leaveClan")
-
 		logger := app.Logger.With(
 			zap.String("source", "clanHandler"),
 			zap.String("operation", "leaveClan"),
@@ -293,110 +457,121 @@ func LeaveClanHandler(app *App) func(c echo.Context) error {
 			zap.String("clanPublicID", publicID),
 		)
 
-		var tx interfaces.Transaction
-		var clan *models.Clan
-		var previousOwner, newOwner *models.Player
-		var err error
+		res, err := leaveClan(c.StdContext(), app, logger, gameID, publicID)
+		if err != nil {
+			return FailWithError(err, c)
+		}
 
-		rollback := func(err error) error {
-			txErr := app.Rollback(tx, "Leaving clan failed", c, logger, err)
-			if txErr != nil {
-				return txErr
-			}
+		return SucceedWithTyped(res, func() (EasyJSONMarshaler, error) {
+			return newLeaveClanResponse(res)
+		}, c)
+	}
+}
 
-			return nil
+// leaveClan makes the owner leave the clan, transferring its ownership or deleting it, and returns the
+// previous and new owners
+func leaveClan(ctx context.Context, app *App, logger zap.Logger, gameID, publicID string) (map[string]interface{}, error) {
+	start := time.Now()
+	logClanOwnerID(ctx, app, gameID, publicID, "before", "leaveClan")
+	defer logClanOwnerID(ctx, app, gameID, publicID, "after", "leaveClan")
+
+	var tx interfaces.Transaction
+	var clan *models.Clan
+	var previousOwner, newOwner *models.Player
+	var err error
+
+	rollback := func(err error) error {
+		txErr := app.Rollback(tx, "Leaving clan failed", logger, err)
+		if txErr != nil {
+			return txErr
 		}
 
-		tx, err = app.BeginTrans(c.StdContext(), logger)
-		if err != nil {
-			return FailWith(500, err.Error(), c)
-		}
-		log.D(logger, "DB Tx begun successful.")
+		return nil
+	}
 
-		log.D(logger, "Leaving clan...")
-		clan, previousOwner, newOwner, err = models.LeaveClan(
-			tx,
-			app.EncryptionKey,
-			gameID,
-			publicID,
-		)
-		if err != nil {
-			txErr := rollback(err)
-			if txErr == nil {
-				if strings.HasPrefix(err.Error(), "Clan was not found with id") {
-					log.W(logger, "Clan was not found.", func(cm log.CM) {
-						cm.Write(zap.Error(err))
-					})
-					notFoundError := &models.ModelNotFoundError{Type: "Clan", ID: publicID}
-					return FailWithError(notFoundError, c)
-				}
-				log.E(logger, "Clan leave failed.", func(cm log.CM) {
+	tx, err = app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, withStatus(500, err)
+	}
+	log.D(logger, "DB Tx begun successful.")
+
+	log.D(logger, "Leaving clan...")
+	clan, previousOwner, newOwner, err = models.LeaveClan(
+		tx,
+		app.EncryptionKey,
+		gameID,
+		publicID,
+	)
+	if err != nil {
+		txErr := rollback(err)
+		if txErr == nil {
+			if strings.HasPrefix(err.Error(), "Clan was not found with id") {
+				log.W(logger, "Clan was not found.", func(cm log.CM) {
 					cm.Write(zap.Error(err))
 				})
+				return nil, &models.ModelNotFoundError{Type: "Clan", ID: publicID}
 			}
-			return FailWith(500, err.Error(), c)
+			log.E(logger, "Clan leave failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
 		}
+		return nil, withStatus(500, err)
+	}
 
-		err = dispatchClanOwnershipChangeHook(app, models.ClanLeftHook, clan, previousOwner, newOwner)
-		if err != nil {
-			txErr := rollback(err)
-			if txErr == nil {
-				log.E(logger, "Leaving clan hook dispatch failed.", func(cm log.CM) {
-					cm.Write(zap.Error(err))
-				})
-			}
-			return FailWith(500, err.Error(), c)
+	err = dispatchClanOwnershipChangeHook(app, models.ClanLeftHook, clan, previousOwner, newOwner)
+	if err != nil {
+		txErr := rollback(err)
+		if txErr == nil {
+			log.E(logger, "Leaving clan hook dispatch failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
 		}
+		return nil, withStatus(500, err)
+	}
 
-		res := map[string]interface{}{}
-		fields := []zap.Field{}
-
-		previousOwnerJSON := previousOwner.Serialize(app.EncryptionKey)
-		delete(previousOwnerJSON, "gameID")
-
-		res["previousOwner"] = previousOwnerJSON
-		res["newOwner"] = nil
-		res["isDeleted"] = true
+	res := map[string]interface{}{}
+	fields := []zap.Field{}
 
-		if newOwner != nil {
-			newOwnerJSON := newOwner.Serialize(app.EncryptionKey)
-			delete(newOwnerJSON, "gameID")
-			res["newOwner"] = newOwnerJSON
-			res["isDeleted"] = false
-		}
+	previousOwnerJSON := previousOwner.Serialize(app.EncryptionKey)
+	delete(previousOwnerJSON, "gameID")
 
-		fields = append(fields, zap.String("clanPublicID", publicID))
-		fields = append(fields, zap.String("previousOwnerPublicID", previousOwner.PublicID))
-		fields = append(fields, zap.Duration("duration", time.Now().Sub(start)))
+	res["previousOwner"] = previousOwnerJSON
+	res["newOwner"] = nil
+	res["isDeleted"] = true
 
-		if newOwner != nil {
-			fields = append(fields, zap.String("newOwnerPublicID", newOwner.PublicID))
-		}
+	if newOwner != nil {
+		newOwnerJSON := newOwner.Serialize(app.EncryptionKey)
+		delete(newOwnerJSON, "gameID")
+		res["newOwner"] = newOwnerJSON
+		res["isDeleted"] = false
+	}
 
-		err = app.Commit(tx, "Left clan", c, logger)
-		if err != nil {
-			return FailWith(500, err.Error(), c)
-		}
+	fields = append(fields, zap.String("clanPublicID", publicID))
+	fields = append(fields, zap.String("previousOwnerPublicID", previousOwner.PublicID))
+	fields = append(fields, zap.Duration("duration", time.Now().Sub(start)))
 
-		log.I(logger, "Left clan successfully.", func(cm log.CM) {
-			cm.Write(fields...)
-		})
+	if newOwner != nil {
+		fields = append(fields, zap.String("newOwnerPublicID", newOwner.PublicID))
+	}
 
-		return SucceedWith(res, c)
+	err = app.Commit(tx, "Left clan", logger)
+	if err != nil {
+		return nil, withStatus(500, err)
 	}
+
+	log.I(logger, "Left clan successfully.", func(cm log.CM) {
+		cm.Write(fields...)
+	})
+	return res, nil
 }
 
 // TransferOwnershipHandler is the handler responsible for transferring the clan ownership to another clan member
 func TransferOwnershipHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "TransferClanOwnership")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		publicID := c.Param("clanPublicID")
 
-		logClanOwnerID(app, c, gameID, publicID, "before", "transferClanOwnership")
-		defer logClanOwnerID(app, c, gameID, publicID, "after", "transferClanOwnership")
-
 		logger := app.Logger.With(
 			zap.String("source", "clanHandler"),
 			zap.String("operation", "transferClanOwnership"),
@@ -408,99 +583,117 @@ func TransferOwnershipHandler(app *App) func(c echo.Context) error {
 			return FailWith(400, err.Error(), c)
 		}
 
-		logger = logger.With(
-			zap.String("newOwnerPublicID", payload.PlayerPublicID),
-		)
-
-		game, err := app.GetGame(c.StdContext(), gameID)
+		res, err := transferClanOwnership(c.StdContext(), app, logger, gameID, publicID, &payload)
 		if err != nil {
-			log.W(logger, "Could not find game.")
-			return FailWith(404, err.Error(), c)
+			return FailWithError(err, c)
 		}
 
-		var tx interfaces.Transaction
-		var clan *models.Clan
-		var previousOwner, newOwner *models.Player
+		return SucceedWithTyped(res, func() (EasyJSONMarshaler, error) {
+			return newTransferClanOwnershipResponse(res)
+		}, c)
+	}
+}
 
-		rb := func(err error) error {
-			txErr := app.Rollback(tx, "Clan ownership transfer failed", c, logger, err)
-			if txErr != nil {
-				return txErr
-			}
+// transferClanOwnership transfers the ownership of the clan to the member of the payload and returns the
+// previous and new owners
+func transferClanOwnership(
+	ctx context.Context, app *App, logger zap.Logger, gameID, publicID string, payload *TransferClanOwnershipPayload,
+) (map[string]interface{}, error) {
+	start := time.Now()
+	logClanOwnerID(ctx, app, gameID, publicID, "before", "transferClanOwnership")
+	defer logClanOwnerID(ctx, app, gameID, publicID, "after", "transferClanOwnership")
+
+	logger = logger.With(
+		zap.String("newOwnerPublicID", payload.PlayerPublicID),
+	)
 
-			return nil
-		}
+	game, err := app.GetGame(ctx, gameID)
+	if err != nil {
+		log.W(logger, "Could not find game.")
+		return nil, withStatus(404, err)
+	}
 
-		tx, err = app.BeginTrans(c.StdContext(), logger)
-		if err != nil {
-			return FailWith(500, err.Error(), c)
-		}
+	var tx interfaces.Transaction
+	var clan *models.Clan
+	var previousOwner, newOwner *models.Player
 
-		log.D(logger, "Transferring clan ownership...")
-		clan, previousOwner, newOwner, err = models.TransferClanOwnership(
-			tx,
-			app.EncryptionKey,
-			gameID,
-			publicID,
-			payload.PlayerPublicID,
-			game.MembershipLevels,
-			game.MaxMembershipLevel,
-		)
-		if err != nil {
-			txErr := rb(err)
-			if txErr == nil {
-				log.E(logger, "Clan ownership transfer failed.", func(cm log.CM) {
-					cm.Write(zap.Error(err))
-				})
-			}
-			return FailWith(500, err.Error(), c)
+	rb := func(err error) error {
+		txErr := app.Rollback(tx, "Clan ownership transfer failed", logger, err)
+		if txErr != nil {
+			return txErr
 		}
 
-		err = dispatchClanOwnershipChangeHook(
-			app, models.ClanOwnershipTransferredHook,
-			clan, previousOwner, newOwner,
-		)
+		return nil
+	}
 
-		if err != nil {
-			txErr := rb(err)
-			if txErr == nil {
-				log.E(logger, "Clan ownership transfer hook dispatch failed.", func(cm log.CM) {
-					cm.Write(zap.Error(err))
-				})
-			}
-			return FailWith(500, err.Error(), c)
-		}
+	tx, err = app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, withStatus(500, err)
+	}
 
-		pOwnerJSON := previousOwner.Serialize(app.EncryptionKey)
-		delete(pOwnerJSON, "gameID")
+	log.D(logger, "Transferring clan ownership...")
+	clan, previousOwner, newOwner, err = models.TransferClanOwnership(
+		tx,
+		app.EncryptionKey,
+		gameID,
+		publicID,
+		payload.PlayerPublicID,
+		game.MembershipLevels,
+		game.MaxMembershipLevel,
+	)
+	if err != nil {
+		txErr := rb(err)
+		if txErr == nil {
+			log.E(logger, "Clan ownership transfer failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+		}
+		return nil, withStatus(500, err)
+	}
 
-		nOwnerJSON := newOwner.Serialize(app.EncryptionKey)
-		delete(nOwnerJSON, "gameID")
+	err = dispatchClanOwnershipChangeHook(
+		app, models.ClanOwnershipTransferredHook,
+		clan, previousOwner, newOwner,
+	)
 
-		err = app.Commit(tx, "Clan ownership transfer", c, logger)
-		if err != nil {
-			return FailWith(500, err.Error(), c)
+	if err != nil {
+		txErr := rb(err)
+		if txErr == nil {
+			log.E(logger, "Clan ownership transfer hook dispatch failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
 		}
+		return nil, withStatus(500, err)
+	}
 
-		log.I(logger, "Clan ownership transfer completed successfully.", func(cm log.CM) {
-			cm.Write(
-				zap.String("previousOwnerPublicID", previousOwner.PublicID),
-				zap.String("newOwnerPublicID", newOwner.PublicID),
-				zap.Duration("duration", time.Now().Sub(start)),
-			)
-		})
-		return SucceedWith(map[string]interface{}{
-			"previousOwner": pOwnerJSON,
-			"newOwner":      nOwnerJSON,
-		}, c)
+	pOwnerJSON := previousOwner.Serialize(app.EncryptionKey)
+	delete(pOwnerJSON, "gameID")
+
+	nOwnerJSON := newOwner.Serialize(app.EncryptionKey)
+	delete(nOwnerJSON, "gameID")
+
+	err = app.Commit(tx, "Clan ownership transfer", logger)
+	if err != nil {
+		return nil, withStatus(500, err)
 	}
+
+	log.I(logger, "Clan ownership transfer completed successfully.", func(cm log.CM) {
+		cm.Write(
+			zap.String("previousOwnerPublicID", previousOwner.PublicID),
+			zap.String("newOwnerPublicID", newOwner.PublicID),
+			zap.Duration("duration", time.Now().Sub(start)),
+		)
+	})
+	return map[string]interface{}{
+		"previousOwner": pOwnerJSON,
+		"newOwner":      nOwnerJSON,
+	}, nil
 }
 
 // ListClansHandler is the handler responsible for returning a list of all clans
 func ListClansHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "ListClans")
-		start := time.Now()
 		gameID := c.Param("gameID")
 
 		logger := app.Logger.With(
@@ -509,49 +702,49 @@ func ListClansHandler(app *App) func(c echo.Context) error {
 			zap.String("gameID", gameID),
 		)
 
-		log.D(logger, "Getting DB connection...")
-		db, err := app.GetCtxDB(c)
+		serializedClans, err := listClans(c.StdContext(), app, logger, gameID)
 		if err != nil {
-			log.E(logger, "Failed to connect to DB.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(500, err.Error(), c)
+			return FailWithError(err, c)
 		}
-		log.D(logger, "DB Connection successful.")
 
-		log.D(logger, "Retrieving all clans...")
-		clans, err := models.GetAllClans(
-			db,
-			gameID,
-		)
-
-		if err != nil {
-			log.E(logger, "Retrieve all clans failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(500, err.Error(), c)
-		}
+		return SucceedWithTyped(map[string]interface{}{
+			"clans": serializedClans,
+		}, func() (EasyJSONMarshaler, error) {
+			return newClansResponse(serializedClans)
+		}, c)
+	}
+}
 
-		serializedClans := serializeClans(clans, true)
+// listClans returns all the clans of the game
+func listClans(ctx context.Context, app *App, logger zap.Logger, gameID string) ([]map[string]interface{}, error) {
+	start := time.Now()
+	log.D(logger, "Retrieving all clans...")
+	clans, err := models.GetAllClans(
+		app.Db(ctx),
+		gameID,
+	)
 
-		log.D(logger, "Retrieve all clans completed successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	if err != nil {
+		log.E(logger, "Retrieve all clans failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
-
-		return SucceedWith(map[string]interface{}{
-			"clans": serializedClans,
-		}, c)
+		return nil, withStatus(500, err)
 	}
+
+	serializedClans := serializeClans(clans, true)
+
+	log.D(logger, "Retrieve all clans completed successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return serializedClans, nil
 }
 
 // SearchClansHandler is the handler responsible for searching for clans
 func SearchClansHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "MongoSearchClans")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		term := c.QueryParam("term")
-		pageSize := app.Config.GetInt64("search.pageSize")
 
 		logger := app.Logger.With(
 			zap.String("source", "clanHandler"),
@@ -560,98 +753,201 @@ func SearchClansHandler(app *App) func(c echo.Context) error {
 			zap.String("term", term),
 		)
 
-		if term == "" {
-			log.W(logger, "Clan search failed due to empty term.")
-			return FailWith(400, (&models.EmptySearchTermError{}).Error(), c)
+		serializedClans, err := searchClans(c.StdContext(), app, logger, gameID, term)
+		if err != nil {
+			return FailWithError(err, c)
 		}
 
-		log.D(logger, "Getting DB connection...")
-		db, err := app.GetCtxDB(c)
+		return SucceedWithTyped(map[string]interface{}{
+			"clans": serializedClans,
+		}, func() (EasyJSONMarshaler, error) {
+			return newClansResponse(serializedClans)
+		}, c)
+	}
+}
+
+// searchClans returns the clans of the game whose names match term
+func searchClans(ctx context.Context, app *App, logger zap.Logger, gameID, term string) ([]map[string]interface{}, error) {
+	start := time.Now()
+	pageSize := app.Config.GetInt64("search.pageSize")
+
+	if term == "" {
+		log.W(logger, "Clan search failed due to empty term.")
+		return nil, withStatus(400, &models.EmptySearchTermError{})
+	}
+
+	if app.MongoDB == nil {
+		log.W(logger, "Clan search failed because MongoDB is not enabled.")
+		return nil, withStatus(http.StatusNotImplemented, errSearchUnavailable)
+	}
+
+	db := app.Db(ctx)
+
+	game, err := app.GetGame(ctx, gameID)
+	if err != nil {
+		log.W(logger, "Could not find game.")
+		return nil, withStatus(404, err)
+	}
+
+	log.D(logger, "Searching clans...")
+	clans, err := models.SearchClan(
+		db,
+		app.MongoDB.WithContext(ctx),
+		gameID,
+		term,
+		pageSize,
+		&game.SearchSettings,
+	)
+
+	if err != nil {
+		log.E(logger, "Clan search failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, withStatus(500, err)
+	}
+
+	if len(clans) == 0 {
+		log.D(logger, "No exact matches, fuzzy searching clans...")
+		clans, err = fuzzySearchClans(ctx, app, db, game, term, int(pageSize))
 		if err != nil {
-			log.E(logger, "Failed to connect to DB.", func(cm log.CM) {
+			log.E(logger, "Clan fuzzy search failed.", func(cm log.CM) {
 				cm.Write(zap.Error(err))
 			})
-			return FailWith(500, err.Error(), c)
+			return nil, withStatus(500, err)
 		}
-		log.D(logger, "DB Connection successful.")
+	}
 
-		log.D(logger, "Searching clans...")
-		clans, err := models.SearchClan(
-			db,
-			app.MongoDB.WithContext(c.StdContext()),
-			gameID,
-			term,
-			pageSize,
+	serializedClans := serializeClans(clans, true)
+
+	log.D(logger, "Clan search successful.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return serializedClans, nil
+}
+
+// SuggestClansHandler is the handler responsible for returning clan names starting with a given prefix
+func SuggestClansHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "SuggestClans")
+		gameID := c.Param("gameID")
+		prefix := c.QueryParam("prefix")
+
+		logger := app.Logger.With(
+			zap.String("source", "clanHandler"),
+			zap.String("operation", "SuggestClans"),
+			zap.String("gameID", gameID),
+			zap.String("prefix", prefix),
 		)
 
+		serializedSuggestions, err := suggestClans(c.StdContext(), app, logger, gameID, prefix)
 		if err != nil {
-			log.E(logger, "Clan search failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(500, err.Error(), c)
+			return FailWithError(err, c)
 		}
 
-		serializedClans := serializeClans(clans, true)
+		return SucceedWithTyped(map[string]interface{}{
+			"clans": serializedSuggestions,
+		}, func() (EasyJSONMarshaler, error) {
+			return newClanSuggestionsResponse(serializedSuggestions)
+		}, c)
+	}
+}
+
+// suggestClans returns the public IDs and names of the clans of the game whose names start with prefix
+func suggestClans(ctx context.Context, app *App, logger zap.Logger, gameID, prefix string) ([]map[string]interface{}, error) {
+	start := time.Now()
+	pageSize := app.Config.GetInt("search.suggest.pageSize")
+
+	if strings.TrimSpace(prefix) == "" {
+		log.W(logger, "Clan suggestion failed due to empty prefix.")
+		return nil, withStatus(400, &models.EmptySearchTermError{})
+	}
 
-		log.D(logger, "Clan search successful.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	if app.MongoDB == nil {
+		log.W(logger, "Clan suggestion failed because MongoDB is not enabled.")
+		return nil, withStatus(http.StatusNotImplemented, errSearchUnavailable)
+	}
+
+	game, err := app.GetGame(ctx, gameID)
+	if err != nil {
+		log.W(logger, "Could not find game.")
+		return nil, withStatus(404, err)
+	}
+
+	log.D(logger, "Suggesting clans...")
+	trie, err := app.clanNamesCache.GetClanNameTrie(app.MongoDB.WithContext(ctx), gameID, &game.SearchSettings)
+	if err != nil {
+		log.E(logger, "Clan suggestion failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
+		return nil, withStatus(500, err)
+	}
 
-		return SucceedWith(map[string]interface{}{
-			"clans": serializedClans,
-		}, c)
+	suggestions := trie.Suggest(prefix, pageSize)
+	if len(suggestions) < pageSize {
+		maxDistance := models.FuzzyMaxDistance(prefix, app.Config.GetInt("search.fuzzy.maxDistance"))
+		if maxDistance > 0 {
+			found := make(map[string]bool, len(suggestions))
+			for _, suggestion := range suggestions {
+				found[suggestion.PublicID] = true
+			}
+			for _, suggestion := range trie.FuzzySearch(prefix, maxDistance, pageSize) {
+				if len(suggestions) >= pageSize {
+					break
+				}
+				if !found[suggestion.PublicID] {
+					suggestions = append(suggestions, suggestion)
+				}
+			}
+		}
+	}
+
+	serializedSuggestions := make([]map[string]interface{}, len(suggestions))
+	for i, suggestion := range suggestions {
+		serializedSuggestions[i] = map[string]interface{}{
+			"publicID": suggestion.PublicID,
+			"name":     suggestion.Name,
+		}
 	}
+
+	log.D(logger, "Clan suggestion successful.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return serializedSuggestions, nil
 }
 
 // RetrieveClanHandler is the handler responsible for returning details for a given clan
 func RetrieveClanHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "RetrieveClan")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		publicID := c.Param("clanPublicID")
 		shortID := c.QueryParam("shortID")
-		maxPendingApplications := c.QueryParam("maxPendingApplications")
-		maxPendingInvites := c.QueryParam("maxPendingInvites")
-		pendingApplicationsOrder := c.QueryParam("pendingApplicationsOrder")
-		pendingInvitesOrder := c.QueryParam("pendingInvitesOrder")
-
-		options := models.NewDefaultGetClanDetailsOptions(app.Config)
-		if maxPendingApplications != "" {
-			maxApps, err := strconv.ParseUint(maxPendingApplications, 10, 16)
+
+		var maxPendingApplications, maxPendingInvites *int
+		if val := c.QueryParam("maxPendingApplications"); val != "" {
+			maxApps, err := strconv.ParseUint(val, 10, 16)
 			if err != nil {
 				return FailWith(400, err.Error(), c)
 			}
-			if int(maxApps) > options.MaxPendingApplications {
-				return FailWith(400, fmt.Sprintf("Maximum pending applications above allowed (%v).", options.MaxPendingApplications), c)
-			}
-			options.MaxPendingApplications = int(maxApps)
+			value := int(maxApps)
+			maxPendingApplications = &value
 		}
-		if maxPendingInvites != "" {
-			maxInvs, err := strconv.ParseUint(maxPendingInvites, 10, 16)
+		if val := c.QueryParam("maxPendingInvites"); val != "" {
+			maxInvs, err := strconv.ParseUint(val, 10, 16)
 			if err != nil {
 				return FailWith(400, err.Error(), c)
 			}
-			if int(maxInvs) > options.MaxPendingInvites {
-				return FailWith(400, fmt.Sprintf("Maximum pending invites above allowed (%v).", options.MaxPendingInvites), c)
-			}
-			options.MaxPendingInvites = int(maxInvs)
-		}
-		if pendingApplicationsOrder != "" {
-			if !models.IsValidOrder(pendingApplicationsOrder) {
-				return FailWith(400, fmt.Sprintf("Pending applications order is invalid (valid orders are %s or %s).", models.Newest, models.Oldest), c)
-			}
-			options.PendingApplicationsOrder = pendingApplicationsOrder
+			value := int(maxInvs)
+			maxPendingInvites = &value
 		}
-		if pendingInvitesOrder != "" {
-			if !models.IsValidOrder(pendingInvitesOrder) {
-				return FailWith(400, fmt.Sprintf("Pending invites order is invalid (valid orders are %s or %s).", models.Newest, models.Oldest), c)
-			}
-			options.PendingInvitesOrder = pendingInvitesOrder
+		options, err := getClanDetailsOptions(
+			app, maxPendingApplications, maxPendingInvites,
+			c.QueryParam("pendingApplicationsOrder"), c.QueryParam("pendingInvitesOrder"),
+		)
+		if err != nil {
+			return FailWith(400, err.Error(), c)
 		}
 
-		db := app.Db(c.StdContext())
-
 		logger := app.Logger.With(
 			zap.String("source", "clanHandler"),
 			zap.String("operation", "RetrieveClan"),
@@ -659,36 +955,79 @@ func RetrieveClanHandler(app *App) func(c echo.Context) error {
 			zap.String("clanPublicID", publicID),
 		)
 
-		log.D(logger, "Getting DB connection...")
-		db, err := app.GetCtxDB(c)
+		clanResult, err := retrieveClan(c.StdContext(), app, logger, gameID, publicID, shortID == "true", options)
 		if err != nil {
-			log.E(logger, "Failed to connect to DB.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(500, err.Error(), c)
+			return FailWithError(err, c)
 		}
-		log.D(logger, "DB Connection successful.")
 
-		game, err := app.GetGame(c.StdContext(), gameID)
-		if err != nil {
-			log.W(logger, "Could not find game.")
-			return FailWith(404, err.Error(), c)
+		setDetailsETag(c, clanResult)
+		return SucceedWithTyped(clanResult, func() (EasyJSONMarshaler, error) {
+			return newClanResponse(clanResult)
+		}, c)
+	}
+}
+
+// getClanDetailsOptions returns the options of the clan details with the given limits and orders, keeping
+// the configured defaults of the nil limits and empty orders
+func getClanDetailsOptions(
+	app *App, maxPendingApplications, maxPendingInvites *int, pendingApplicationsOrder, pendingInvitesOrder string,
+) (*models.GetClanDetailsOptions, error) {
+	options := models.NewDefaultGetClanDetailsOptions(app.Config)
+	if maxPendingApplications != nil {
+		if *maxPendingApplications > options.MaxPendingApplications {
+			return nil, fmt.Errorf("Maximum pending applications above allowed (%v).", options.MaxPendingApplications)
+		}
+		options.MaxPendingApplications = *maxPendingApplications
+	}
+	if maxPendingInvites != nil {
+		if *maxPendingInvites > options.MaxPendingInvites {
+			return nil, fmt.Errorf("Maximum pending invites above allowed (%v).", options.MaxPendingInvites)
+		}
+		options.MaxPendingInvites = *maxPendingInvites
+	}
+	if pendingApplicationsOrder != "" {
+		if !models.IsValidOrder(pendingApplicationsOrder) {
+			return nil, fmt.Errorf("Pending applications order is invalid (valid orders are %s or %s).", models.Newest, models.Oldest)
+		}
+		options.PendingApplicationsOrder = pendingApplicationsOrder
+	}
+	if pendingInvitesOrder != "" {
+		if !models.IsValidOrder(pendingInvitesOrder) {
+			return nil, fmt.Errorf("Pending invites order is invalid (valid orders are %s or %s).", models.Newest, models.Oldest)
 		}
+		options.PendingInvitesOrder = pendingInvitesOrder
+	}
+	return options, nil
+}
 
+// retrieveClan returns the details of the clan, looking it up by its short public ID if shortID is set
+func retrieveClan(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, publicID string, shortID bool, options *models.GetClanDetailsOptions,
+) (map[string]interface{}, error) {
+	start := time.Now()
+	// the payloads are shared with other requests and may be loaded again in background after this
+	// request ends, so they are not loaded with the connection of the request
+	db := app.Db(context.Background())
+
+	game, err := app.GetGame(ctx, gameID)
+	if err != nil {
+		log.W(logger, "Could not find game.")
+		return nil, withStatus(404, err)
+	}
+
+	loadClanDetails := func() (map[string]interface{}, error) {
 		var clan *models.Clan
-		if shortID == "true" {
+		var err error
+		if shortID {
 			clan, err = models.GetClanByShortPublicID(db, gameID, publicID)
 		} else {
 			clan, err = models.GetClanByPublicID(db, gameID, publicID)
 		}
-
 		if err != nil {
-			log.W(logger, "Could not find clan.")
-			return FailWith(404, err.Error(), c)
+			return nil, err
 		}
-
-		log.D(logger, "Retrieving clan details...")
-		clanResult, err := models.GetClanDetails(
+		return models.GetClanDetails(
 			db,
 			app.EncryptionKey,
 			gameID,
@@ -696,26 +1035,39 @@ func RetrieveClanHandler(app *App) func(c echo.Context) error {
 			game.MaxClansPerPlayer,
 			options,
 		)
+	}
 
-		if err != nil {
-			log.E(logger, "Retrieve clan details failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(500, err.Error(), c)
-		}
+	log.D(logger, "Retrieving clan details...")
+	// only the default options are cached, so that clan writes invalidate a single key
+	var clanResult map[string]interface{}
+	if !shortID && *options == *models.NewDefaultGetClanDetailsOptions(app.Config) {
+		clanResult, err = app.clanDetailsCache.Get(gameID, publicID, loadClanDetails)
+	} else {
+		key := fmt.Sprintf("clanDetails/%s/%s/%t/%+v", gameID, publicID, shortID, *options)
+		clanResult, err = coalesce(app, key, loadClanDetails)
+	}
 
-		log.D(logger, "Clan details retrieved successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	if err != nil {
+		if _, ok := err.(*models.ModelNotFoundError); ok {
+			log.W(logger, "Could not find clan.")
+			return nil, err
+		}
+		log.E(logger, "Retrieve clan details failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
-		return SucceedWith(clanResult, c)
+		return nil, withStatus(500, err)
 	}
+
+	log.D(logger, "Clan details retrieved successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return clanResult, nil
 }
 
 // RetrieveClanMembersHandler retrieves only the clan users
 func RetrieveClanMembersHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "RetrieveClanUsers")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		publicID := c.Param("clanPublicID")
 
@@ -726,43 +1078,46 @@ func RetrieveClanMembersHandler(app *App) func(c echo.Context) error {
 			zap.String("clanPublicID", publicID),
 		)
 
-		log.D(logger, "Getting DB connection...")
-		db, err := app.GetCtxDB(c)
+		clanMembers, err := retrieveClanMembers(c.StdContext(), app, logger, gameID, publicID)
 		if err != nil {
-			log.E(logger, "Failed to connect to DB.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(500, err.Error(), c)
-		}
-		log.D(logger, "DB Connection successful.")
-
-		log.D(logger, "Retrieving clan players...")
-		clanMembers, err := models.GetClanMembers(
-			db,
-			gameID,
-			publicID,
-		)
-		if err != nil {
-			log.E(logger, "Clan playerids retrieval failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(500, err.Error(), c)
+			return FailWithError(err, c)
 		}
 
-		log.D(logger, "Clan playerids retrieved successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
-		})
+		return SucceedWithTyped(clanMembers, func() (EasyJSONMarshaler, error) {
+			return newClanMembersResponse(clanMembers)
+		}, c)
 
-		return SucceedWith(clanMembers, c)
+	}
+}
 
+// retrieveClanMembers returns the public IDs of the members of the clan
+func retrieveClanMembers(
+	ctx context.Context, app *App, logger zap.Logger, gameID, publicID string,
+) (map[string]interface{}, error) {
+	start := time.Now()
+	log.D(logger, "Retrieving clan players...")
+	clanMembers, err := models.GetClanMembers(
+		app.Db(ctx),
+		gameID,
+		publicID,
+	)
+	if err != nil {
+		log.E(logger, "Clan playerids retrieval failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, withStatus(500, err)
 	}
+
+	log.D(logger, "Clan playerids retrieved successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return clanMembers, nil
 }
 
 // RetrieveClanSummaryHandler is the handler responsible for returning details summary for a given clan
 func RetrieveClanSummaryHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "RetrieveClanSummary")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		publicID := c.Param("clanPublicID")
 
@@ -773,36 +1128,45 @@ func RetrieveClanSummaryHandler(app *App) func(c echo.Context) error {
 			zap.String("clanPublicID", publicID),
 		)
 
-		log.D(logger, "Getting DB connection...")
-		db, err := app.GetCtxDB(c)
+		clanSummary, err := retrieveClanSummary(app, logger, gameID, publicID)
 		if err != nil {
-			log.E(logger, "Failed to connect to DB.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(500, err.Error(), c)
+			return FailWithError(err, c)
 		}
-		log.D(logger, "DB Connection successful.")
 
-		log.D(logger, "Retrieving clan summary...")
-		clanSummary, err := models.GetClanSummary(
+		return SucceedWithTyped(clanSummary, func() (EasyJSONMarshaler, error) {
+			return newClanSummaryResponse(clanSummary)
+		}, c)
+	}
+}
+
+// retrieveClanSummary returns the summary of the clan
+func retrieveClanSummary(app *App, logger zap.Logger, gameID, publicID string) (map[string]interface{}, error) {
+	start := time.Now()
+	// the payloads are shared with other requests and may be loaded again in background after this
+	// request ends, so they are not loaded with the connection of the request
+	db := app.Db(context.Background())
+
+	log.D(logger, "Retrieving clan summary...")
+	key := fmt.Sprintf("clanSummary/%s/%s", gameID, publicID)
+	clanSummary, err := coalesce(app, key, func() (map[string]interface{}, error) {
+		return models.GetClanSummary(
 			db,
 			gameID,
 			publicID,
 		)
+	})
 
-		if err != nil {
-			log.E(logger, "Clan summary retrieval failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWithError(err, c)
-		}
-
-		log.D(logger, "Clan summary retrieved successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	if err != nil {
+		log.E(logger, "Clan summary retrieval failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
-
-		return SucceedWith(clanSummary, c)
+		return nil, err
 	}
+
+	log.D(logger, "Clan summary retrieved successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return clanSummary, nil
 }
 
 // RetrieveClansSummariesHandler is the handler responsible for returning details summary for a given
@@ -810,7 +1174,6 @@ func RetrieveClanSummaryHandler(app *App) func(c echo.Context) error {
 func RetrieveClansSummariesHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "RetrieveClansSummaries")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		publicIDsStr := c.QueryParam("clanPublicIds")
 
@@ -825,57 +1188,67 @@ func RetrieveClansSummariesHandler(app *App) func(c echo.Context) error {
 
 		// split of an empty string returns an array with an empty string
 		if len(publicIDs) == 1 && publicIDs[0] == "" {
-			log.D(logger, "Empty query string provided.")
-			log.E(logger, "Clans summaries retrieval failed, Empty query string provided.")
-			return FailWith(400, "No clanPublicIds provided", c)
+			publicIDs = nil
 		}
 
-		log.D(logger, "Getting DB connection...")
-		db, err := app.GetCtxDB(c)
+		clansResponse, err := retrieveClansSummaries(app, logger, gameID, publicIDs)
 		if err != nil {
-			log.E(logger, "Failed to connect to DB.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(500, err.Error(), c)
+			return FailWithError(err, c)
 		}
-		log.D(logger, "DB Connection successful.")
 
-		status := 500
-		var missingClans []string
-		log.D(logger, "Retrieving clans summaries...")
-		clansSummaries, err := app.clansSummariesCache.GetClansSummaries(
-			db,
-			gameID,
-			publicIDs,
-		)
+		return SucceedWithTyped(clansResponse, func() (EasyJSONMarshaler, error) {
+			return newClansSummariesResponse(clansResponse)
+		}, c)
+	}
+}
 
-		if err != nil {
-			if _, ok := err.(*models.CouldNotFindAllClansError); ok {
-				e := err.(*models.CouldNotFindAllClansError)
-				log.W(logger, "Could not find all clans summaries.", func(cm log.CM) {
-					cm.Write(zap.Error(err))
-				})
-				missingClans = e.ClanIDs
-			} else {
-				log.E(logger, "Clans summaries retrieval failed, 500.", func(cm log.CM) {
-					cm.Write(zap.Error(err))
-				})
-				return FailWith(status, err.Error(), c)
-			}
-		}
+// retrieveClansSummaries returns the summaries of the clans, along with the public IDs of the clans that
+// were not found
+func retrieveClansSummaries(app *App, logger zap.Logger, gameID string, publicIDs []string) (map[string]interface{}, error) {
+	start := time.Now()
+	if len(publicIDs) == 0 {
+		log.D(logger, "Empty query string provided.")
+		log.E(logger, "Clans summaries retrieval failed, Empty query string provided.")
+		return nil, withStatus(400, errors.New("No clanPublicIds provided"))
+	}
 
-		log.D(logger, "Clans summaries retrieved successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
-		})
+	// the payloads are shared with other requests and may be loaded again in background after this
+	// request ends, so they are not loaded with the connection of the request
+	db := app.Db(context.Background())
 
-		clansResponse := map[string]interface{}{
-			"clans": clansSummaries,
-		}
+	var missingClans []string
+	log.D(logger, "Retrieving clans summaries...")
+	clansSummaries, err := app.clansSummariesCache.GetClansSummaries(
+		db,
+		gameID,
+		publicIDs,
+	)
 
-		if missingClans != nil && len(missingClans) > 0 {
-			clansResponse["missingClans"] = missingClans
+	if err != nil {
+		if _, ok := err.(*models.CouldNotFindAllClansError); ok {
+			e := err.(*models.CouldNotFindAllClansError)
+			log.W(logger, "Could not find all clans summaries.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+			missingClans = e.ClanIDs
+		} else {
+			log.E(logger, "Clans summaries retrieval failed, 500.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+			return nil, withStatus(500, err)
 		}
+	}
+
+	log.D(logger, "Clans summaries retrieved successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+
+	clansResponse := map[string]interface{}{
+		"clans": clansSummaries,
+	}
 
-		return SucceedWith(clansResponse, c)
+	if missingClans != nil && len(missingClans) > 0 {
+		clansResponse["missingClans"] = missingClans
 	}
+	return clansResponse, nil
 }
@@ -8,6 +8,8 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"strings"
 
 	"github.com/topfreegames/khan/log"
@@ -134,3 +136,216 @@ func validateUpdateClanDispatch(game *models.Game, sourceClan *models.Clan, clan
 
 	return false
 }
+
+// errSearchUnavailable is the reason of the failures of the clan search routes when MongoDB is not enabled
+var errSearchUnavailable = errors.New("Clan search is not available because MongoDB is not enabled.")
+
+// fuzzySearchClans looks up clans whose name is within a few typos of term using the game clan name trie
+func fuzzySearchClans(ctx context.Context, app *App, db models.DB, game *models.Game, term string, pageSize int) ([]models.Clan, error) {
+	maxDistance := models.FuzzyMaxDistance(term, app.Config.GetInt("search.fuzzy.maxDistance"))
+	if maxDistance <= 0 {
+		return []models.Clan{}, nil
+	}
+
+	trie, err := app.clanNamesCache.GetClanNameTrie(app.MongoDB.WithContext(ctx), game.PublicID, &game.SearchSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := trie.FuzzySearch(term, maxDistance, pageSize)
+	if len(suggestions) == 0 {
+		return []models.Clan{}, nil
+	}
+	publicIDs := make([]string, len(suggestions))
+	for i, suggestion := range suggestions {
+		publicIDs[i] = suggestion.PublicID
+	}
+
+	clans, err := models.GetClansByPublicIDs(db, game.PublicID, publicIDs)
+	if err != nil {
+		if _, ok := err.(*models.CouldNotFindAllClansError); !ok {
+			return nil, err
+		}
+	}
+
+	// keep the closest matches first
+	idToClan := make(map[string]models.Clan, len(clans))
+	for _, clan := range clans {
+		idToClan[clan.PublicID] = clan
+	}
+	result := make([]models.Clan, 0, len(clans))
+	for _, publicID := range publicIDs {
+		if clan, ok := idToClan[publicID]; ok {
+			result = append(result, clan)
+		}
+	}
+	return result, nil
+}
+
+// newClanReference returns the typed clan of a serialized clan reference
+func newClanReference(r *payloadReader, clan map[string]interface{}) *ClanReference {
+	if clan == nil {
+		return nil
+	}
+	return &ClanReference{
+		PublicID: r.str(clan, "publicID"),
+		Name:     r.str(clan, "name"),
+	}
+}
+
+// newClanReferences returns the typed clans of a list of serialized clan references
+func newClanReferences(r *payloadReader, clans []map[string]interface{}) []*ClanReference {
+	references := make([]*ClanReference, len(clans))
+	for i, clan := range clans {
+		references[i] = newClanReference(r, clan)
+	}
+	return references
+}
+
+// newClanSummaries returns the typed clans of a list of serialized clans
+func newClanSummaries(r *payloadReader, clans []map[string]interface{}) []*ClanSummary {
+	summaries := make([]*ClanSummary, len(clans))
+	for i, clan := range clans {
+		if clan != nil {
+			summaries[i] = &ClanSummary{
+				PublicID:         r.str(clan, "publicID"),
+				Name:             r.str(clan, "name"),
+				Metadata:         r.object(clan, "metadata"),
+				AllowApplication: r.boolean(clan, "allowApplication"),
+				AutoJoin:         r.boolean(clan, "autoJoin"),
+				MembershipCount:  int(r.integer(clan, "membershipCount")),
+			}
+		}
+	}
+	return summaries
+}
+
+// newClansResponse returns the v2 response of the list and search clans routes
+func newClansResponse(clans []map[string]interface{}) (*ClansResponse, error) {
+	r := &payloadReader{}
+	return &ClansResponse{Success: true, Clans: newClanSummaries(r, clans)}, r.err
+}
+
+// newClanSuggestionsResponse returns the v2 response of the suggest clans route
+func newClanSuggestionsResponse(clans []map[string]interface{}) (*ClanSuggestionsResponse, error) {
+	r := &payloadReader{}
+	return &ClanSuggestionsResponse{Success: true, Clans: newClanReferences(r, clans)}, r.err
+}
+
+// newClansSummariesResponse returns the v2 response of the clans summaries route
+func newClansSummariesResponse(summaries map[string]interface{}) (*ClansSummariesResponse, error) {
+	r := &payloadReader{}
+	return &ClansSummariesResponse{
+		Success:      true,
+		Clans:        newClanSummaries(r, r.objects(summaries, "clans")),
+		MissingClans: r.strs(summaries, "missingClans"),
+	}, r.err
+}
+
+// newClanSummaryResponse returns the v2 response of the clan summary route
+func newClanSummaryResponse(summary map[string]interface{}) (*ClanSummaryResponse, error) {
+	r := &payloadReader{}
+	return &ClanSummaryResponse{
+		Success:          true,
+		PublicID:         r.str(summary, "publicID"),
+		Name:             r.str(summary, "name"),
+		Metadata:         r.object(summary, "metadata"),
+		AllowApplication: r.boolean(summary, "allowApplication"),
+		AutoJoin:         r.boolean(summary, "autoJoin"),
+		MembershipCount:  int(r.integer(summary, "membershipCount")),
+	}, r.err
+}
+
+// newClanMembersResponse returns the v2 response of the clan members route
+func newClanMembersResponse(members map[string]interface{}) (*ClanMembersResponse, error) {
+	r := &payloadReader{}
+	return &ClanMembersResponse{Success: true, Members: r.strs(members, "members")}, r.err
+}
+
+// newClanMembers returns the typed members of a list of serialized clan members
+func newClanMembers(r *payloadReader, members []map[string]interface{}) []*ClanMember {
+	typed := make([]*ClanMember, len(members))
+	for i, member := range members {
+		if member == nil {
+			continue
+		}
+		typed[i] = &ClanMember{
+			Level:   r.str(member, "level"),
+			Message: r.str(member, "message"),
+		}
+		if player := r.object(member, "player"); player != nil {
+			typed[i].Player = &ClanMemberPlayer{
+				PublicID: r.str(player, "publicID"),
+				Name:     r.str(player, "name"),
+				Metadata: r.object(player, "metadata"),
+				Approver: newPlayerReference(r, r.object(player, "approver")),
+				Denier:   newPlayerReference(r, r.object(player, "denier")),
+			}
+		}
+	}
+	return typed
+}
+
+// newClanResponse returns the v2 response of the retrieve clan route
+func newClanResponse(details map[string]interface{}) (*ClanResponse, error) {
+	r := &payloadReader{}
+	response := &ClanResponse{
+		Success:          true,
+		PublicID:         r.str(details, "publicID"),
+		Name:             r.str(details, "name"),
+		Metadata:         r.object(details, "metadata"),
+		AllowApplication: r.boolean(details, "allowApplication"),
+		AutoJoin:         r.boolean(details, "autoJoin"),
+		MembershipCount:  int(r.integer(details, "membershipCount")),
+		Version:          r.integer(details, "version"),
+		Owner:            newPlayerProfile(r, r.object(details, "owner")),
+		Roster:           newClanMembers(r, r.objects(details, "roster")),
+	}
+	if memberships := r.object(details, "memberships"); memberships != nil {
+		response.Memberships = &ClanMemberships{
+			PendingApplications: newClanMembers(r, r.objects(memberships, "pendingApplications")),
+			PendingInvites:      newClanMembers(r, r.objects(memberships, "pendingInvites")),
+			Banned:              newClanMembers(r, r.objects(memberships, "banned")),
+			Denied:              newClanMembers(r, r.objects(memberships, "denied")),
+		}
+	}
+	return response, r.err
+}
+
+// newPatchClanResponse returns the v2 response of the patch clan route
+func newPatchClanResponse(clan map[string]interface{}) (*PatchClanResponse, error) {
+	r := &payloadReader{}
+	return &PatchClanResponse{
+		Success: true,
+		Clan: &PatchedClan{
+			PublicID:         r.str(clan, "publicID"),
+			Name:             r.str(clan, "name"),
+			Metadata:         r.object(clan, "metadata"),
+			AllowApplication: r.boolean(clan, "allowApplication"),
+			AutoJoin:         r.boolean(clan, "autoJoin"),
+			MembershipCount:  int(r.integer(clan, "membershipCount")),
+			OwnerPublicID:    r.str(clan, "ownerPublicID"),
+		},
+	}, r.err
+}
+
+// newLeaveClanResponse returns the v2 response of the leave clan route
+func newLeaveClanResponse(result map[string]interface{}) (*LeaveClanResponse, error) {
+	r := &payloadReader{}
+	return &LeaveClanResponse{
+		Success:       true,
+		PreviousOwner: newPlayerSummary(r, r.object(result, "previousOwner")),
+		NewOwner:      newPlayerSummary(r, r.object(result, "newOwner")),
+		IsDeleted:     r.boolean(result, "isDeleted"),
+	}, r.err
+}
+
+// newTransferClanOwnershipResponse returns the v2 response of the transfer clan ownership route
+func newTransferClanOwnershipResponse(result map[string]interface{}) (*TransferClanOwnershipResponse, error) {
+	r := &payloadReader{}
+	return &TransferClanOwnershipResponse{
+		Success:       true,
+		PreviousOwner: newPlayerSummary(r, r.object(result, "previousOwner")),
+		NewOwner:      newPlayerSummary(r, r.object(result, "newOwner")),
+	}, r.err
+}
@@ -345,6 +345,98 @@ var _ = Describe("Clan API Handler", func() {
 		})
 	})
 
+	Describe("Patch Clan Handler", func() {
+		It("Should patch clan", func() {
+			_, clan, owner, _, _, err := fixtures.GetClanWithMemberships(testDb, 0, 0, 0, 0, "", "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.Exec("UPDATE clans SET metadata=$1 WHERE id=$2", `{"x": "a", "y": "b"}`, clan.ID)
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := map[string]interface{}{
+				"ownerPublicID": owner.PublicID,
+				"autoJoin":      !clan.AutoJoin,
+				"metadata":      map[string]interface{}{"x": nil, "z": "c"},
+			}
+			route := GetGameRoute(clan.GameID, fmt.Sprintf("/clans/%s", clan.PublicID))
+			status, body := PatchJSON(app, route, payload)
+
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+
+			dbClan, err := models.GetClanByPublicID(db, clan.GameID, clan.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbClan.Name).To(Equal(clan.Name))
+			Expect(dbClan.AllowApplication).To(Equal(clan.AllowApplication))
+			Expect(dbClan.AutoJoin).To(Equal(!clan.AutoJoin))
+			Expect(dbClan.Metadata).To(Equal(map[string]interface{}{"y": "b", "z": "c"}))
+		})
+
+		It("Should clear clan metadata if it is null", func() {
+			_, clan, owner, _, _, err := fixtures.GetClanWithMemberships(testDb, 0, 0, 0, 0, "", "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.Exec("UPDATE clans SET metadata=$1 WHERE id=$2", `{"x": "a", "y": "b"}`, clan.ID)
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := map[string]interface{}{
+				"ownerPublicID": owner.PublicID,
+				"metadata":      nil,
+			}
+			route := GetGameRoute(clan.GameID, fmt.Sprintf("/clans/%s", clan.PublicID))
+			status, _ := PatchJSON(app, route, payload)
+			Expect(status).To(Equal(http.StatusOK))
+
+			dbClan, err := models.GetClanByPublicID(db, clan.GameID, clan.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbClan.Metadata).To(Equal(map[string]interface{}{}))
+		})
+
+		It("Should not patch clan without ownerPublicID", func() {
+			_, clan, _, _, _, err := fixtures.GetClanWithMemberships(testDb, 0, 0, 0, 0, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(clan.GameID, fmt.Sprintf("/clans/%s", clan.PublicID))
+			status, body := PatchJSON(app, route, map[string]interface{}{"name": "name"})
+
+			Expect(status).To(Equal(http.StatusBadRequest))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal("ownerPublicID is required"))
+		})
+
+		It("Should not patch clan if If-Match header does not match its version", func() {
+			_, clan, owner, _, _, err := fixtures.GetClanWithMemberships(testDb, 0, 0, 0, 0, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(clan.GameID, fmt.Sprintf("/clans/%s", clan.PublicID))
+			status, _, etag := GetWithETag(app, route)
+			Expect(status).To(Equal(http.StatusOK))
+
+			payload := map[string]interface{}{
+				"ownerPublicID": owner.PublicID,
+				"name":          "new clan name",
+			}
+			status, _, newETag := PatchJSONIfMatch(app, route, payload, etag)
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(newETag).NotTo(Equal(etag))
+
+			status, _, _ = PatchJSONIfMatch(app, route, payload, etag)
+			Expect(status).To(Equal(http.StatusPreconditionFailed))
+		})
+
+		It("Should return 400 if If-Match header is not a valid version", func() {
+			_, clan, owner, _, _, err := fixtures.GetClanWithMemberships(testDb, 0, 0, 0, 0, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(clan.GameID, fmt.Sprintf("/clans/%s", clan.PublicID))
+			payload := map[string]interface{}{"ownerPublicID": owner.PublicID}
+			status, _, _ := PatchJSONIfMatch(app, route, payload, `"-1"`)
+			Expect(status).To(Equal(http.StatusBadRequest))
+		})
+	})
+
 	Describe("Update Clan Handler", func() {
 		It("Should update clan", func() {
 			_, clan, owner, _, _, err := fixtures.GetClanWithMemberships(testDb, 0, 0, 0, 0, "", "")
@@ -382,6 +474,32 @@ var _ = Describe("Clan API Handler", func() {
 			Expect(dbClan.AutoJoin).To(Equal(!clan.AutoJoin))
 		})
 
+		It("Should not update clan if If-Match header does not match its version", func() {
+			_, clan, owner, _, _, err := fixtures.GetClanWithMemberships(testDb, 0, 0, 0, 0, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(clan.GameID, fmt.Sprintf("/clans/%s", clan.PublicID))
+			status, _, etag := GetWithETag(app, route)
+			Expect(status).To(Equal(http.StatusOK))
+
+			payload := map[string]interface{}{
+				"name":             "new clan name",
+				"ownerPublicID":    owner.PublicID,
+				"metadata":         clan.Metadata,
+				"allowApplication": clan.AllowApplication,
+				"autoJoin":         clan.AutoJoin,
+			}
+			status, _, newETag := PutJSONIfMatch(app, route, payload, etag)
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(newETag).NotTo(Equal(etag))
+
+			status, body, _ := PutJSONIfMatch(app, route, payload, etag)
+			Expect(status).To(Equal(http.StatusPreconditionFailed))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+		})
+
 		It("Should update Mongo if update clan", func() {
 			mongo, err := testing.GetTestMongo()
 			Expect(err).NotTo(HaveOccurred())
@@ -1170,6 +1288,64 @@ var _ = Describe("Clan API Handler", func() {
 		})
 	})
 
+	Describe("Suggest Clans Handler", func() {
+		insertClanIntoMongo := func(player *models.Player, clan *models.Clan) error {
+			return mongoWorker.InsertGame(context.Background(), player.GameID, clan)
+		}
+
+		getSuggestedClans := func(gameID, prefix string) []interface{} {
+			status, body := Get(app, GetGameRoute(gameID, fmt.Sprintf("clans/suggest?prefix=%s", prefix)))
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			return result["clans"].([]interface{})
+		}
+
+		It("Should suggest clans by name prefix", func() {
+			mongoDB, err := testing.GetTestMongo()
+			Expect(err).NotTo(HaveOccurred())
+
+			gameID := uuid.NewV4().String()
+			player, expectedClans, err := fixtures.CreateTestClans(
+				testDb, mongoDB, gameID, "clan-apisuggest-clan", 5, insertClanIntoMongo,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			clans := getSuggestedClans(player.GameID, "💩clán-clan-APISUGGEST")
+			Expect(clans).To(HaveLen(5))
+
+			expectedNames := map[string]string{}
+			for _, expectedClan := range expectedClans {
+				expectedNames[expectedClan.PublicID] = expectedClan.Name
+			}
+			for _, cl := range clans {
+				clan := cl.(map[string]interface{})
+				Expect(clan["name"]).To(Equal(expectedNames[clan["publicID"].(string)]))
+			}
+		})
+
+		It("Should suggest clans with typos in the prefix", func() {
+			mongoDB, err := testing.GetTestMongo()
+			Expect(err).NotTo(HaveOccurred())
+
+			gameID := uuid.NewV4().String()
+			player, _, err := fixtures.CreateTestClans(
+				testDb, mongoDB, gameID, "clan-apisuggest-clan", 5, insertClanIntoMongo,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			clans := getSuggestedClans(player.GameID, "💩clán-clam-apisugest")
+			Expect(clans).To(HaveLen(5))
+		})
+
+		It("Should fail if prefix is empty", func() {
+			gameID := uuid.NewV4().String()
+			status, _ := Get(app, GetGameRoute(gameID, "clans/suggest?prefix="))
+			Expect(status).To(Equal(http.StatusBadRequest))
+		})
+	})
+
 	Describe("Clan Hooks", func() {
 		It("Should call create clan hook", func() {
 			hooks, err := fixtures.GetHooksForRoutes(testDb, []string{
@@ -18,6 +18,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -205,6 +206,7 @@ func (d *Dispatcher) PerformDispatchHook(m *workers.Msg) {
 				fmt.Sprintf("game:%s", gameID),
 			}
 			statsd.Increment(hookInternalFailures, tags...)
+			app.Metrics.HookDeliveries.WithLabelValues(gameID, "error").Inc()
 
 			log.E(logger, "Could not interpolate webhook.", func(cm log.CM) {
 				cm.Write(
@@ -274,6 +276,7 @@ func (d *Dispatcher) PerformDispatchHook(m *workers.Msg) {
 			elapsed := time.Since(start)
 			statsd.Timing(requestingHookMilliseconds, elapsed, tags...)
 			statsd.Increment(hookInternalFailures, tags...)
+			app.Metrics.HookDeliveries.WithLabelValues(gameID, "error").Inc()
 
 			log.E(logger, "Could not request webhook.", func(cm log.CM) {
 				cm.Write(zap.String("requestURL", hook.URL), zap.Error(err))
@@ -298,6 +301,7 @@ func (d *Dispatcher) PerformDispatchHook(m *workers.Msg) {
 		}
 		elapsed := time.Since(start)
 		statsd.Timing(requestingHookMilliseconds, elapsed, tags...)
+		app.Metrics.HookDeliveries.WithLabelValues(gameID, strconv.Itoa(resp.StatusCode)).Inc()
 
 		if resp.StatusCode > 399 {
 			app.addError()
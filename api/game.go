@@ -8,13 +8,17 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/labstack/echo"
 	"github.com/topfreegames/khan/log"
 	"github.com/topfreegames/khan/models"
-	"github.com/topfreegames/khan/mongo"
 	"github.com/uber-go/zap"
 )
 
@@ -22,7 +26,6 @@ import (
 func CreateGameHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "CreateGame")
-		start := time.Now()
 
 		logger := app.Logger.With(
 			zap.String("source", "gameHandler"),
@@ -30,9 +33,6 @@ func CreateGameHandler(app *App) func(c echo.Context) error {
 		)
 
 		log.D(logger, "Retrieving parameters...")
-		var err error
-		var optional *optionalParams
-
 		payload, optional, err := getCreateGamePayload(app, c, logger)
 		if err != nil {
 			log.E(logger, "Failed to retrieve parameters.", func(cm log.CM) {
@@ -41,86 +41,100 @@ func CreateGameHandler(app *App) func(c echo.Context) error {
 			return FailWith(400, err.Error(), c)
 		}
 
-		log.D(logger, "Parameters retrieved successfully.", func(cm log.CM) {
-			cm.Write(
-				zap.Int("maxPendingInvites", optional.maxPendingInvites),
-				zap.Int("cooldownBeforeInvite", optional.cooldownBeforeInvite),
-				zap.Int("cooldownBeforeApply", optional.cooldownBeforeApply),
-			)
-		})
-
-		tx, err := app.BeginTrans(c.StdContext(), logger)
+		game, err := createGame(c.StdContext(), app, logger, payload, optional)
 		if err != nil {
-			log.E(logger, "Could not start transaction", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(500, err.Error(), c)
-		}
-
-		log.D(logger, "Creating game...")
-		game, err := models.CreateGame(
-			tx,
-			payload.PublicID,
-			payload.Name,
-			payload.MembershipLevels,
-			payload.Metadata,
-			payload.MinLevelToAcceptApplication,
-			payload.MinLevelToCreateInvitation,
-			payload.MinLevelToRemoveMember,
-			payload.MinLevelOffsetToRemoveMember,
-			payload.MinLevelOffsetToPromoteMember,
-			payload.MinLevelOffsetToDemoteMember,
-			payload.MaxMembers,
-			payload.MaxClansPerPlayer,
-			payload.CooldownAfterDeny,
-			payload.CooldownAfterDelete,
-			optional.cooldownBeforeApply,
-			optional.cooldownBeforeInvite,
-			optional.maxPendingInvites,
-			false,
-			optional.clanUpdateMetadataFieldsHookTriggerWhitelist,
-			optional.playerUpdateMetadataFieldsHookTriggerWhitelist,
-		)
-
-		if err != nil {
-			log.E(logger, "Create game failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			app.Rollback(tx, "Game", c, logger, err)
-			return FailWith(500, err.Error(), c)
+			return FailWithError(err, c)
 		}
 
-		if app.MongoDB != nil {
-			err = app.MongoDB.Run(mongo.GetClanNameTextIndexCommand(game.PublicID, false), nil)
-			if err != nil {
-				app.Rollback(tx, "Game", c, logger, err)
-			}
-		}
+		return SucceedWith(map[string]interface{}{
+			"publicID": game.PublicID,
+		}, c)
+	}
+}
 
-		txErr := app.Commit(tx, "Game", c, logger)
-		if txErr != nil {
-			return FailWith(500, err.Error(), c)
-		}
+// createGame creates the game of the payload
+func createGame(
+	ctx context.Context, app *App, logger zap.Logger, payload *CreateGamePayload, optional *optionalParams,
+) (*models.Game, error) {
+	start := time.Now()
+	log.D(logger, "Parameters retrieved successfully.", func(cm log.CM) {
+		cm.Write(
+			zap.Int("maxPendingInvites", optional.maxPendingInvites),
+			zap.Int("cooldownBeforeInvite", optional.cooldownBeforeInvite),
+			zap.Int("cooldownBeforeApply", optional.cooldownBeforeApply),
+		)
+	})
 
-		log.I(logger, "Game created succesfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	tx, err := app.BeginTrans(ctx, logger)
+	if err != nil {
+		log.E(logger, "Could not start transaction", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
+		return nil, withStatus(500, err)
+	}
 
-		return SucceedWith(map[string]interface{}{
-			"publicID": game.PublicID,
-		}, c)
+	log.D(logger, "Creating game...")
+	game, err := models.CreateGame(
+		tx,
+		payload.PublicID,
+		payload.Name,
+		payload.MembershipLevels,
+		payload.Metadata,
+		payload.MinLevelToAcceptApplication,
+		payload.MinLevelToCreateInvitation,
+		payload.MinLevelToRemoveMember,
+		payload.MinLevelOffsetToRemoveMember,
+		payload.MinLevelOffsetToPromoteMember,
+		payload.MinLevelOffsetToDemoteMember,
+		payload.MaxMembers,
+		payload.MaxClansPerPlayer,
+		payload.CooldownAfterDeny,
+		payload.CooldownAfterDelete,
+		optional.cooldownBeforeApply,
+		optional.cooldownBeforeInvite,
+		optional.maxPendingInvites,
+		false,
+		optional.clanUpdateMetadataFieldsHookTriggerWhitelist,
+		optional.playerUpdateMetadataFieldsHookTriggerWhitelist,
+		optional.playerEncryptedMetadataFields,
+		optional.searchSettings,
+		optional.playerNameSettings,
+		optional.playerTokenSettings,
+	)
+
+	if err != nil {
+		log.E(logger, "Create game failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		app.Rollback(tx, "Game", logger, err)
+		return nil, withStatus(500, err)
 	}
+
+	if app.MongoDB != nil {
+		err = app.MongoDB.Run(game.GetClanNameTextIndexCommand(game.PublicID, false), nil)
+		if err != nil {
+			app.Rollback(tx, "Game", logger, err)
+			return nil, withStatus(500, err)
+		}
+	}
+
+	err = app.Commit(tx, "Game", logger)
+	if err != nil {
+		return nil, withStatus(500, err)
+	}
+
+	log.I(logger, "Game created succesfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return game, nil
 }
 
 //UpdateGameHandler is the handler responsible for updating existing
 func UpdateGameHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "UpdateGame")
-		start := time.Now()
 		gameID := c.Param("gameID")
 
-		db := app.Db(c.StdContext())
-
 		logger := app.Logger.With(
 			zap.String("source", "gameHandler"),
 			zap.String("operation", "updateGame"),
@@ -146,82 +160,469 @@ func UpdateGameHandler(app *App) func(c echo.Context) error {
 			return FailWith(400, err.Error(), c)
 		}
 
-		log.D(logger, "Parameters retrieved successfully.", func(cm log.CM) {
-			cm.Write(
-				zap.Int("maxPendingInvites", optional.maxPendingInvites),
-				zap.Int("cooldownBeforeInvite", optional.cooldownBeforeInvite),
-				zap.Int("cooldownBeforeApply", optional.cooldownBeforeApply),
-			)
-		})
-		log.D(logger, "Validating payload...")
-		if payloadErrors := ValidatePayload(&payload); len(payloadErrors) != 0 {
-			logPayloadErrors(logger, payloadErrors)
-			errorString := strings.Join(payloadErrors[:], ", ")
-			return FailWith(422, errorString, c)
-		}
-
-		log.D(logger, "Updating game...")
-		_, err = models.UpdateGame(
-			db,
-			gameID,
-			payload.Name,
-			payload.MembershipLevels,
-			payload.Metadata,
-			payload.MinLevelToAcceptApplication,
-			payload.MinLevelToCreateInvitation,
-			payload.MinLevelToRemoveMember,
-			payload.MinLevelOffsetToRemoveMember,
-			payload.MinLevelOffsetToPromoteMember,
-			payload.MinLevelOffsetToDemoteMember,
-			payload.MaxMembers,
-			payload.MaxClansPerPlayer,
-			payload.CooldownAfterDeny,
-			payload.CooldownAfterDelete,
-			optional.cooldownBeforeApply,
-			optional.cooldownBeforeInvite,
-			optional.maxPendingInvites,
-			optional.clanUpdateMetadataFieldsHookTriggerWhitelist,
-			optional.playerUpdateMetadataFieldsHookTriggerWhitelist,
-		)
+		expectedVersion, err := getIfMatchVersion(c)
+		if err != nil {
+			return FailWith(400, err.Error(), c)
+		}
 
+		game, err := updateGame(c.StdContext(), app, logger, gameID, &payload, optional, expectedVersion)
 		if err != nil {
-			log.E(logger, "Game update failed.", func(cm log.CM) {
+			return FailWithError(err, c)
+		}
+
+		setETag(c, game.Version)
+		return SucceedWith(map[string]interface{}{}, c)
+	}
+}
+
+// updateGame updates the game with the payload, creating it if it does not exist. expectedVersion is the
+// version the game must be at, zero for any version
+func updateGame(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID string, payload *UpdateGamePayload, optional *optionalParams, expectedVersion int64,
+) (*models.Game, error) {
+	start := time.Now()
+	log.D(logger, "Parameters retrieved successfully.", func(cm log.CM) {
+		cm.Write(
+			zap.Int("maxPendingInvites", optional.maxPendingInvites),
+			zap.Int("cooldownBeforeInvite", optional.cooldownBeforeInvite),
+			zap.Int("cooldownBeforeApply", optional.cooldownBeforeApply),
+		)
+	})
+	log.D(logger, "Validating payload...")
+	if payloadErrors := ValidatePayload(payload); len(payloadErrors) != 0 {
+		logPayloadErrors(logger, payloadErrors)
+		return nil, withStatus(422, errors.New(strings.Join(payloadErrors[:], ", ")))
+	}
+
+	tx, err := app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, withStatus(500, err)
+	}
+
+	log.D(logger, "Retrieving game...")
+	previousGame, err := models.GetGameByPublicID(tx, gameID)
+	if err != nil {
+		if _, ok := err.(*models.ModelNotFoundError); !ok {
+			log.E(logger, "Game retrieval failed.", func(cm log.CM) {
 				cm.Write(zap.Error(err))
 			})
-			return FailWith(500, err.Error(), c)
-		}
-
-		successPayload := map[string]interface{}{
-			"publicID":                      gameID,
-			"name":                          payload.Name,
-			"membershipLevels":              payload.MembershipLevels,
-			"metadata":                      payload.Metadata,
-			"minLevelToAcceptApplication":   payload.MinLevelToAcceptApplication,
-			"minLevelToCreateInvitation":    payload.MinLevelToCreateInvitation,
-			"minLevelToRemoveMember":        payload.MinLevelToRemoveMember,
-			"minLevelOffsetToRemoveMember":  payload.MinLevelOffsetToRemoveMember,
-			"minLevelOffsetToPromoteMember": payload.MinLevelOffsetToPromoteMember,
-			"minLevelOffsetToDemoteMember":  payload.MinLevelOffsetToDemoteMember,
-			"maxMembers":                    payload.MaxMembers,
-			"maxClansPerPlayer":             payload.MaxClansPerPlayer,
-			"cooldownAfterDeny":             payload.CooldownAfterDeny,
-			"cooldownAfterDelete":           payload.CooldownAfterDelete,
-			"cooldownBeforeApply":           optional.cooldownBeforeApply,
-			"cooldownBeforeInvite":          optional.cooldownBeforeInvite,
-			"maxPendingInvites":             optional.maxPendingInvites,
-		}
-		dErr := app.DispatchHooks(gameID, models.GameUpdatedHook, successPayload)
-		if dErr != nil {
-			log.E(logger, "Game update hook dispatch failed.", func(cm log.CM) {
-				cm.Write(zap.Error(dErr))
-			})
-			return FailWith(500, err.Error(), c)
+			app.Rollback(tx, "Game retrieval failed", logger, err)
+			return nil, withStatus(500, err)
+		}
+		previousGame = nil
+	}
+	optional.keepStoredValues(previousGame)
+
+	log.D(logger, "Updating game...")
+	game, err := models.UpdateGame(
+		tx,
+		gameID,
+		payload.Name,
+		payload.MembershipLevels,
+		payload.Metadata,
+		payload.MinLevelToAcceptApplication,
+		payload.MinLevelToCreateInvitation,
+		payload.MinLevelToRemoveMember,
+		payload.MinLevelOffsetToRemoveMember,
+		payload.MinLevelOffsetToPromoteMember,
+		payload.MinLevelOffsetToDemoteMember,
+		payload.MaxMembers,
+		payload.MaxClansPerPlayer,
+		payload.CooldownAfterDeny,
+		payload.CooldownAfterDelete,
+		optional.cooldownBeforeApply,
+		optional.cooldownBeforeInvite,
+		optional.maxPendingInvites,
+		optional.clanUpdateMetadataFieldsHookTriggerWhitelist,
+		optional.playerUpdateMetadataFieldsHookTriggerWhitelist,
+		optional.playerEncryptedMetadataFields,
+		optional.searchSettings,
+		optional.playerNameSettings,
+		optional.playerTokenSettings,
+		expectedVersion,
+	)
+
+	if err == nil {
+		err = models.DeleteOldGameVersions(tx, gameID, app.Config.GetInt("khan.gameVersions.retention"))
+	}
+	if err != nil {
+		log.E(logger, "Game update failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		app.Rollback(tx, "Game update failed", logger, err)
+		switch err.(type) {
+		case *models.VersionMismatchError, *models.MembershipLevelsInUseError:
+			return nil, err
 		}
+		return nil, withStatus(500, err)
+	}
+
+	err = app.Commit(tx, "Game update", logger)
+	if err != nil {
+		return nil, withStatus(500, err)
+	}
+
+	if app.MongoDB != nil && (previousGame == nil || previousGame.SearchSettings != game.SearchSettings) {
+		var previousSettings *models.SearchSettings
+		if previousGame != nil {
+			previousSettings = &previousGame.SearchSettings
+		}
+		app.enqueueClansReindex(gameID, previousSettings, &game.SearchSettings, logger)
+	}
+	if previousGame != nil {
+		app.enqueuePlayersMetadataEncryption(
+			gameID, previousGame.PlayerEncryptedMetadataFields, game.PlayerEncryptedMetadataFields, logger,
+		)
+		app.enqueuePlayersNamesHashing(gameID, &previousGame.PlayerNameSettings, &game.PlayerNameSettings, logger)
+	}
 
-		log.I(logger, "Game updated succesfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	successPayload := map[string]interface{}{
+		"publicID":                      gameID,
+		"name":                          payload.Name,
+		"membershipLevels":              payload.MembershipLevels,
+		"metadata":                      payload.Metadata,
+		"minLevelToAcceptApplication":   payload.MinLevelToAcceptApplication,
+		"minLevelToCreateInvitation":    payload.MinLevelToCreateInvitation,
+		"minLevelToRemoveMember":        payload.MinLevelToRemoveMember,
+		"minLevelOffsetToRemoveMember":  payload.MinLevelOffsetToRemoveMember,
+		"minLevelOffsetToPromoteMember": payload.MinLevelOffsetToPromoteMember,
+		"minLevelOffsetToDemoteMember":  payload.MinLevelOffsetToDemoteMember,
+		"maxMembers":                    payload.MaxMembers,
+		"maxClansPerPlayer":             payload.MaxClansPerPlayer,
+		"cooldownAfterDeny":             payload.CooldownAfterDeny,
+		"cooldownAfterDelete":           payload.CooldownAfterDelete,
+		"cooldownBeforeApply":           optional.cooldownBeforeApply,
+		"cooldownBeforeInvite":          optional.cooldownBeforeInvite,
+		"maxPendingInvites":             optional.maxPendingInvites,
+		"searchSettings":                optional.searchSettings,
+		"playerNameSettings":            optional.playerNameSettings,
+		"playerTokenSettings":           optional.playerTokenSettings,
+	}
+	dErr := app.DispatchHooks(gameID, models.GameUpdatedHook, successPayload)
+	if dErr != nil {
+		log.E(logger, "Game update hook dispatch failed.", func(cm log.CM) {
+			cm.Write(zap.Error(dErr))
 		})
+		return nil, withStatus(500, dErr)
+	}
 
-		return SucceedWith(map[string]interface{}{}, c)
+	log.I(logger, "Game updated succesfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return game, nil
+}
+
+//ListGamesHandler is the handler responsible for listing all games
+func ListGamesHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "ListGames")
+
+		logger := app.Logger.With(
+			zap.String("source", "gameHandler"),
+			zap.String("operation", "listGames"),
+		)
+
+		limit := 0
+		if val := c.QueryParam("limit"); val != "" {
+			parsed, err := strconv.ParseUint(val, 10, 32)
+			if err != nil || parsed == 0 {
+				return FailWith(400, fmt.Sprintf("Limit %s is not a positive number.", val), c)
+			}
+			limit = int(parsed)
+		}
+		offset := 0
+		if val := c.QueryParam("offset"); val != "" {
+			parsed, err := strconv.ParseUint(val, 10, 32)
+			if err != nil {
+				return FailWith(400, fmt.Sprintf("Offset %s is not a number.", val), c)
+			}
+			offset = int(parsed)
+		}
+
+		games, limit, err := listGames(c.StdContext(), app, logger, limit, offset)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+
+		serializedGames := make([]map[string]interface{}, len(games))
+		for i, game := range games {
+			serializedGames[i] = game.Serialize()
+		}
+
+		return SucceedWith(map[string]interface{}{
+			"games":  serializedGames,
+			"limit":  limit,
+			"offset": offset,
+		}, c)
+	}
+}
+
+// listGames returns a page of the games and its limit, which is the default limit if limit is zero
+func listGames(ctx context.Context, app *App, logger zap.Logger, limit, offset int) ([]*models.Game, int, error) {
+	start := time.Now()
+	if limit == 0 {
+		limit = app.Config.GetInt("khan.listGames.defaultLimit")
+	}
+	if maxLimit := app.Config.GetInt("khan.listGames.maxLimit"); limit > maxLimit {
+		return nil, 0, withStatus(400, fmt.Errorf("Limit above allowed (%v).", maxLimit))
 	}
+
+	log.D(logger, "Retrieving games...")
+	games, err := models.GetGames(app.Db(ctx), limit, offset)
+	if err != nil {
+		log.E(logger, "List games failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, 0, withStatus(500, err)
+	}
+
+	log.I(logger, "Games listed successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return games, limit, nil
+}
+
+//RetrieveGameHandler is the handler responsible for returning a game with all its configurations
+func RetrieveGameHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "RetrieveGame")
+		gameID := c.Param("gameID")
+
+		logger := app.Logger.With(
+			zap.String("source", "gameHandler"),
+			zap.String("operation", "retrieveGame"),
+			zap.String("gameID", gameID),
+		)
+
+		game, err := retrieveGame(c.StdContext(), app, logger, gameID)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+
+		setETag(c, game.Version)
+		return SucceedWith(game.Serialize(), c)
+	}
+}
+
+// retrieveGame returns the game with all its configurations
+func retrieveGame(ctx context.Context, app *App, logger zap.Logger, gameID string) (*models.Game, error) {
+	start := time.Now()
+	log.D(logger, "Retrieving game...")
+	game, err := models.GetGameByPublicID(app.Db(ctx), gameID)
+	if err != nil {
+		log.W(logger, "Retrieve game failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, err
+	}
+
+	log.I(logger, "Game retrieved successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return game, nil
+}
+
+//DeleteGameHandler is the handler responsible for deleting a game. The game is deleted by a background job
+func DeleteGameHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "DeleteGame")
+		gameID := c.Param("gameID")
+
+		logger := app.Logger.With(
+			zap.String("source", "gameHandler"),
+			zap.String("operation", "deleteGame"),
+			zap.String("gameID", gameID),
+		)
+
+		jobID, err := deleteGame(c.StdContext(), app, logger, gameID)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+
+		return c.JSON(http.StatusAccepted, map[string]interface{}{
+			"success": true,
+			"jobID":   jobID,
+		})
+	}
+}
+
+// deleteGame marks the game as deleting and returns the ID of the job that deletes it
+func deleteGame(ctx context.Context, app *App, logger zap.Logger, gameID string) (string, error) {
+	start := time.Now()
+	// writes to the game are rejected from now on, so its rows are not written while they are deleted
+	log.D(logger, "Marking game as deleting...")
+	err := models.MarkGameAsDeleting(app.Db(ctx), gameID)
+	if err != nil {
+		log.W(logger, "Mark game as deleting failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return "", err
+	}
+
+	log.D(logger, "Enqueuing game deletion...")
+	jobID, err := models.EnqueueGameDeletion(gameID)
+	if err != nil {
+		log.E(logger, "Enqueue game deletion failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return "", withStatus(500, err)
+	}
+
+	log.I(logger, "Game deletion enqueued successfully.", func(cm log.CM) {
+		cm.Write(
+			zap.String("jobID", jobID),
+			zap.Duration("duration", time.Now().Sub(start)),
+		)
+	})
+	return jobID, nil
+}
+
+//ListGameVersionsHandler is the handler responsible for listing the stored versions of a game
+func ListGameVersionsHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "ListGameVersions")
+		gameID := c.Param("gameID")
+
+		logger := app.Logger.With(
+			zap.String("source", "gameHandler"),
+			zap.String("operation", "listGameVersions"),
+			zap.String("gameID", gameID),
+		)
+
+		versions, err := listGameVersions(c.StdContext(), app, logger, gameID)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+
+		serializedVersions := make([]map[string]interface{}, len(versions))
+		for i, version := range versions {
+			serializedVersions[i], err = version.Serialize()
+			if err != nil {
+				log.E(logger, "Game version serialization failed.", func(cm log.CM) {
+					cm.Write(zap.Int64("version", version.Version), zap.Error(err))
+				})
+				return FailWith(500, err.Error(), c)
+			}
+		}
+
+		return SucceedWith(map[string]interface{}{
+			"versions": serializedVersions,
+		}, c)
+	}
+}
+
+// listGameVersions returns the stored versions of the game
+func listGameVersions(ctx context.Context, app *App, logger zap.Logger, gameID string) ([]*models.GameVersion, error) {
+	start := time.Now()
+	log.D(logger, "Retrieving game versions...")
+	versions, err := models.GetGameVersions(app.Db(ctx), gameID)
+	if err != nil {
+		log.W(logger, "Retrieve game versions failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, err
+	}
+
+	log.I(logger, "Game versions retrieved successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return versions, nil
+}
+
+//RollbackGameHandler is the handler responsible for restoring the configuration a game had at a previous version
+func RollbackGameHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "RollbackGame")
+		gameID := c.Param("gameID")
+
+		logger := app.Logger.With(
+			zap.String("source", "gameHandler"),
+			zap.String("operation", "rollbackGame"),
+			zap.String("gameID", gameID),
+		)
+
+		version, err := strconv.ParseInt(c.Param("version"), 10, 64)
+		if err != nil || version < 1 {
+			return FailWith(400, fmt.Sprintf("Invalid version %s.", c.Param("version")), c)
+		}
+
+		expectedVersion, err := getIfMatchVersion(c)
+		if err != nil {
+			return FailWith(400, err.Error(), c)
+		}
+
+		game, err := rollbackGame(c.StdContext(), app, logger, gameID, version, expectedVersion)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+
+		setETag(c, game.Version)
+		return SucceedWith(map[string]interface{}{
+			"version": game.Version,
+		}, c)
+	}
+}
+
+// rollbackGame restores the configuration the game had at version. expectedVersion is the version the game
+// must be at, zero for any version
+func rollbackGame(
+	ctx context.Context, app *App, logger zap.Logger, gameID string, version, expectedVersion int64,
+) (*models.Game, error) {
+	start := time.Now()
+	if version < 1 {
+		return nil, withStatus(400, fmt.Errorf("Invalid version %d.", version))
+	}
+
+	tx, err := app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, withStatus(500, err)
+	}
+
+	log.D(logger, "Retrieving game...")
+	previousGame, err := models.GetGameByPublicID(tx, gameID)
+	if err != nil {
+		log.W(logger, "Game retrieval failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		app.Rollback(tx, "Game retrieval failed", logger, err)
+		return nil, err
+	}
+
+	log.D(logger, "Rolling back game...", func(cm log.CM) {
+		cm.Write(zap.Int64("version", version))
+	})
+	game, err := models.RollbackGame(tx, gameID, version, expectedVersion)
+	if err == nil {
+		err = models.DeleteOldGameVersions(tx, gameID, app.Config.GetInt("khan.gameVersions.retention"))
+	}
+	if err != nil {
+		log.E(logger, "Game rollback failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		app.Rollback(tx, "Game rollback failed", logger, err)
+		return nil, err
+	}
+
+	err = app.Commit(tx, "Game rollback", logger)
+	if err != nil {
+		return nil, withStatus(500, err)
+	}
+
+	if app.MongoDB != nil && previousGame.SearchSettings != game.SearchSettings {
+		app.enqueueClansReindex(gameID, &previousGame.SearchSettings, &game.SearchSettings, logger)
+	}
+
+	dErr := app.DispatchHooks(gameID, models.GameUpdatedHook, game.Serialize())
+	if dErr != nil {
+		log.E(logger, "Game update hook dispatch failed.", func(cm log.CM) {
+			cm.Write(zap.Error(dErr))
+		})
+		return nil, withStatus(500, dErr)
+	}
+
+	log.I(logger, "Game rolled back succesfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return game, nil
 }
@@ -9,8 +9,11 @@ package api
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/labstack/echo"
+	"github.com/topfreegames/khan/log"
+	"github.com/topfreegames/khan/models"
 	"github.com/uber-go/zap"
 )
 
@@ -20,6 +23,57 @@ type optionalParams struct {
 	cooldownBeforeInvite                           int
 	clanUpdateMetadataFieldsHookTriggerWhitelist   string
 	playerUpdateMetadataFieldsHookTriggerWhitelist string
+	playerEncryptedMetadataFields                  string
+	searchSettings                                 *models.SearchSettings
+	playerNameSettings                             *models.PlayerNameSettings
+	playerTokenSettings                            *models.PlayerTokenSettings
+	// missing holds the parameters absent from the payload that keep their stored values on updates
+	missing map[string]bool
+}
+
+// keepStoredValues sets the parameters absent from the payload that keep their stored values on updates
+// to the values of the previous version of the game, which is nil for games that do not exist yet
+func (o *optionalParams) keepStoredValues(previous *models.Game) {
+	if previous == nil {
+		return
+	}
+	if o.missing["clanHookFieldsWhitelist"] {
+		o.clanUpdateMetadataFieldsHookTriggerWhitelist = previous.ClanUpdateMetadataFieldsHookTriggerWhitelist
+	}
+	if o.missing["playerHookFieldsWhitelist"] {
+		o.playerUpdateMetadataFieldsHookTriggerWhitelist = previous.PlayerUpdateMetadataFieldsHookTriggerWhitelist
+	}
+	if o.missing["playerEncryptedMetadataFields"] {
+		o.playerEncryptedMetadataFields = previous.PlayerEncryptedMetadataFields
+	}
+	if o.missing["playerNameSettings"] {
+		playerNameSettings := previous.PlayerNameSettings
+		o.playerNameSettings = &playerNameSettings
+	}
+}
+
+// defaultOptionalParams returns the optional parameters of a game payload that sets none of them, so that they
+// keep the configured defaults or, on updates, the stored values of the parameters in missing
+func defaultOptionalParams(app *App) *optionalParams {
+	return &optionalParams{
+		maxPendingInvites:    app.Config.GetInt("khan.maxPendingInvites"),
+		cooldownBeforeInvite: app.Config.GetInt("khan.defaultCooldownBeforeInvite"),
+		cooldownBeforeApply:  app.Config.GetInt("khan.defaultCooldownBeforeApply"),
+		searchSettings: &models.SearchSettings{
+			MinPrefixLength:    app.Config.GetInt("search.defaults.minPrefixLength"),
+			AccentSensitive:    app.Config.GetBool("search.defaults.accentSensitive"),
+			CaseSensitive:      app.Config.GetBool("search.defaults.caseSensitive"),
+			CJKNGramSize:       app.Config.GetInt("search.defaults.cjkNGramSize"),
+			NameWeight:         app.Config.GetInt("search.defaults.nameWeight"),
+			NamePrefixesWeight: app.Config.GetInt("search.defaults.namePrefixesWeight"),
+		},
+		playerNameSettings:  &models.PlayerNameSettings{},
+		playerTokenSettings: &models.PlayerTokenSettings{},
+		missing: map[string]bool{
+			"playerEncryptedMetadataFields": true,
+			"playerNameSettings":            true,
+		},
+	}
 }
 
 func getOptionalParameters(app *App, c echo.Context) (*optionalParams, error) {
@@ -34,48 +88,66 @@ func getOptionalParameters(app *App, c echo.Context) (*optionalParams, error) {
 		return nil, err
 	}
 
-	var maxPendingInvites int
+	optional := defaultOptionalParams(app)
 	if val, ok := jsonPayload["maxPendingInvites"]; ok {
-		maxPendingInvites = int(val.(float64))
-	} else {
-		maxPendingInvites = app.Config.GetInt("khan.maxPendingInvites")
+		optional.maxPendingInvites = int(val.(float64))
 	}
-
-	var cooldownBeforeInvite int
 	if val, ok := jsonPayload["cooldownBeforeInvite"]; ok {
-		cooldownBeforeInvite = int(val.(float64))
-	} else {
-		cooldownBeforeInvite = app.Config.GetInt("khan.defaultCooldownBeforeInvite")
+		optional.cooldownBeforeInvite = int(val.(float64))
 	}
-
-	var cooldownBeforeApply int
 	if val, ok := jsonPayload["cooldownBeforeApply"]; ok {
-		cooldownBeforeApply = int(val.(float64))
-	} else {
-		cooldownBeforeApply = app.Config.GetInt("khan.defaultCooldownBeforeApply")
+		optional.cooldownBeforeApply = int(val.(float64))
 	}
-
-	var clanWhitelist string
 	if val, ok := jsonPayload["clanHookFieldsWhitelist"]; ok {
-		clanWhitelist = val.(string)
-	} else {
-		clanWhitelist = ""
+		optional.clanUpdateMetadataFieldsHookTriggerWhitelist = val.(string)
 	}
-
-	var playerWhitelist string
 	if val, ok := jsonPayload["playerHookFieldsWhitelist"]; ok {
-		playerWhitelist = val.(string)
-	} else {
-		playerWhitelist = ""
+		optional.playerUpdateMetadataFieldsHookTriggerWhitelist = val.(string)
+	}
+	if val, ok := jsonPayload["playerEncryptedMetadataFields"]; ok {
+		optional.playerEncryptedMetadataFields = val.(string)
+		delete(optional.missing, "playerEncryptedMetadataFields")
 	}
 
-	return &optionalParams{
-		maxPendingInvites:                              maxPendingInvites,
-		cooldownBeforeInvite:                           cooldownBeforeInvite,
-		cooldownBeforeApply:                            cooldownBeforeApply,
-		clanUpdateMetadataFieldsHookTriggerWhitelist:   clanWhitelist,
-		playerUpdateMetadataFieldsHookTriggerWhitelist: playerWhitelist,
-	}, nil
+	if val, ok := jsonPayload["searchSettings"]; ok {
+		settingsJSON, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(settingsJSON, optional.searchSettings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if val, ok := jsonPayload["playerNameSettings"]; ok {
+		settingsJSON, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(settingsJSON, optional.playerNameSettings)
+		if err != nil {
+			return nil, err
+		}
+		delete(optional.missing, "playerNameSettings")
+	}
+
+	if val, ok := jsonPayload["playerTokenSettings"]; ok {
+		settingsJSON, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(settingsJSON, optional.playerTokenSettings)
+		if err != nil {
+			return nil, err
+		}
+		err = optional.playerTokenSettings.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return optional, nil
 }
 
 func getCreateGamePayload(app *App, c echo.Context, logger zap.Logger) (*CreateGamePayload, *optionalParams, error) {
@@ -90,3 +162,66 @@ func getCreateGamePayload(app *App, c echo.Context, logger zap.Logger) (*CreateG
 
 	return &payload, optional, nil
 }
+
+// enqueueClansReindex enqueues the reindex of the clans of a game after its search settings changed from
+// previous, which is nil for games that were just created. The game is already updated by then, so a
+// failure is logged instead of failing the request
+func (app *App) enqueueClansReindex(gameID string, previous, current *models.SearchSettings, logger zap.Logger) {
+	rebuildIndex := previous == nil || previous.TextIndexChanged(current)
+	log.I(logger, "Search settings changed, enqueuing clans reindex...", func(cm log.CM) {
+		cm.Write(zap.Bool("rebuildIndex", rebuildIndex))
+	})
+	_, err := models.EnqueueClansReindex(gameID, rebuildIndex)
+	if err != nil {
+		log.E(logger, "Enqueue clans reindex failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+	app.clanNamesCache.Invalidate(gameID)
+}
+
+// enqueuePlayersMetadataEncryption enqueues the encryption of the stored values of the player metadata
+// fields the game started encrypting at rest. The game is already updated by then, so a failure is logged
+// instead of failing the request
+func (app *App) enqueuePlayersMetadataEncryption(gameID, previousFields, currentFields string, logger zap.Logger) {
+	previous := map[string]bool{}
+	for _, field := range strings.Split(previousFields, ",") {
+		previous[strings.TrimSpace(field)] = true
+	}
+	var fields []string
+	for _, field := range strings.Split(currentFields, ",") {
+		field = strings.TrimSpace(field)
+		if field != "" && !previous[field] {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	log.I(logger, "Player encrypted metadata fields added, enqueuing players metadata encryption...", func(cm log.CM) {
+		cm.Write(zap.String("fields", strings.Join(fields, ",")))
+	})
+	_, err := models.EnqueuePlayersMetadataEncryption(gameID, fields)
+	if err != nil {
+		log.E(logger, "Enqueue players metadata encryption failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+}
+
+// enqueuePlayersNamesHashing enqueues the hashing of the stored player names if the game started requiring
+// unique player names. The game is already updated by then, so a failure is logged instead of failing the request
+func (app *App) enqueuePlayersNamesHashing(gameID string, previous, current *models.PlayerNameSettings, logger zap.Logger) {
+	if previous.Unique || !current.Unique {
+		return
+	}
+
+	log.I(logger, "Unique player names enabled, enqueuing players names hashing...")
+	_, err := models.EnqueuePlayersNamesHashing(gameID)
+	if err != nil {
+		log.E(logger, "Enqueue players names hashing failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+}
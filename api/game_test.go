@@ -105,6 +105,7 @@ var _ = Describe("Game API Handler", func() {
 			payload["cooldownBeforeInvite"] = 2384
 			payload["playerHookFieldsWhitelist"] = "a,b"
 			payload["clanHookFieldsWhitelist"] = "c,d"
+			payload["playerEncryptedMetadataFields"] = "email"
 			status, body := PostJSON(a, "/games", payload)
 
 			Expect(status).To(Equal(http.StatusOK))
@@ -120,6 +121,7 @@ var _ = Describe("Game API Handler", func() {
 			Expect(dbGame.MaxPendingInvites).To(Equal(27))
 			Expect(dbGame.PlayerUpdateMetadataFieldsHookTriggerWhitelist).To(Equal(payload["playerHookFieldsWhitelist"]))
 			Expect(dbGame.ClanUpdateMetadataFieldsHookTriggerWhitelist).To(Equal(payload["clanHookFieldsWhitelist"]))
+			Expect(dbGame.PlayerEncryptedMetadataFields).To(Equal("email"))
 		})
 
 		It("Should not create game if missing parameters", func() {
@@ -232,6 +234,43 @@ var _ = Describe("Game API Handler", func() {
 			Expect(dbGame.CooldownAfterDelete).To(Equal(payload["cooldownAfterDelete"]))
 		})
 
+		It("Should keep the player encrypted metadata fields if they are missing", func() {
+			game := fixtures.GameFactory.MustCreateWithOption(map[string]interface{}{
+				"PlayerEncryptedMetadataFields": "email",
+			}).(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := getGamePayload(game.PublicID, game.Name)
+			delete(payload, "playerEncryptedMetadataFields")
+
+			route := fmt.Sprintf("/games/%s", game.PublicID)
+			status, _ := PutJSON(a, route, payload)
+			Expect(status).To(Equal(http.StatusOK))
+
+			dbGame, err := models.GetGameByPublicID(db, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbGame.PlayerEncryptedMetadataFields).To(Equal("email"))
+		})
+
+		It("Should keep the player name settings if they are missing", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			game.PlayerNameSettings = models.PlayerNameSettings{KeepHistory: true, Unique: true}
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := getGamePayload(game.PublicID, game.Name)
+			delete(payload, "playerNameSettings")
+
+			route := fmt.Sprintf("/games/%s", game.PublicID)
+			status, _ := PutJSON(a, route, payload)
+			Expect(status).To(Equal(http.StatusOK))
+
+			dbGame, err := models.GetGameByPublicID(db, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbGame.PlayerNameSettings).To(Equal(game.PlayerNameSettings))
+		})
+
 		It("Should insert if game does not exist", func() {
 			gameID := uuid.NewV4().String()
 			payload := getGamePayload(gameID, gameID)
@@ -354,6 +393,202 @@ var _ = Describe("Game API Handler", func() {
 		})
 	})
 
+	Describe("List Games Handler", func() {
+		It("Should list games", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+			count, err := db.SelectInt("SELECT COUNT(*) FROM games")
+			Expect(err).NotTo(HaveOccurred())
+
+			// games are listed in creation order, so the last page has the game just created
+			status, body := Get(a, fmt.Sprintf("/games?limit=1&offset=%d", count-1))
+
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["limit"]).To(BeEquivalentTo(1))
+			Expect(result["offset"]).To(BeEquivalentTo(count - 1))
+
+			games := result["games"].([]interface{})
+			Expect(games).To(HaveLen(1))
+			Expect(games[0].(map[string]interface{})["publicID"]).To(Equal(game.PublicID))
+		})
+
+		It("Should not list games with an invalid limit", func() {
+			status, _ := Get(a, "/games?limit=0")
+			Expect(status).To(Equal(http.StatusBadRequest))
+
+			status, _ = Get(a, "/games?limit=100000")
+			Expect(status).To(Equal(http.StatusBadRequest))
+
+			status, _ = Get(a, "/games?offset=-1")
+			Expect(status).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("Retrieve Game Handler", func() {
+		It("Should retrieve game", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			status, body, etag := GetWithETag(a, fmt.Sprintf("/games/%s", game.PublicID))
+
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(etag).To(Equal(`"1"`))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["publicID"]).To(Equal(game.PublicID))
+			Expect(result["name"]).To(Equal(game.Name))
+			Expect(result["membershipLevels"]).To(HaveLen(len(game.MembershipLevels)))
+			Expect(int(result["maxMembers"].(float64))).To(Equal(game.MaxMembers))
+			Expect(int(result["cooldownAfterDelete"].(float64))).To(Equal(game.CooldownAfterDelete))
+			Expect(int(result["minLevelToRemoveMember"].(float64))).To(Equal(game.MinLevelToRemoveMember))
+		})
+
+		It("Should return 404 for invalid game", func() {
+			status, _ := Get(a, "/games/invalid-game")
+
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("Delete Game Handler", func() {
+		It("Should enqueue game deletion", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			header := http.Header{api.AdminTokenHeader: []string{"admin-token"}}
+			status, body, _ := doRequestWithHeader(a, "DELETE", fmt.Sprintf("/games/%s", game.PublicID), "", header)
+
+			Expect(status).To(Equal(http.StatusAccepted))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["jobID"]).NotTo(BeEmpty())
+
+			dbGame, err := models.GetGameByPublicID(db, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbGame.Deleting).To(BeTrue())
+		})
+
+		It("Should reject writes to a game being deleted", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+			err = models.MarkGameAsDeleting(db, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+
+			route := fmt.Sprintf("/games/%s/players", game.PublicID)
+			status, body := PostJSON(a, route, map[string]interface{}{
+				"publicID": uuid.NewV4().String(),
+				"name":     "player",
+				"metadata": map[string]interface{}{},
+			})
+			Expect(status).To(Equal(http.StatusConflict))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal(fmt.Sprintf("Game %s is being deleted", game.PublicID)))
+
+			status, _ = Get(a, fmt.Sprintf("/games/%s", game.PublicID))
+			Expect(status).To(Equal(http.StatusOK))
+		})
+
+		It("Should not delete game without admin token", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			status, _ := Delete(a, fmt.Sprintf("/games/%s", game.PublicID))
+			Expect(status).To(Equal(http.StatusUnauthorized))
+
+			header := http.Header{api.AdminTokenHeader: []string{"invalid-token"}}
+			status, _, _ = doRequestWithHeader(a, "DELETE", fmt.Sprintf("/games/%s", game.PublicID), "", header)
+			Expect(status).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("Should return 404 for invalid game", func() {
+			header := http.Header{api.AdminTokenHeader: []string{"admin-token"}}
+			status, _, _ := doRequestWithHeader(a, "DELETE", "/games/invalid-game", "", header)
+
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("Game Versions Handlers", func() {
+		It("Should list game versions", func() {
+			gameID := uuid.NewV4().String()
+			route := fmt.Sprintf("/games/%s", gameID)
+			status, _ := PutJSON(a, route, getGamePayload(gameID, "first"))
+			Expect(status).To(Equal(http.StatusOK))
+			status, _ = PutJSON(a, route, getGamePayload(gameID, "second"))
+			Expect(status).To(Equal(http.StatusOK))
+
+			status, body := Get(a, route+"/versions")
+
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			versions := result["versions"].([]interface{})
+			Expect(versions).To(HaveLen(2))
+			latest := versions[0].(map[string]interface{})
+			Expect(latest["version"]).To(BeEquivalentTo(2))
+			Expect(latest["game"].(map[string]interface{})["name"]).To(Equal("second"))
+		})
+
+		It("Should rollback game to a previous version", func() {
+			gameID := uuid.NewV4().String()
+			route := fmt.Sprintf("/games/%s", gameID)
+			status, _ := PutJSON(a, route, getGamePayload(gameID, "first"))
+			Expect(status).To(Equal(http.StatusOK))
+			status, _ = PutJSON(a, route, getGamePayload(gameID, "second"))
+			Expect(status).To(Equal(http.StatusOK))
+
+			status, body, _ := doRequestWithHeader(a, "POST", route+"/versions/1/rollback", "", http.Header{"If-Match": []string{`"2"`}})
+
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["version"]).To(BeEquivalentTo(3))
+
+			dbGame, err := models.GetGameByPublicID(db, gameID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbGame.Name).To(Equal("first"))
+		})
+
+		It("Should return 404 for invalid game version", func() {
+			gameID := uuid.NewV4().String()
+			route := fmt.Sprintf("/games/%s", gameID)
+			status, _ := PutJSON(a, route, getGamePayload(gameID, "first"))
+			Expect(status).To(Equal(http.StatusOK))
+
+			status, _ = Post(a, route+"/versions/10/rollback", "")
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+
+		It("Should not remove membership levels used by memberships", func() {
+			game, _, _, _, _, err := fixtures.GetClanWithMemberships(testDb, 2, 0, 0, 0, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := getGamePayload(game.PublicID, game.Name)
+			payload["membershipLevels"] = map[string]interface{}{"Elder": 2, "CoLeader": 3}
+			status, body := PutJSON(a, fmt.Sprintf("/games/%s", game.PublicID), payload)
+
+			Expect(status).To(Equal(http.StatusUnprocessableEntity))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(ContainSubstring("Member"))
+		})
+	})
+
 	Describe("Game Hooks", func() {
 		Describe("Update Game Hook", func() {
 			It("Should call update game hook", func() {
@@ -0,0 +1,951 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo"
+	"github.com/topfreegames/khan/pb"
+	"github.com/uber-go/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// grpcRoutes maps the methods of the Khan gRPC service to the method and path of the routes serving them
+var grpcRoutes = map[string]string{
+	"HealthCheck":                        "GET /healthcheck",
+	"Status":                             "GET /status",
+	"ListGames":                          "GET /games",
+	"CreateGame":                         "POST /games",
+	"RetrieveGame":                       "GET /games/:gameID",
+	"UpdateGame":                         "PUT /games/:gameID",
+	"DeleteGame":                         "DELETE /games/:gameID",
+	"ListGameVersions":                   "GET /games/:gameID/versions",
+	"RollbackGame":                       "POST /games/:gameID/versions/:version/rollback",
+	"ListAPIKeys":                        "GET /games/:gameID/api-keys",
+	"CreateAPIKey":                       "POST /games/:gameID/api-keys",
+	"RevokeAPIKey":                       "DELETE /games/:gameID/api-keys/:apiKeyPublicID",
+	"CreateHook":                         "POST /games/:gameID/hooks",
+	"RemoveHook":                         "DELETE /games/:gameID/hooks/:publicID",
+	"CreatePlayer":                       "POST /games/:gameID/players",
+	"UpsertPlayers":                      "PUT /games/:gameID/players",
+	"UpdatePlayer":                       "PUT /games/:gameID/players/:playerPublicID",
+	"PatchPlayer":                        "PATCH /games/:gameID/players/:playerPublicID",
+	"RetrievePlayer":                     "GET /games/:gameID/players/:playerPublicID",
+	"DeletePlayer":                       "DELETE /games/:gameID/players/:playerPublicID",
+	"ExportPlayer":                       "GET /games/:gameID/players/:playerPublicID/export",
+	"RetrievePlayerNameHistory":          "GET /games/:gameID/players/:playerPublicID/name-history",
+	"SearchClans":                        "GET /games/:gameID/clans/search",
+	"SuggestClans":                       "GET /games/:gameID/clans/suggest",
+	"ListClans":                          "GET /games/:gameID/clans",
+	"CreateClan":                         "POST /games/:gameID/clans",
+	"RetrieveClansSummaries":             "GET /games/:gameID/clans-summary",
+	"RetrieveClan":                       "GET /games/:gameID/clans/:clanPublicID",
+	"RetrieveClanMembers":                "GET /games/:gameID/clans/:clanPublicID/members",
+	"RetrieveClanSummary":                "GET /games/:gameID/clans/:clanPublicID/summary",
+	"UpdateClan":                         "PUT /games/:gameID/clans/:clanPublicID",
+	"PatchClan":                          "PATCH /games/:gameID/clans/:clanPublicID",
+	"LeaveClan":                          "POST /games/:gameID/clans/:clanPublicID/leave",
+	"TransferClanOwnership":              "POST /games/:gameID/clans/:clanPublicID/transfer-ownership",
+	"ApplyForMembership":                 "POST /games/:gameID/clans/:clanPublicID/memberships/application",
+	"ApproveOrDenyMembershipApplication": "POST /games/:gameID/clans/:clanPublicID/memberships/application/:action",
+	"InviteForMembership":                "POST /games/:gameID/clans/:clanPublicID/memberships/invitation",
+	"ApproveOrDenyMembershipInvitation":  "POST /games/:gameID/clans/:clanPublicID/memberships/invitation/:action",
+	"DeleteMembership":                   "POST /games/:gameID/clans/:clanPublicID/memberships/delete",
+	"PromoteMembership":                  "POST /games/:gameID/clans/:clanPublicID/memberships/promote",
+	"DemoteMembership":                   "POST /games/:gameID/clans/:clanPublicID/memberships/demote",
+}
+
+// grpcExcludedRoutes are the routes that are only served over HTTP
+var grpcExcludedRoutes = map[string]bool{
+	"GET /openapi.json":      true,
+	"GET /metrics":           true,
+	"GET /healthcheck/live":  true,
+	"GET /healthcheck/ready": true,
+}
+
+// GRPCUnmapped returns the routes without a method in the Khan gRPC service and the methods without a route.
+// Routes of the v2 API are served by the methods of their v1 routes
+func GRPCUnmapped(routes []echo.Route) []string {
+	var unmapped []string
+	mapped := map[string]bool{}
+	for _, route := range grpcRoutes {
+		mapped[route] = true
+	}
+	for _, route := range routes {
+		key := fmt.Sprintf("%s %s", route.Method, route.Path)
+		if strings.HasPrefix(route.Path, V2Prefix+"/") {
+			continue
+		}
+		if !mapped[key] && !grpcExcludedRoutes[key] {
+			unmapped = append(unmapped, key)
+		}
+	}
+	methods := pb.File_khan_proto.Services().ByName("Khan").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		name := string(methods.Get(i).Name())
+		if _, ok := grpcRoutes[name]; !ok {
+			unmapped = append(unmapped, name)
+		}
+	}
+	sort.Strings(unmapped)
+	return unmapped
+}
+
+// NewGRPCServer returns a gRPC server with the Khan service of the app registered
+func NewGRPCServer(app *App) *grpc.Server {
+	s := &GRPCServer{
+		App:           app,
+		basicAuthUser: app.Config.GetString("basicauth.username"),
+		basicAuthPass: app.Config.GetString("basicauth.password"),
+		gameDeletion:  NewGameDeletionMiddleware(app),
+		admin:         NewAdminMiddleware(app.Config.GetString("admin.token")),
+	}
+	if app.Config.GetBool("playerTokens.enabled") {
+		s.playerToken = NewPlayerTokenMiddleware(app)
+	}
+	if app.Config.GetBool("apiKeys.enabled") {
+		s.apiKey = NewAPIKeyMiddleware(app)
+	}
+	if app.Config.GetBool("rateLimit.enabled") {
+		s.rateLimit = NewRateLimitMiddleware(app, app.rateLimitStore)
+	}
+
+	server := grpc.NewServer(grpc.UnaryInterceptor(s.intercept))
+	pb.RegisterKhanServer(server, s)
+	return server
+}
+
+// GRPCServer implements the Khan gRPC service by running the operations of the HTTP routes, after the
+// checks of their middlewares
+type GRPCServer struct {
+	pb.UnimplementedKhanServer
+	App *App
+
+	basicAuthUser string
+	basicAuthPass string
+	playerToken   *PlayerTokenMiddleware
+	apiKey        *APIKeyMiddleware
+	rateLimit     *RateLimitMiddleware
+	gameDeletion  *GameDeletionMiddleware
+	admin         *AdminMiddleware
+}
+
+// startGRPC serves the Khan gRPC service at the configured port
+func (app *App) startGRPC() (*grpc.Server, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", app.Host, app.Config.GetInt("grpc.port")))
+	if err != nil {
+		return nil, err
+	}
+	server := NewGRPCServer(app)
+	go server.Serve(listener)
+	return server, nil
+}
+
+// logger returns the logger of an operation of the service
+func (s *GRPCServer) logger(operation string, fields ...zap.Field) zap.Logger {
+	return s.App.Logger.With(append([]zap.Field{
+		zap.String("source", "grpc"),
+		zap.String("operation", operation),
+	}, fields...)...)
+}
+
+// gameOptionalParams returns the optional parameters of the game settings, keeping the configured defaults, or
+// the stored values on updates, of the settings that are not set
+func gameOptionalParams(app *App, settings *pb.GameSettings) (*optionalParams, error) {
+	optional := defaultOptionalParams(app)
+	if settings.CooldownBeforeApply != nil {
+		optional.cooldownBeforeApply = int(settings.CooldownBeforeApply.Value)
+	}
+	if settings.CooldownBeforeInvite != nil {
+		optional.cooldownBeforeInvite = int(settings.CooldownBeforeInvite.Value)
+	}
+	if settings.MaxPendingInvites != nil {
+		optional.maxPendingInvites = int(settings.MaxPendingInvites.Value)
+	}
+	if settings.ClanHookFieldsWhitelist != nil {
+		optional.clanUpdateMetadataFieldsHookTriggerWhitelist = settings.ClanHookFieldsWhitelist.Value
+	} else {
+		optional.missing["clanHookFieldsWhitelist"] = true
+	}
+	if settings.PlayerHookFieldsWhitelist != nil {
+		optional.playerUpdateMetadataFieldsHookTriggerWhitelist = settings.PlayerHookFieldsWhitelist.Value
+	} else {
+		optional.missing["playerHookFieldsWhitelist"] = true
+	}
+	if settings.PlayerEncryptedMetadataFields != nil {
+		optional.playerEncryptedMetadataFields = settings.PlayerEncryptedMetadataFields.Value
+		delete(optional.missing, "playerEncryptedMetadataFields")
+	}
+
+	if search := settings.SearchSettings; search != nil {
+		if search.MinPrefixLength != nil {
+			optional.searchSettings.MinPrefixLength = int(search.MinPrefixLength.Value)
+		}
+		if search.AccentSensitive != nil {
+			optional.searchSettings.AccentSensitive = search.AccentSensitive.Value
+		}
+		if search.CaseSensitive != nil {
+			optional.searchSettings.CaseSensitive = search.CaseSensitive.Value
+		}
+		if search.CjkNGramSize != nil {
+			optional.searchSettings.CJKNGramSize = int(search.CjkNGramSize.Value)
+		}
+		if search.NameWeight != nil {
+			optional.searchSettings.NameWeight = int(search.NameWeight.Value)
+		}
+		if search.NamePrefixesWeight != nil {
+			optional.searchSettings.NamePrefixesWeight = int(search.NamePrefixesWeight.Value)
+		}
+	}
+
+	if names := settings.PlayerNameSettings; names != nil {
+		optional.playerNameSettings.KeepHistory = names.KeepHistory
+		optional.playerNameSettings.Unique = names.Unique
+		delete(optional.missing, "playerNameSettings")
+	}
+
+	if tokens := settings.PlayerTokenSettings; tokens != nil {
+		optional.playerTokenSettings.PublicKey = tokens.PublicKey
+		optional.playerTokenSettings.MaxTTL = int(tokens.MaxTtl)
+		if err := optional.playerTokenSettings.Validate(); err != nil {
+			return nil, withStatus(http.StatusBadRequest, err)
+		}
+	}
+	return optional, nil
+}
+
+// membershipLevels returns the membership levels of the game settings as they are decoded from JSON payloads
+func membershipLevels(settings *pb.GameSettings) map[string]interface{} {
+	if settings.MembershipLevels == nil {
+		return nil
+	}
+	levels := map[string]interface{}{}
+	for level, value := range settings.MembershipLevels {
+		levels[level] = float64(value)
+	}
+	return levels
+}
+
+// pendingLimit returns the limit of pending memberships of a clan details request, nil if it is not set
+func pendingLimit(name string, value *wrapperspb.UInt32Value) (*int, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if value.Value > math.MaxUint16 {
+		return nil, withStatus(http.StatusBadRequest, fmt.Errorf("%s %d is out of range.", name, value.Value))
+	}
+	limit := int(value.Value)
+	return &limit, nil
+}
+
+// HealthCheck serves GET /healthcheck
+func (s *GRPCServer) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
+	text, err := healthCheck(s.App, s.App.Db(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.HealthCheckResponse{Text: text}, nil
+}
+
+// Status serves GET /status
+func (s *GRPCServer) Status(ctx context.Context, req *pb.StatusRequest) (*pb.StatusResponse, error) {
+	response := &pb.StatusResponse{}
+	return response, grpcResponse(khanStatus(ctx, s.App), response)
+}
+
+// ListGames serves GET /games
+func (s *GRPCServer) ListGames(ctx context.Context, req *pb.ListGamesRequest) (*pb.ListGamesResponse, error) {
+	if req.Limit < 0 {
+		return nil, withStatus(http.StatusBadRequest, fmt.Errorf("Limit %d is not a positive number.", req.Limit))
+	}
+	if req.Offset < 0 {
+		return nil, withStatus(http.StatusBadRequest, fmt.Errorf("Offset %d is not a number.", req.Offset))
+	}
+
+	games, limit, err := listGames(ctx, s.App, s.logger("listGames"), int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	serializedGames := make([]map[string]interface{}, len(games))
+	for i, game := range games {
+		serializedGames[i] = game.Serialize()
+	}
+	response := &pb.ListGamesResponse{}
+	return response, grpcResponse(map[string]interface{}{
+		"games":  serializedGames,
+		"limit":  limit,
+		"offset": req.Offset,
+	}, response)
+}
+
+// CreateGame serves POST /games
+func (s *GRPCServer) CreateGame(ctx context.Context, req *pb.CreateGameRequest) (*pb.CreateGameResponse, error) {
+	settings := req.Game
+	if settings == nil {
+		settings = &pb.GameSettings{}
+	}
+	payload := &CreateGamePayload{
+		PublicID:                      req.PublicId,
+		Name:                          settings.Name,
+		MembershipLevels:              membershipLevels(settings),
+		Metadata:                      grpcMetadata(settings.Metadata),
+		MinLevelToAcceptApplication:   int(settings.MinLevelToAcceptApplication),
+		MinLevelToCreateInvitation:    int(settings.MinLevelToCreateInvitation),
+		MinLevelToRemoveMember:        int(settings.MinLevelToRemoveMember),
+		MinLevelOffsetToRemoveMember:  int(settings.MinLevelOffsetToRemoveMember),
+		MinLevelOffsetToPromoteMember: int(settings.MinLevelOffsetToPromoteMember),
+		MinLevelOffsetToDemoteMember:  int(settings.MinLevelOffsetToDemoteMember),
+		MaxMembers:                    int(settings.MaxMembers),
+		MaxClansPerPlayer:             int(settings.MaxClansPerPlayer),
+		CooldownAfterDeny:             int(settings.CooldownAfterDeny),
+		CooldownAfterDelete:           int(settings.CooldownAfterDelete),
+	}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+	optional, err := gameOptionalParams(s.App, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := createGame(ctx, s.App, s.logger("createGame"), payload, optional)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateGameResponse{PublicId: game.PublicID}, nil
+}
+
+// RetrieveGame serves GET /games/:gameID
+func (s *GRPCServer) RetrieveGame(ctx context.Context, req *pb.RetrieveGameRequest) (*pb.RetrieveGameResponse, error) {
+	logger := s.logger("retrieveGame", zap.String("gameID", req.GameId))
+	game, err := retrieveGame(ctx, s.App, logger, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.RetrieveGameResponse{Game: &pb.Game{}}
+	return response, grpcResponse(game.Serialize(), response.Game)
+}
+
+// UpdateGame serves PUT /games/:gameID
+func (s *GRPCServer) UpdateGame(ctx context.Context, req *pb.UpdateGameRequest) (*pb.UpdateGameResponse, error) {
+	settings := req.Game
+	if settings == nil {
+		settings = &pb.GameSettings{}
+	}
+	payload := &UpdateGamePayload{
+		Name:                          settings.Name,
+		MembershipLevels:              membershipLevels(settings),
+		Metadata:                      grpcMetadata(settings.Metadata),
+		MinLevelToAcceptApplication:   int(settings.MinLevelToAcceptApplication),
+		MinLevelToCreateInvitation:    int(settings.MinLevelToCreateInvitation),
+		MinLevelToRemoveMember:        int(settings.MinLevelToRemoveMember),
+		MinLevelOffsetToRemoveMember:  int(settings.MinLevelOffsetToRemoveMember),
+		MinLevelOffsetToPromoteMember: int(settings.MinLevelOffsetToPromoteMember),
+		MinLevelOffsetToDemoteMember:  int(settings.MinLevelOffsetToDemoteMember),
+		MaxMembers:                    int(settings.MaxMembers),
+		MaxClansPerPlayer:             int(settings.MaxClansPerPlayer),
+		CooldownAfterDeny:             int(settings.CooldownAfterDeny),
+		CooldownAfterDelete:           int(settings.CooldownAfterDelete),
+	}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+	optional, err := gameOptionalParams(s.App, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("updateGame", zap.String("gameID", req.GameId))
+	game, err := updateGame(ctx, s.App, logger, req.GameId, payload, optional, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateGameResponse{Version: game.Version}, nil
+}
+
+// DeleteGame serves DELETE /games/:gameID
+func (s *GRPCServer) DeleteGame(ctx context.Context, req *pb.DeleteGameRequest) (*pb.DeleteGameResponse, error) {
+	logger := s.logger("deleteGame", zap.String("gameID", req.GameId))
+	jobID, err := deleteGame(ctx, s.App, logger, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteGameResponse{JobId: jobID}, nil
+}
+
+// ListGameVersions serves GET /games/:gameID/versions
+func (s *GRPCServer) ListGameVersions(
+	ctx context.Context, req *pb.ListGameVersionsRequest,
+) (*pb.ListGameVersionsResponse, error) {
+	logger := s.logger("listGameVersions", zap.String("gameID", req.GameId))
+	versions, err := listGameVersions(ctx, s.App, logger, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+	serializedVersions := make([]map[string]interface{}, len(versions))
+	for i, version := range versions {
+		serializedVersions[i], err = version.Serialize()
+		if err != nil {
+			return nil, withStatus(http.StatusInternalServerError, err)
+		}
+	}
+	response := &pb.ListGameVersionsResponse{}
+	return response, grpcResponse(map[string]interface{}{"versions": serializedVersions}, response)
+}
+
+// RollbackGame serves POST /games/:gameID/versions/:version/rollback
+func (s *GRPCServer) RollbackGame(ctx context.Context, req *pb.RollbackGameRequest) (*pb.RollbackGameResponse, error) {
+	logger := s.logger("rollbackGame", zap.String("gameID", req.GameId), zap.Int64("version", req.Version))
+	game, err := rollbackGame(ctx, s.App, logger, req.GameId, req.Version, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RollbackGameResponse{Version: game.Version}, nil
+}
+
+// ListAPIKeys serves GET /games/:gameID/api-keys
+func (s *GRPCServer) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	logger := s.logger("listAPIKeys", zap.String("gameID", req.GameId))
+	apiKeys, err := listAPIKeys(ctx, s.App, logger, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+	serializedKeys := make([]map[string]interface{}, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		serializedKeys[i] = apiKey.Serialize()
+	}
+	response := &pb.ListAPIKeysResponse{}
+	return response, grpcResponse(map[string]interface{}{"apiKeys": serializedKeys}, response)
+}
+
+// CreateAPIKey serves POST /games/:gameID/api-keys
+func (s *GRPCServer) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	payload := &APIKeyPayload{Scopes: req.Scopes}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("createAPIKey", zap.String("gameID", req.GameId))
+	apiKey, key, err := createAPIKey(ctx, s.App, logger, req.GameId, payload)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.CreateAPIKeyResponse{ApiKey: &pb.APIKey{}, Key: key}
+	return response, grpcResponse(apiKey.Serialize(), response.ApiKey)
+}
+
+// RevokeAPIKey serves DELETE /games/:gameID/api-keys/:apiKeyPublicID
+func (s *GRPCServer) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	logger := s.logger("revokeAPIKey", zap.String("gameID", req.GameId), zap.String("apiKeyPublicID", req.ApiKeyPublicId))
+	if err := revokeAPIKey(ctx, s.App, logger, req.GameId, req.ApiKeyPublicId); err != nil {
+		return nil, err
+	}
+	return &pb.RevokeAPIKeyResponse{}, nil
+}
+
+// CreateHook serves POST /games/:gameID/hooks
+func (s *GRPCServer) CreateHook(ctx context.Context, req *pb.CreateHookRequest) (*pb.CreateHookResponse, error) {
+	payload := &HookPayload{Type: int(req.Type), HookURL: req.HookUrl}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("createHook", zap.String("gameID", req.GameId))
+	hook, err := createHook(ctx, s.App, logger, req.GameId, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateHookResponse{PublicId: hook.PublicID}, nil
+}
+
+// RemoveHook serves DELETE /games/:gameID/hooks/:publicID
+func (s *GRPCServer) RemoveHook(ctx context.Context, req *pb.RemoveHookRequest) (*pb.RemoveHookResponse, error) {
+	logger := s.logger("removeHook", zap.String("gameID", req.GameId), zap.String("hookPublicID", req.PublicId))
+	if err := removeHook(ctx, s.App, logger, req.GameId, req.PublicId); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveHookResponse{}, nil
+}
+
+// CreatePlayer serves POST /games/:gameID/players
+func (s *GRPCServer) CreatePlayer(ctx context.Context, req *pb.CreatePlayerRequest) (*pb.CreatePlayerResponse, error) {
+	payload := &CreatePlayerPayload{PublicID: req.PublicId, Name: req.Name, Metadata: grpcMetadata(req.Metadata)}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("createPlayer", zap.String("gameID", req.GameId))
+	player, err := createPlayer(ctx, s.App, logger, req.GameId, payload)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.CreatePlayerResponse{}
+	return response, grpcResponse(map[string]interface{}{
+		"gameID":   req.GameId,
+		"publicID": player.PublicID,
+		"name":     player.Name,
+		"metadata": player.Metadata,
+	}, response)
+}
+
+// UpsertPlayers serves PUT /games/:gameID/players
+func (s *GRPCServer) UpsertPlayers(ctx context.Context, req *pb.UpsertPlayersRequest) (*pb.UpsertPlayersResponse, error) {
+	payload := &UpsertPlayersPayload{Players: make([]*CreatePlayerPayload, len(req.Players))}
+	for i, player := range req.Players {
+		payload.Players[i] = &CreatePlayerPayload{
+			PublicID: player.PublicId,
+			Name:     player.Name,
+			Metadata: grpcMetadata(player.Metadata),
+		}
+	}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("upsertPlayers", zap.String("gameID", req.GameId))
+	result, err := upsertPlayers(ctx, s.App, logger, req.GameId, payload)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.UpsertPlayersResponse{}
+	return response, grpcResponse(result, response)
+}
+
+// UpdatePlayer serves PUT /games/:gameID/players/:playerPublicID
+func (s *GRPCServer) UpdatePlayer(ctx context.Context, req *pb.UpdatePlayerRequest) (*pb.UpdatePlayerResponse, error) {
+	payload := &UpdatePlayerPayload{Name: req.Name, Metadata: grpcMetadata(req.Metadata)}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("updatePlayer", zap.String("gameID", req.GameId), zap.String("playerPublicID", req.PlayerPublicId))
+	player, err := updatePlayer(ctx, s.App, logger, req.GameId, req.PlayerPublicId, payload, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdatePlayerResponse{Version: player.Version}, nil
+}
+
+// PatchPlayer serves PATCH /games/:gameID/players/:playerPublicID
+func (s *GRPCServer) PatchPlayer(ctx context.Context, req *pb.PatchPlayerRequest) (*pb.PatchPlayerResponse, error) {
+	payload := &PatchPlayerPayload{Name: req.Name, Metadata: grpcMetadata(req.Metadata)}
+
+	logger := s.logger("patchPlayer", zap.String("gameID", req.GameId), zap.String("playerPublicID", req.PlayerPublicId))
+	player, err := patchPlayer(ctx, s.App, logger, req.GameId, req.PlayerPublicId, payload, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.PatchPlayerResponse{}
+	return response, grpcResponse(map[string]interface{}{
+		"name":     player.Name,
+		"metadata": player.Metadata,
+		"version":  player.Version,
+	}, response)
+}
+
+// RetrievePlayer serves GET /games/:gameID/players/:playerPublicID
+func (s *GRPCServer) RetrievePlayer(ctx context.Context, req *pb.RetrievePlayerRequest) (*pb.RetrievePlayerResponse, error) {
+	logger := s.logger("retrievePlayer", zap.String("gameID", req.GameId), zap.String("playerPublicID", req.PlayerPublicId))
+	player, err := retrievePlayer(s.App, logger, req.GameId, req.PlayerPublicId)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.RetrievePlayerResponse{}
+	return response, grpcResponse(player, response)
+}
+
+// DeletePlayer serves DELETE /games/:gameID/players/:playerPublicID
+func (s *GRPCServer) DeletePlayer(ctx context.Context, req *pb.DeletePlayerRequest) (*pb.DeletePlayerResponse, error) {
+	logger := s.logger("deletePlayer", zap.String("gameID", req.GameId), zap.String("playerPublicID", req.PlayerPublicId))
+	clans, err := deletePlayer(ctx, s.App, logger, req.GameId, req.PlayerPublicId)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.DeletePlayerResponse{}
+	return response, grpcResponse(map[string]interface{}{"clans": clans}, response)
+}
+
+// ExportPlayer serves GET /games/:gameID/players/:playerPublicID/export
+func (s *GRPCServer) ExportPlayer(ctx context.Context, req *pb.ExportPlayerRequest) (*pb.ExportPlayerResponse, error) {
+	logger := s.logger("exportPlayer", zap.String("gameID", req.GameId), zap.String("playerPublicID", req.PlayerPublicId))
+	data, err := exportPlayer(ctx, s.App, logger, req.GameId, req.PlayerPublicId)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ExportPlayerResponse{}
+	return response, grpcResponse(data, response)
+}
+
+// RetrievePlayerNameHistory serves GET /games/:gameID/players/:playerPublicID/name-history
+func (s *GRPCServer) RetrievePlayerNameHistory(
+	ctx context.Context, req *pb.RetrievePlayerNameHistoryRequest,
+) (*pb.RetrievePlayerNameHistoryResponse, error) {
+	logger := s.logger(
+		"retrievePlayerNameHistory", zap.String("gameID", req.GameId), zap.String("playerPublicID", req.PlayerPublicId),
+	)
+	history, err := retrievePlayerNameHistory(ctx, s.App, logger, req.GameId, req.PlayerPublicId)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.RetrievePlayerNameHistoryResponse{}
+	return response, grpcResponse(map[string]interface{}{"nameHistory": history}, response)
+}
+
+// SearchClans serves GET /games/:gameID/clans/search
+func (s *GRPCServer) SearchClans(ctx context.Context, req *pb.SearchClansRequest) (*pb.SearchClansResponse, error) {
+	logger := s.logger("searchClans", zap.String("gameID", req.GameId), zap.String("term", req.Term))
+	clans, err := searchClans(ctx, s.App, logger, req.GameId, req.Term)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.SearchClansResponse{}
+	return response, grpcResponse(map[string]interface{}{"clans": clans}, response)
+}
+
+// SuggestClans serves GET /games/:gameID/clans/suggest
+func (s *GRPCServer) SuggestClans(ctx context.Context, req *pb.SuggestClansRequest) (*pb.SuggestClansResponse, error) {
+	logger := s.logger("suggestClans", zap.String("gameID", req.GameId), zap.String("prefix", req.Prefix))
+	clans, err := suggestClans(ctx, s.App, logger, req.GameId, req.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.SuggestClansResponse{}
+	return response, grpcResponse(map[string]interface{}{"clans": clans}, response)
+}
+
+// ListClans serves GET /games/:gameID/clans
+func (s *GRPCServer) ListClans(ctx context.Context, req *pb.ListClansRequest) (*pb.ListClansResponse, error) {
+	clans, err := listClans(ctx, s.App, s.logger("listClans", zap.String("gameID", req.GameId)), req.GameId)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ListClansResponse{}
+	return response, grpcResponse(map[string]interface{}{"clans": clans}, response)
+}
+
+// CreateClan serves POST /games/:gameID/clans
+func (s *GRPCServer) CreateClan(ctx context.Context, req *pb.CreateClanRequest) (*pb.CreateClanResponse, error) {
+	payload := &CreateClanPayload{
+		PublicID:         req.PublicId,
+		Name:             req.Name,
+		OwnerPublicID:    req.OwnerPublicId,
+		Metadata:         grpcMetadata(req.Metadata),
+		AllowApplication: req.AllowApplication,
+		AutoJoin:         req.AutoJoin,
+	}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("createClan", zap.String("gameID", req.GameId))
+	clan, err := createClan(ctx, s.App, logger, req.GameId, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateClanResponse{PublicId: clan.PublicID}, nil
+}
+
+// RetrieveClansSummaries serves GET /games/:gameID/clans-summary
+func (s *GRPCServer) RetrieveClansSummaries(
+	ctx context.Context, req *pb.RetrieveClansSummariesRequest,
+) (*pb.RetrieveClansSummariesResponse, error) {
+	logger := s.logger("retrieveClansSummaries", zap.String("gameID", req.GameId))
+	var publicIDs []string
+	if len(req.ClanPublicIds) > 0 {
+		publicIDs = req.ClanPublicIds
+	}
+	result, err := retrieveClansSummaries(s.App, logger, req.GameId, publicIDs)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.RetrieveClansSummariesResponse{}
+	return response, grpcResponse(result, response)
+}
+
+// RetrieveClan serves GET /games/:gameID/clans/:clanPublicID
+func (s *GRPCServer) RetrieveClan(ctx context.Context, req *pb.RetrieveClanRequest) (*pb.RetrieveClanResponse, error) {
+	maxPendingApplications, err := pendingLimit("maxPendingApplications", req.MaxPendingApplications)
+	if err != nil {
+		return nil, err
+	}
+	maxPendingInvites, err := pendingLimit("maxPendingInvites", req.MaxPendingInvites)
+	if err != nil {
+		return nil, err
+	}
+	options, err := getClanDetailsOptions(
+		s.App, maxPendingApplications, maxPendingInvites, req.PendingApplicationsOrder, req.PendingInvitesOrder,
+	)
+	if err != nil {
+		return nil, withStatus(http.StatusBadRequest, err)
+	}
+
+	logger := s.logger("retrieveClan", zap.String("gameID", req.GameId), zap.String("clanPublicID", req.ClanPublicId))
+	clan, err := retrieveClan(ctx, s.App, logger, req.GameId, req.ClanPublicId, req.ShortId, options)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.RetrieveClanResponse{}
+	return response, grpcResponse(clan, response)
+}
+
+// RetrieveClanMembers serves GET /games/:gameID/clans/:clanPublicID/members
+func (s *GRPCServer) RetrieveClanMembers(
+	ctx context.Context, req *pb.RetrieveClanMembersRequest,
+) (*pb.RetrieveClanMembersResponse, error) {
+	logger := s.logger("retrieveClanMembers", zap.String("gameID", req.GameId), zap.String("clanPublicID", req.ClanPublicId))
+	members, err := retrieveClanMembers(ctx, s.App, logger, req.GameId, req.ClanPublicId)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.RetrieveClanMembersResponse{}
+	return response, grpcResponse(members, response)
+}
+
+// RetrieveClanSummary serves GET /games/:gameID/clans/:clanPublicID/summary
+func (s *GRPCServer) RetrieveClanSummary(
+	ctx context.Context, req *pb.RetrieveClanSummaryRequest,
+) (*pb.RetrieveClanSummaryResponse, error) {
+	logger := s.logger("retrieveClanSummary", zap.String("gameID", req.GameId), zap.String("clanPublicID", req.ClanPublicId))
+	summary, err := retrieveClanSummary(s.App, logger, req.GameId, req.ClanPublicId)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.RetrieveClanSummaryResponse{}
+	return response, grpcResponse(summary, response)
+}
+
+// UpdateClan serves PUT /games/:gameID/clans/:clanPublicID
+func (s *GRPCServer) UpdateClan(ctx context.Context, req *pb.UpdateClanRequest) (*pb.UpdateClanResponse, error) {
+	payload := &UpdateClanPayload{
+		Name:             req.Name,
+		OwnerPublicID:    req.OwnerPublicId,
+		Metadata:         grpcMetadata(req.Metadata),
+		AllowApplication: req.AllowApplication,
+		AutoJoin:         req.AutoJoin,
+	}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("updateClan", zap.String("gameID", req.GameId), zap.String("clanPublicID", req.ClanPublicId))
+	clan, err := updateClan(ctx, s.App, logger, req.GameId, req.ClanPublicId, payload, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateClanResponse{Version: clan.Version}, nil
+}
+
+// PatchClan serves PATCH /games/:gameID/clans/:clanPublicID
+func (s *GRPCServer) PatchClan(ctx context.Context, req *pb.PatchClanRequest) (*pb.PatchClanResponse, error) {
+	payload := &PatchClanPayload{OwnerPublicID: req.OwnerPublicId, Metadata: grpcMetadata(req.Metadata)}
+	if req.Name != nil {
+		payload.Name = &req.Name.Value
+	}
+	if req.AllowApplication != nil {
+		payload.AllowApplication = &req.AllowApplication.Value
+	}
+	if req.AutoJoin != nil {
+		payload.AutoJoin = &req.AutoJoin.Value
+	}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("patchClan", zap.String("gameID", req.GameId), zap.String("clanPublicID", req.ClanPublicId))
+	clan, clanJSON, err := patchClan(
+		ctx, s.App, logger, req.GameId, req.ClanPublicId, payload, req.ClearMetadata, req.ExpectedVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.PatchClanResponse{}
+	return response, grpcResponse(map[string]interface{}{
+		"clan":    clanJSON,
+		"version": clan.Version,
+	}, response)
+}
+
+// LeaveClan serves POST /games/:gameID/clans/:clanPublicID/leave
+func (s *GRPCServer) LeaveClan(ctx context.Context, req *pb.LeaveClanRequest) (*pb.LeaveClanResponse, error) {
+	logger := s.logger("leaveClan", zap.String("gameID", req.GameId), zap.String("clanPublicID", req.ClanPublicId))
+	result, err := leaveClan(ctx, s.App, logger, req.GameId, req.ClanPublicId)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.LeaveClanResponse{}
+	return response, grpcResponse(result, response)
+}
+
+// TransferClanOwnership serves POST /games/:gameID/clans/:clanPublicID/transfer-ownership
+func (s *GRPCServer) TransferClanOwnership(
+	ctx context.Context, req *pb.TransferClanOwnershipRequest,
+) (*pb.TransferClanOwnershipResponse, error) {
+	payload := &TransferClanOwnershipPayload{PlayerPublicID: req.PlayerPublicId}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("transferClanOwnership", zap.String("gameID", req.GameId), zap.String("clanPublicID", req.ClanPublicId))
+	result, err := transferClanOwnership(ctx, s.App, logger, req.GameId, req.ClanPublicId, payload)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.TransferClanOwnershipResponse{}
+	return response, grpcResponse(result, response)
+}
+
+// ApplyForMembership serves POST /games/:gameID/clans/:clanPublicID/memberships/application
+func (s *GRPCServer) ApplyForMembership(
+	ctx context.Context, req *pb.ApplyForMembershipRequest,
+) (*pb.ApplyForMembershipResponse, error) {
+	payload := &ApplyForMembershipPayload{Level: req.Level, PlayerPublicID: req.PlayerPublicId}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("applyForMembership", zap.String("gameID", req.GameId), zap.String("clanPublicID", req.ClanPublicId))
+	membership, err := applyForMembership(ctx, s.App, logger, req.GameId, req.ClanPublicId, payload, req.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApplyForMembershipResponse{Approved: membership.Approved}, nil
+}
+
+// ApproveOrDenyMembershipApplication serves POST /games/:gameID/clans/:clanPublicID/memberships/application/:action
+func (s *GRPCServer) ApproveOrDenyMembershipApplication(
+	ctx context.Context, req *pb.ApproveOrDenyMembershipApplicationRequest,
+) (*pb.ApproveOrDenyMembershipApplicationResponse, error) {
+	payload := &BasePayloadWithRequestorAndPlayerPublicIDs{
+		PlayerPublicID:    req.PlayerPublicId,
+		RequestorPublicID: req.RequestorPublicId,
+	}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger(
+		"approveOrDenyMembershipApplication",
+		zap.String("gameID", req.GameId),
+		zap.String("clanPublicID", req.ClanPublicId),
+		zap.String("action", req.Action),
+	)
+	err := approveOrDenyMembershipApplication(ctx, s.App, logger, req.GameId, req.ClanPublicId, req.Action, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApproveOrDenyMembershipApplicationResponse{}, nil
+}
+
+// InviteForMembership serves POST /games/:gameID/clans/:clanPublicID/memberships/invitation
+func (s *GRPCServer) InviteForMembership(
+	ctx context.Context, req *pb.InviteForMembershipRequest,
+) (*pb.InviteForMembershipResponse, error) {
+	payload := &InviteForMembershipPayload{
+		Level:             req.Level,
+		PlayerPublicID:    req.PlayerPublicId,
+		RequestorPublicID: req.RequestorPublicId,
+	}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("inviteForMembership", zap.String("gameID", req.GameId), zap.String("clanPublicID", req.ClanPublicId))
+	if err := inviteForMembership(ctx, s.App, logger, req.GameId, req.ClanPublicId, payload, req.Message); err != nil {
+		return nil, err
+	}
+	return &pb.InviteForMembershipResponse{}, nil
+}
+
+// ApproveOrDenyMembershipInvitation serves POST /games/:gameID/clans/:clanPublicID/memberships/invitation/:action
+func (s *GRPCServer) ApproveOrDenyMembershipInvitation(
+	ctx context.Context, req *pb.ApproveOrDenyMembershipInvitationRequest,
+) (*pb.ApproveOrDenyMembershipInvitationResponse, error) {
+	payload := &ApproveOrDenyMembershipInvitationPayload{PlayerPublicID: req.PlayerPublicId}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger(
+		"approveOrDenyMembershipInvitation",
+		zap.String("gameID", req.GameId),
+		zap.String("clanPublicID", req.ClanPublicId),
+		zap.String("action", req.Action),
+	)
+	err := approveOrDenyMembershipInvitation(ctx, s.App, logger, req.GameId, req.ClanPublicId, req.Action, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApproveOrDenyMembershipInvitationResponse{}, nil
+}
+
+// DeleteMembership serves POST /games/:gameID/clans/:clanPublicID/memberships/delete
+func (s *GRPCServer) DeleteMembership(
+	ctx context.Context, req *pb.DeleteMembershipRequest,
+) (*pb.DeleteMembershipResponse, error) {
+	payload := &BasePayloadWithRequestorAndPlayerPublicIDs{
+		PlayerPublicID:    req.PlayerPublicId,
+		RequestorPublicID: req.RequestorPublicId,
+	}
+	if err := validate(payload); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger("deleteMembership", zap.String("gameID", req.GameId), zap.String("clanPublicID", req.ClanPublicId))
+	if err := deleteMembership(ctx, s.App, logger, req.GameId, req.ClanPublicId, payload); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteMembershipResponse{}, nil
+}
+
+// PromoteMembership serves POST /games/:gameID/clans/:clanPublicID/memberships/promote
+func (s *GRPCServer) PromoteMembership(
+	ctx context.Context, req *pb.PromoteMembershipRequest,
+) (*pb.PromoteMembershipResponse, error) {
+	level, err := s.promoteOrDemoteMembership(ctx, "promote", req.GameId, req.ClanPublicId, req.PlayerPublicId, req.RequestorPublicId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PromoteMembershipResponse{Level: level}, nil
+}
+
+// DemoteMembership serves POST /games/:gameID/clans/:clanPublicID/memberships/demote
+func (s *GRPCServer) DemoteMembership(
+	ctx context.Context, req *pb.DemoteMembershipRequest,
+) (*pb.DemoteMembershipResponse, error) {
+	level, err := s.promoteOrDemoteMembership(ctx, "demote", req.GameId, req.ClanPublicId, req.PlayerPublicId, req.RequestorPublicId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DemoteMembershipResponse{Level: level}, nil
+}
+
+// promoteOrDemoteMembership promotes or demotes, as told by action, the membership of the player
+func (s *GRPCServer) promoteOrDemoteMembership(
+	ctx context.Context, action, gameID, clanPublicID, playerPublicID, requestorPublicID string,
+) (string, error) {
+	payload := &BasePayloadWithRequestorAndPlayerPublicIDs{
+		PlayerPublicID:    playerPublicID,
+		RequestorPublicID: requestorPublicID,
+	}
+	if err := validate(payload); err != nil {
+		return "", err
+	}
+
+	logger := s.logger(
+		fmt.Sprintf("%sMembership", action), zap.String("gameID", gameID), zap.String("clanPublicID", clanPublicID),
+	)
+	return promoteOrDemoteMembership(ctx, s.App, logger, gameID, clanPublicID, action, payload)
+}
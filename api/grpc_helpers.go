@@ -0,0 +1,449 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"reflect"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/topfreegames/khan/log"
+	"github.com/uber-go/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// grpcAdminRoutes are the routes served with the admin middleware
+var grpcAdminRoutes = map[string]bool{
+	"DELETE /games/:gameID":                         true,
+	"GET /games/:gameID/api-keys":                   true,
+	"POST /games/:gameID/api-keys":                  true,
+	"DELETE /games/:gameID/api-keys/:apiKeyPublicID": true,
+}
+
+// grpcRequest is a call to a method of the Khan gRPC service, as the middlewares check it. Params and body
+// fields are the fields of the request message with the same JSON names
+type grpcRequest struct {
+	ctx     context.Context
+	method  string
+	path    string
+	message protoreflect.Message
+	md      metadata.MD
+}
+
+func (r *grpcRequest) Context() context.Context {
+	return r.ctx
+}
+
+func (r *grpcRequest) Method() string {
+	return r.method
+}
+
+func (r *grpcRequest) Path() string {
+	return r.path
+}
+
+func (r *grpcRequest) Param(name string) string {
+	fd := r.message.Descriptor().Fields().ByJSONName(name)
+	if fd == nil || fd.IsList() || fd.IsMap() {
+		return ""
+	}
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return r.message.Get(fd).String()
+	case protoreflect.Int64Kind:
+		if version := r.message.Get(fd).Int(); version != 0 {
+			return strconv.FormatInt(version, 10)
+		}
+	}
+	return ""
+}
+
+func (r *grpcRequest) Header(name string) string {
+	if values := r.md.Get(name); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (r *grpcRequest) BodyField(name string) (string, error) {
+	return r.Param(name), nil
+}
+
+func (r *grpcRequest) RealIP() string {
+	if ip := r.Header("X-Forwarded-For"); ip != "" {
+		return ip
+	}
+	if ip := r.Header("X-Real-IP"); ip != "" {
+		return ip
+	}
+	if p, ok := peer.FromContext(r.ctx); ok {
+		ip, _, err := net.SplitHostPort(p.Addr.String())
+		if err == nil {
+			return ip
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// intercept runs the checks of the middlewares of the route of the called method before the method: required
+// path parameters, player tokens, basic auth, API keys, rate limits, game deletions and admin tokens. Errors of
+// the checks and of the method are returned with the gRPC code of their HTTP status
+func (s *GRPCServer) intercept(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (response interface{}, err error) {
+	method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	route, ok := grpcRoutes[method]
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "method %s is not implemented", method)
+	}
+	parts := strings.SplitN(route, " ", 2)
+	md, _ := metadata.FromIncomingContext(ctx)
+	r := &grpcRequest{ctx: ctx, method: parts[0], path: parts[1], message: req.(proto.Message).ProtoReflect(), md: md}
+
+	start := time.Now()
+	defer func() {
+		if e := recover(); e != nil {
+			pErr, ok := e.(error)
+			if !ok {
+				pErr = fmt.Errorf("%v", e)
+			}
+			s.App.onErrorHandler(pErr, debug.Stack())
+			response, err = nil, withStatus(http.StatusInternalServerError, pErr)
+		}
+
+		httpStatus := http.StatusOK
+		if err != nil {
+			httpStatus = errorStatus(err)
+			log.D(s.App.Logger, "Request failed.", func(cm log.CM) {
+				cm.Write(
+					zap.String("source", "grpc"),
+					zap.String("method", method),
+					zap.Int("statusCode", httpStatus),
+					zap.Error(err),
+				)
+			})
+			err = status.Error(grpcCode(httpStatus), err.Error())
+		}
+		s.App.Metrics.RequestDuration.WithLabelValues(
+			r.path, r.method, strconv.Itoa(httpStatus),
+		).Observe(time.Since(start).Seconds())
+	}()
+
+	if err := s.check(r, route); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// check runs the checks of the middlewares of the route on the request
+func (s *GRPCServer) check(r *grpcRequest, route string) error {
+	for _, segment := range strings.Split(r.path, "/") {
+		if strings.HasPrefix(segment, ":") && r.Param(segment[1:]) == "" {
+			return withStatus(http.StatusBadRequest, fmt.Errorf("%s is required", segment[1:]))
+		}
+	}
+
+	var subject string
+	if s.playerToken != nil {
+		var err error
+		if subject, err = s.playerToken.verify(r); err != nil {
+			return err
+		}
+	}
+	if err := s.basicAuth(r, subject); err != nil {
+		return err
+	}
+	var apiKeyPublicID string
+	if s.apiKey != nil {
+		var err error
+		if apiKeyPublicID, err = s.apiKey.authorize(r, subject); err != nil {
+			return err
+		}
+	}
+	if s.rateLimit != nil {
+		basicAuthUser := verifiedBasicAuthUser(r, s.rateLimit.BasicAuthUser)
+		retryAfter, err := s.rateLimit.take(r, rateLimitRequestor(r, subject, apiKeyPublicID, basicAuthUser))
+		if err != nil {
+			if retryAfter > 0 {
+				grpc.SetHeader(r.ctx, metadata.Pairs("retry-after", strconv.FormatInt(retryAfter, 10)))
+			}
+			return err
+		}
+	}
+	if err := s.gameDeletion.check(r); err != nil {
+		return err
+	}
+	if grpcAdminRoutes[route] {
+		return s.admin.authorize(r)
+	}
+	return nil
+}
+
+// basicAuth returns an error if basic auth is configured and the request does not send its credentials in
+// the authorization metadata. Requests of verified player tokens and healthchecks are allowed, as over HTTP
+func (s *GRPCServer) basicAuth(r *grpcRequest, playerTokenSubject string) error {
+	if s.basicAuthUser == "" || playerTokenSubject != "" || strings.HasPrefix(r.path, "/healthcheck") {
+		return nil
+	}
+	req := &http.Request{Header: http.Header{"Authorization": {r.Header("authorization")}}}
+	username, password, ok := req.BasicAuth()
+	if !ok || username != s.basicAuthUser || password != s.basicAuthPass {
+		return withStatus(http.StatusUnauthorized, errors.New(http.StatusText(http.StatusUnauthorized)))
+	}
+	return nil
+}
+
+// grpcCode returns the gRPC status code of an HTTP status code
+func grpcCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	if httpStatus >= 500 {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
+
+// validate returns the validation errors of the payload as a bad request, as LoadJSONPayload does
+func validate(payload Validatable) error {
+	if errs := payload.Validate(); len(errs) != 0 {
+		return withStatus(http.StatusBadRequest, errors.New(strings.Join(errs, ", ")))
+	}
+	return nil
+}
+
+// grpcMetadata returns the metadata of a payload, which is nil if it is not set
+func grpcMetadata(metadata *structpb.Struct) map[string]interface{} {
+	if metadata == nil {
+		return nil
+	}
+	return metadata.AsMap()
+}
+
+// grpcResponse sets the fields of the response to the fields of the result of an operation with the same
+// JSON names, so that RPCs answer with the fields of the JSON responses of their routes. Fields of result that
+// the response does not have are left out, and results that do not match the types of the response fail
+func grpcResponse(result interface{}, response proto.Message) error {
+	if err := setMessageFields(response.ProtoReflect(), reflect.ValueOf(result)); err != nil {
+		return withStatus(http.StatusInternalServerError, fmt.Errorf(
+			"Response does not match %s: %s", response.ProtoReflect().Descriptor().Name(), err,
+		))
+	}
+	return nil
+}
+
+// setMessageFields sets the fields of m to the fields of value, a map with string keys or a struct with json
+// tags, with the same JSON names
+func setMessageFields(m protoreflect.Message, value reflect.Value) error {
+	value = indirect(value)
+	fields := m.Descriptor().Fields()
+	set := func(name string, v reflect.Value) error {
+		fd := fields.ByJSONName(name)
+		v = indirect(v)
+		if fd == nil || !v.IsValid() {
+			return nil
+		}
+		if err := setField(m, fd, v); err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("%s is not an object", value.Type())
+		}
+		iter := value.MapRange()
+		for iter.Next() {
+			if err := set(iter.Key().String(), iter.Value()); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		t := value.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = t.Field(i).Name
+			}
+			if err := set(name, value.Field(i)); err != nil {
+				return err
+			}
+		}
+	default:
+		if !value.IsValid() {
+			return nil
+		}
+		return fmt.Errorf("%s is not an object", value.Type())
+	}
+	return nil
+}
+
+// setField sets the field of m to v
+func setField(m protoreflect.Message, fd protoreflect.FieldDescriptor, v reflect.Value) error {
+	switch {
+	case fd.IsList():
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return fmt.Errorf("%s is not a list", v.Type())
+		}
+		list := m.Mutable(fd).List()
+		for i := 0; i < v.Len(); i++ {
+			item := indirect(v.Index(i))
+			if !item.IsValid() {
+				continue
+			}
+			value, err := protoValue(fd, list.NewElement, item)
+			if err != nil {
+				return fmt.Errorf("%d: %s", i, err)
+			}
+			list.Append(value)
+		}
+		return nil
+	case fd.IsMap():
+		if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("%s is not an object", v.Type())
+		}
+		fields := m.Mutable(fd).Map()
+		iter := v.MapRange()
+		for iter.Next() {
+			item := indirect(iter.Value())
+			if !item.IsValid() {
+				continue
+			}
+			value, err := protoValue(fd.MapValue(), fields.NewValue, item)
+			if err != nil {
+				return fmt.Errorf("%s: %s", iter.Key().String(), err)
+			}
+			fields.Set(protoreflect.ValueOfString(iter.Key().String()).MapKey(), value)
+		}
+		return nil
+	}
+	value, err := protoValue(fd, func() protoreflect.Value { return m.NewField(fd) }, v)
+	if err != nil {
+		return err
+	}
+	m.Set(fd, value)
+	return nil
+}
+
+// protoValue returns v as a value of the kind of fd. newMessage returns an empty value of message fields
+func protoValue(fd protoreflect.FieldDescriptor, newMessage func() protoreflect.Value, v reflect.Value) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() == "google.protobuf.Struct" {
+			fields, ok := v.Interface().(map[string]interface{})
+			if !ok {
+				break
+			}
+			s, err := structpb.NewStruct(fields)
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			return protoreflect.ValueOfMessage(s.ProtoReflect()), nil
+		}
+		value := newMessage()
+		if err := setMessageFields(value.Message(), v); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return value, nil
+	case protoreflect.StringKind:
+		if v.Kind() == reflect.String {
+			return protoreflect.ValueOfString(v.String()), nil
+		}
+	case protoreflect.BoolKind:
+		if v.Kind() == reflect.Bool {
+			return protoreflect.ValueOfBool(v.Bool()), nil
+		}
+	case protoreflect.Int32Kind:
+		if n, ok := integerValue(v); ok && n >= math.MinInt32 && n <= math.MaxInt32 {
+			return protoreflect.ValueOfInt32(int32(n)), nil
+		}
+	case protoreflect.Int64Kind:
+		if n, ok := integerValue(v); ok {
+			return protoreflect.ValueOfInt64(n), nil
+		}
+	case protoreflect.DoubleKind:
+		switch v.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return protoreflect.ValueOfFloat64(v.Float()), nil
+		}
+		if n, ok := integerValue(v); ok {
+			return protoreflect.ValueOfFloat64(float64(n)), nil
+		}
+	}
+	return protoreflect.Value{}, fmt.Errorf("%s is not a %s", v.Type(), fd.Kind())
+}
+
+// integerValue returns the integer in v. Floats are integers if they have no fraction, since numbers read
+// back from JSON, such as cached details, are floats
+func integerValue(v reflect.Value) (int64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); f == math.Trunc(f) {
+			return int64(f), true
+		}
+	}
+	return 0, false
+}
+
+// indirect returns the value v points to, which is invalid for nil pointers and interfaces
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
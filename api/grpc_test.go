@@ -0,0 +1,170 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/khan/api"
+	"github.com/topfreegames/khan/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func getGRPCGameSettings(name string) *pb.GameSettings {
+	metadata, err := structpb.NewStruct(map[string]interface{}{"x": "a"})
+	Expect(err).NotTo(HaveOccurred())
+	return &pb.GameSettings{
+		Name:                          name,
+		MembershipLevels:              map[string]int32{"Member": 1, "Elder": 2, "CoLeader": 3},
+		Metadata:                      metadata,
+		MinLevelToAcceptApplication:   1,
+		MinLevelToCreateInvitation:    2,
+		MinLevelToRemoveMember:        3,
+		MinLevelOffsetToRemoveMember:  1,
+		MinLevelOffsetToPromoteMember: 1,
+		MinLevelOffsetToDemoteMember:  1,
+		MaxMembers:                    100,
+		MaxClansPerPlayer:             1,
+		CooldownAfterDeny:             30,
+		CooldownAfterDelete:           40,
+	}
+}
+
+var _ = Describe("gRPC Server", func() {
+	var server *grpc.Server
+	var conn *grpc.ClientConn
+
+	dial := func(a *api.App) pb.KhanClient {
+		listener := bufconn.Listen(1024 * 1024)
+		server = api.NewGRPCServer(a)
+		go server.Serve(listener)
+
+		var err error
+		conn, err = grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(
+			func(context.Context, string) (net.Conn, error) {
+				return listener.Dial()
+			},
+		))
+		Expect(err).NotTo(HaveOccurred())
+		return pb.NewKhanClient(conn)
+	}
+
+	AfterEach(func() {
+		conn.Close()
+		server.Stop()
+	})
+
+	It("Should have a method for every route", func() {
+		a := GetDefaultTestApp()
+		dial(a)
+		Expect(api.GRPCUnmapped(a.App.Routes())).To(BeEmpty())
+	})
+
+	It("Should create and retrieve a game", func() {
+		client := dial(GetDefaultTestApp())
+		gameID := uuid.NewV4().String()
+
+		created, err := client.CreateGame(context.Background(), &pb.CreateGameRequest{
+			PublicId: gameID,
+			Game:     getGRPCGameSettings(gameID),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created.PublicId).To(Equal(gameID))
+
+		retrieved, err := client.RetrieveGame(context.Background(), &pb.RetrieveGameRequest{GameId: gameID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retrieved.Game.PublicId).To(Equal(gameID))
+		Expect(retrieved.Game.Name).To(Equal(gameID))
+		Expect(retrieved.Game.MembershipLevels).To(Equal(map[string]int32{"Member": 1, "Elder": 2, "CoLeader": 3}))
+		Expect(retrieved.Game.Metadata.AsMap()).To(Equal(map[string]interface{}{"x": "a"}))
+		Expect(retrieved.Game.MaxMembers).To(Equal(int32(100)))
+		Expect(retrieved.Game.Version).To(BeNumerically(">", 0))
+	})
+
+	It("Should keep the hook fields whitelists of a game updated without them", func() {
+		client := dial(GetDefaultTestApp())
+		gameID := uuid.NewV4().String()
+		settings := getGRPCGameSettings(gameID)
+		settings.ClanHookFieldsWhitelist = wrapperspb.String("a,b")
+		settings.PlayerHookFieldsWhitelist = wrapperspb.String("c,d")
+		_, err := client.CreateGame(context.Background(), &pb.CreateGameRequest{PublicId: gameID, Game: settings})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.UpdateGame(context.Background(), &pb.UpdateGameRequest{
+			GameId: gameID,
+			Game:   getGRPCGameSettings("new-name"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		retrieved, err := client.RetrieveGame(context.Background(), &pb.RetrieveGameRequest{GameId: gameID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retrieved.Game.Name).To(Equal("new-name"))
+		Expect(retrieved.Game.ClanHookFieldsWhitelist).To(Equal("a,b"))
+		Expect(retrieved.Game.PlayerHookFieldsWhitelist).To(Equal("c,d"))
+
+		settings = getGRPCGameSettings("new-name")
+		settings.ClanHookFieldsWhitelist = wrapperspb.String("")
+		_, err = client.UpdateGame(context.Background(), &pb.UpdateGameRequest{GameId: gameID, Game: settings})
+		Expect(err).NotTo(HaveOccurred())
+
+		retrieved, err = client.RetrieveGame(context.Background(), &pb.RetrieveGameRequest{GameId: gameID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retrieved.Game.ClanHookFieldsWhitelist).To(BeEmpty())
+		Expect(retrieved.Game.PlayerHookFieldsWhitelist).To(Equal("c,d"))
+	})
+
+	It("Should return the reason of failed operations with the matching status code", func() {
+		client := dial(GetDefaultTestApp())
+
+		_, err := client.RetrieveGame(context.Background(), &pb.RetrieveGameRequest{GameId: uuid.NewV4().String()})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		_, err = client.CreateGame(context.Background(), &pb.CreateGameRequest{
+			PublicId: uuid.NewV4().String(),
+			Game:     &pb.GameSettings{Name: "game"},
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("Should require path parameters", func() {
+		client := dial(GetDefaultTestApp())
+		_, err := client.RetrieveGame(context.Background(), &pb.RetrieveGameRequest{})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		Expect(status.Convert(err).Message()).To(Equal("gameID is required"))
+	})
+
+	It("Should authenticate requests with their metadata", func() {
+		client := dial(GetTestAppWithBasicAuth("user", "pass"))
+
+		_, err := client.ListGames(context.Background(), &pb.ListGamesRequest{})
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+		credentials := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Basic "+credentials)
+		_, err = client.ListGames(ctx, &pb.ListGamesRequest{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should require the admin token for admin methods", func() {
+		client := dial(GetDefaultTestApp())
+		_, err := client.ListAPIKeys(context.Background(), &pb.ListAPIKeysRequest{GameId: uuid.NewV4().String()})
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		Expect(status.Convert(err).Message()).To(Equal("Invalid admin token."))
+	})
+})
@@ -8,11 +8,17 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/garyburd/redigo/redis"
+	workers "github.com/jrallison/go-workers"
 	"github.com/labstack/echo"
+	gorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
 )
 
 //HealthCheckHandler is the handler responsible for validating that the app is still up
@@ -24,14 +30,166 @@ func HealthCheckHandler(app *App) func(c echo.Context) error {
 			return FailWith(http.StatusInternalServerError, err.Error(), c)
 		}
 
-		workingString := app.Config.GetString("healthcheck.workingText")
-
-		_, err = db.SelectInt("select count(*) from games")
+		workingString, err := healthCheck(app, db)
 		if err != nil {
-			return FailWith(http.StatusInternalServerError, fmt.Sprintf("Error connecting to database: %s", err), c)
+			return FailWithError(err, c)
 		}
+		return c.String(http.StatusOK, workingString)
+	}
+}
+
+// healthCheck returns the working text of the app if its database is reachable
+func healthCheck(app *App, db gorp.Database) (string, error) {
+	workingString := app.Config.GetString("healthcheck.workingText")
 
-		workingString = strings.TrimSpace(workingString)
+	_, err := db.SelectInt("select 1")
+	if err != nil {
+		return "", withStatus(http.StatusInternalServerError, fmt.Errorf("Error connecting to database: %s", err))
+	}
+
+	return strings.TrimSpace(workingString), nil
+}
+
+//LivenessHandler is the handler responsible for validating that the app process is up, without checking its dependencies
+func LivenessHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "Liveness")
+		workingString := strings.TrimSpace(app.Config.GetString("healthcheck.workingText"))
 		return c.String(http.StatusOK, workingString)
 	}
 }
+
+//DependencyStatus is the result of the readiness check of a dependency
+type DependencyStatus struct {
+	Healthy bool    `json:"healthy"`
+	Latency float64 `json:"latencyMs"`
+	Error   string  `json:"error,omitempty"`
+}
+
+//ReadinessHandler is the handler responsible for validating that every enabled dependency of the app is reachable
+func ReadinessHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "Readiness")
+		if app.isShuttingDown() {
+			return c.JSON(http.StatusServiceUnavailable, map[string]interface{}{
+				"ready":        false,
+				"shuttingDown": true,
+				"dependencies": map[string]*DependencyStatus{},
+			})
+		}
+
+		timeout := app.Config.GetDuration("healthcheck.timeout")
+		dependencies := checkDependencies(c.StdContext(), app.readinessChecks(), timeout)
+
+		ready := true
+		for _, dependency := range dependencies {
+			ready = ready && dependency.Healthy
+		}
+		status := http.StatusOK
+		if !ready {
+			status = http.StatusServiceUnavailable
+		}
+		return c.JSON(status, map[string]interface{}{
+			"ready":        ready,
+			"dependencies": dependencies,
+		})
+	}
+}
+
+// readinessChecks returns the checks of the enabled dependencies of the app, by name
+func (app *App) readinessChecks() map[string]func(ctx context.Context) error {
+	checks := map[string]func(ctx context.Context) error{
+		"postgres": func(ctx context.Context) error {
+			_, err := app.Db(ctx).SelectInt("select 1")
+			return err
+		},
+		"redis": func(ctx context.Context) error {
+			if workers.Config == nil {
+				return fmt.Errorf("workers are not configured")
+			}
+			_, err := doRedis(ctx, workers.Config.Pool, "PING")
+			return err
+		},
+	}
+	if app.cachesRedisPool != nil {
+		checks["cachesRedis"] = func(ctx context.Context) error {
+			_, err := doRedis(ctx, app.cachesRedisPool, "PING")
+			return err
+		}
+	}
+	if app.ESClient != nil {
+		checks["elasticsearch"] = func(ctx context.Context) error {
+			_, err := app.ESClient.Client.ClusterHealth().Do(ctx)
+			return err
+		}
+	}
+	if app.MongoDB != nil {
+		checks["mongodb"] = func(ctx context.Context) error {
+			result := map[string]interface{}{}
+			return app.MongoDB.WithContext(ctx).Run("ping", &result)
+		}
+	}
+	return checks
+}
+
+// doRedis runs a command in a connection of the pool, failing when the deadline of ctx is reached instead of
+// waiting for a Redis that does not answer
+func doRedis(ctx context.Context, pool *redis.Pool, command string, args ...interface{}) (interface{}, error) {
+	conn, err := pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return conn.Do(command, args...)
+	}
+	timeout := time.Until(deadline)
+	if timeout <= 0 {
+		return nil, ctx.Err()
+	}
+	return redis.DoWithTimeout(conn, timeout, command, args...)
+}
+
+// checkDependencies runs the checks concurrently, failing the ones that take longer than timeout
+func checkDependencies(
+	ctx context.Context, checks map[string]func(ctx context.Context) error, timeout time.Duration,
+) map[string]*DependencyStatus {
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	dependencies := map[string]*DependencyStatus{}
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check func(ctx context.Context) error) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			result := make(chan error, 1)
+			go func() {
+				result <- check(checkCtx)
+			}()
+			var err error
+			select {
+			case err = <-result:
+			case <-checkCtx.Done():
+				err = fmt.Errorf("timed out after %s", timeout)
+			}
+
+			dependency := &DependencyStatus{
+				Healthy: err == nil,
+				Latency: float64(time.Since(start)) / float64(time.Millisecond),
+			}
+			if err != nil {
+				dependency.Error = err.Error()
+			}
+			mutex.Lock()
+			dependencies[name] = dependency
+			mutex.Unlock()
+		}(name, check)
+	}
+	wg.Wait()
+	return dependencies
+}
@@ -8,7 +8,9 @@
 package api_test
 
 import (
+	"encoding/json"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -38,4 +40,51 @@ var _ = Describe("Healthcheck API Handler", func() {
 			Expect(body).To(Equal("WORKING"))
 		})
 	})
+
+	Describe("Liveness Handler", func() {
+		It("Should respond with WORKING string", func() {
+			a := GetTestAppWithBasicAuth("basicauthuser", "basicauthpass")
+			status, body := Get(a, "/healthcheck/live")
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(Equal("WORKING"))
+		})
+	})
+
+	Describe("Readiness Handler", func() {
+		It("Should respond with the status of each dependency", func() {
+			a := GetDefaultTestApp()
+			status, body := Get(a, "/healthcheck/ready")
+			Expect(status).To(Equal(http.StatusOK))
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["ready"]).To(BeTrue())
+			dependencies := result["dependencies"].(map[string]interface{})
+			Expect(dependencies).To(HaveKey("postgres"))
+			Expect(dependencies).To(HaveKey("redis"))
+			postgres := dependencies["postgres"].(map[string]interface{})
+			Expect(postgres["healthy"]).To(BeTrue())
+			Expect(postgres["latencyMs"]).To(BeNumerically(">", 0))
+		})
+
+		It("Should respond with 503 if a dependency times out", func() {
+			a := GetDefaultTestApp()
+			a.Config.Set("healthcheck.timeout", time.Nanosecond)
+			status, body := Get(a, "/healthcheck/ready")
+			Expect(status).To(Equal(http.StatusServiceUnavailable))
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["ready"]).To(BeFalse())
+			postgres := result["dependencies"].(map[string]interface{})["postgres"].(map[string]interface{})
+			Expect(postgres["healthy"]).To(BeFalse())
+			Expect(postgres["error"]).NotTo(BeEmpty())
+		})
+
+		It("Should ignore basic auth", func() {
+			a := GetTestAppWithBasicAuth("basicauthuser", "basicauthpass")
+			status, _ := Get(a, "/healthcheck/ready")
+			Expect(status).To(Equal(http.StatusOK))
+		})
+	})
 })
@@ -14,6 +14,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -23,6 +24,7 @@ import (
 	"github.com/mailru/easyjson/jwriter"
 	"github.com/topfreegames/khan/log"
 	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/util"
 	"github.com/uber-go/zap"
 )
 
@@ -47,6 +49,29 @@ func FailWith(status int, message string, c echo.Context) error {
 
 // FailWithError fails with the specified error
 func FailWithError(err error, c echo.Context) error {
+	return FailWith(errorStatus(err), err.Error(), c)
+}
+
+// statusError is an error that fails requests with the given HTTP status, whatever the type of err
+type statusError struct {
+	status int
+	err    error
+}
+
+func (e *statusError) Error() string {
+	return e.err.Error()
+}
+
+// withStatus returns err failing requests with the given HTTP status
+func withStatus(status int, err error) error {
+	return &statusError{status: status, err: err}
+}
+
+// errorStatus returns the HTTP status of the requests that failed with err
+func errorStatus(err error) int {
+	if e, ok := err.(*statusError); ok {
+		return e.status
+	}
 	t := reflect.TypeOf(err)
 	status, ok := map[string]int{
 		"*models.ModelNotFoundError":                                 http.StatusNotFound,
@@ -56,24 +81,91 @@ func FailWithError(err error, c echo.Context) error {
 		"*models.AlreadyHasValidMembershipError":                     http.StatusConflict,
 		"*models.CannotApproveOrDenyMembershipAlreadyProcessedError": http.StatusConflict,
 		"*models.CannotPromoteOrDemoteMemberLevelError":              http.StatusConflict,
+		"*models.VersionMismatchError":                               http.StatusPreconditionFailed,
+		"gorp.OptimisticLockError":                                   http.StatusPreconditionFailed,
+		"*models.PlayerNameTakenError":                               http.StatusConflict,
+		"*models.MembershipLevelsInUseError":                         http.StatusUnprocessableEntity,
+		"*models.InvalidPlayerTokenError":                            http.StatusUnauthorized,
+		"*models.IdempotencyKeyMismatchError":                        http.StatusUnprocessableEntity,
+		"*models.IdempotencyKeyInProgressError":                      http.StatusConflict,
+		"*models.GameDeletingError":                                  http.StatusConflict,
 	}[t.String()]
 
 	if !ok {
 		status = http.StatusInternalServerError
 	}
-
-	return FailWith(status, err.Error(), c)
+	return status
 }
 
-// SucceedWith sends payload to user with status 200
+// SucceedWith sends payload to user with status 200. Routes of the v2 API respond with SucceedWithTyped instead
 func SucceedWith(payload map[string]interface{}, c echo.Context) error {
 	f := func() error {
+		if v2App(c) != nil {
+			return FailWith(http.StatusInternalServerError, "Route has no v2 response type.", c)
+		}
 		payload["success"] = true
 		return c.JSON(http.StatusOK, payload)
 	}
 	return f()
 }
 
+// setETag sets the ETag of the response to the given version of the resource
+func setETag(c echo.Context, version int64) {
+	c.Response().Header().Set("ETag", fmt.Sprintf("\"%d\"", version))
+}
+
+// setDetailsETag sets the ETag of the response to the version in the details of a resource.
+// Details read from the cache hold the version as a float64
+func setDetailsETag(c echo.Context, details map[string]interface{}) {
+	switch version := details["version"].(type) {
+	case int64:
+		setETag(c, version)
+	case float64:
+		setETag(c, int64(version))
+	}
+}
+
+// getIfMatchVersion returns the version of the resource required by the If-Match header of the
+// request, or zero if the header is missing or matches any version
+func getIfMatchVersion(c echo.Context) (int64, error) {
+	ifMatch := strings.TrimSpace(c.Request().Header().Get("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return 0, nil
+	}
+	tag := strings.Trim(strings.TrimPrefix(ifMatch, "W/"), "\"")
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("If-Match header %s is not a valid version", ifMatch)
+	}
+	return version, nil
+}
+
+// isNullInPayload returns whether field is set to null in the JSON object of the request body, which
+// the payload structs cannot tell apart from a missing field
+func isNullInPayload(c echo.Context, field string) (bool, error) {
+	data, err := GetRequestBody(c)
+	if err != nil {
+		return false, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return false, err
+	}
+	value, ok := fields[field]
+	return ok && strings.TrimSpace(string(value)) == "null", nil
+}
+
+// coalesce runs fn once for all concurrent requests with the same key and returns a deep copy of its result to each of them
+func coalesce(app *App, key string, fn func() (map[string]interface{}, error)) (map[string]interface{}, error) {
+	value, err := app.requestsGroup.Do(key, func() (interface{}, error) {
+		return fn()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return util.DeepCopyMap(value.(map[string]interface{})), nil
+}
+
 //LoadJSONPayload loads the JSON payload to the given struct validating all fields are not null
 func LoadJSONPayload(payloadStruct interface{}, c echo.Context, logger zap.Logger) error {
 	log.D(logger, "Loading payload...")
@@ -74,6 +74,40 @@ func GetTestAppWithBasicAuth(username, password string) *api.App {
 	return app
 }
 
+// GetTestAppWithAPIKeys returns a new Khan API application bound to 0.0.0.0:8888 for test that requires API keys
+func GetTestAppWithAPIKeys() *api.App {
+	logger := kt.NewMockLogger()
+	app := api.GetApp("0.0.0.0", 8888, "../config/test.yaml", true, logger, false, true)
+	app.Config.Set("apiKeys.enabled", true)
+	app.Configure()
+	return app
+}
+
+// GetTestAppWithPlayerTokens returns a new Khan API application bound to 0.0.0.0:8888 for test that requires
+// API keys and accepts player tokens
+func GetTestAppWithPlayerTokens() *api.App {
+	logger := kt.NewMockLogger()
+	app := api.GetApp("0.0.0.0", 8888, "../config/test.yaml", true, logger, false, true)
+	app.Config.Set("apiKeys.enabled", true)
+	app.Config.Set("playerTokens.enabled", true)
+	app.Configure()
+	return app
+}
+
+// GetTestAppWithRateLimit returns a new Khan API application bound to 0.0.0.0:8888 for test that limits
+// reads and searches to burst requests
+func GetTestAppWithRateLimit(burst int) *api.App {
+	logger := kt.NewMockLogger()
+	app := api.GetApp("0.0.0.0", 8888, "../config/test.yaml", true, logger, false, true)
+	app.Config.Set("rateLimit.enabled", true)
+	app.Config.Set("rateLimit.groups.read.rate", 0.001)
+	app.Config.Set("rateLimit.groups.read.burst", burst)
+	app.Config.Set("rateLimit.groups.search.rate", 0.001)
+	app.Config.Set("rateLimit.groups.search.burst", burst)
+	app.Configure()
+	return app
+}
+
 //Get from server
 func Get(app *api.App, url string) (int, string) {
 	return doRequest(app, "GET", url, "")
@@ -107,6 +141,45 @@ func PutJSON(app *api.App, url string, body interface{}) (int, string) {
 	return Put(app, url, string(result))
 }
 
+//PatchJSON sends a JSON Merge Patch to server
+func PatchJSON(app *api.App, url string, body interface{}) (int, string) {
+	result, err := json.Marshal(body)
+	if err != nil {
+		return 510, "Failed to marshal specified body to JSON format"
+	}
+	return doRequest(app, "PATCH", url, string(result))
+}
+
+//PutJSONIfMatch to server with an If-Match header and returns the ETag of the response
+func PutJSONIfMatch(app *api.App, url string, body interface{}, ifMatch string) (int, string, string) {
+	result, err := json.Marshal(body)
+	if err != nil {
+		return 510, "Failed to marshal specified body to JSON format", ""
+	}
+	header := http.Header{}
+	header.Set("If-Match", ifMatch)
+	status, resBody, resHeader := doRequestWithHeader(app, "PUT", url, string(result), header)
+	return status, resBody, resHeader.Get("ETag")
+}
+
+//PatchJSONIfMatch sends a JSON Merge Patch to server with an If-Match header and returns the ETag of the response
+func PatchJSONIfMatch(app *api.App, url string, body interface{}, ifMatch string) (int, string, string) {
+	result, err := json.Marshal(body)
+	if err != nil {
+		return 510, "Failed to marshal specified body to JSON format", ""
+	}
+	header := http.Header{}
+	header.Set("If-Match", ifMatch)
+	status, resBody, resHeader := doRequestWithHeader(app, "PATCH", url, string(result), header)
+	return status, resBody, resHeader.Get("ETag")
+}
+
+//GetWithETag from server and returns the ETag of the response
+func GetWithETag(app *api.App, url string) (int, string, string) {
+	status, body, header := doRequestWithHeader(app, "GET", url, "", http.Header{})
+	return status, body, header.Get("ETag")
+}
+
 //Delete from server
 func Delete(app *api.App, url string) (int, string) {
 	return doRequest(app, "DELETE", url, "")
@@ -154,6 +227,27 @@ func PerformRequest(ts *httptest.Server, req *http.Request) (int, string) {
 	return res.StatusCode, string(b)
 }
 
+func doRequestWithHeader(app *api.App, method, url, body string, header http.Header) (int, string, http.Header) {
+	ts := InitializeTestServer(app)
+	defer transport.CloseIdleConnections()
+	defer ts.Close()
+
+	req := GetRequest(app, ts, method, url, body)
+	for key := range header {
+		req.Header.Set(key, header.Get(key))
+	}
+	res, err := client.Do(req)
+	//Wait for port of httptest to be reclaimed by OS
+	time.Sleep(50 * time.Millisecond)
+	Expect(err).NotTo(HaveOccurred())
+
+	b, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	Expect(err).NotTo(HaveOccurred())
+
+	return res.StatusCode, string(b), res.Header
+}
+
 func doRequest(app *api.App, method, url, body string) (int, string) {
 	ts := InitializeTestServer(app)
 	defer transport.CloseIdleConnections()
@@ -8,6 +8,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -21,11 +22,8 @@ import (
 func CreateHookHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "CreateHook")
-		start := time.Now()
 		gameID := c.Param("gameID")
 
-		db := app.Db(c.StdContext())
-
 		logger := app.Logger.With(
 			zap.String("source", "CreateHookHandler"),
 			zap.String("operation", "createHook"),
@@ -41,43 +39,51 @@ func CreateHookHandler(app *App) func(c echo.Context) error {
 			return FailWith(http.StatusBadRequest, err.Error(), c)
 		}
 
-		log.D(logger, "Creating hook...")
-		hook, err := models.CreateHook(
-			db,
-			gameID,
-			payload.Type,
-			payload.HookURL,
-		)
-
+		hook, err := createHook(c.StdContext(), app, logger, gameID, &payload)
 		if err != nil {
-			log.E(logger, "Failed to create the hook.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
+			return FailWithError(err, c)
 		}
 
-		log.I(logger, "Created hook successfully.", func(cm log.CM) {
-			cm.Write(
-				zap.String("hookPublicID", hook.PublicID),
-				zap.Duration("duration", time.Now().Sub(start)),
-			)
-		})
 		return SucceedWith(map[string]interface{}{
 			"publicID": hook.PublicID,
 		}, c)
 	}
 }
 
+// createHook creates the hook of the payload for the game
+func createHook(ctx context.Context, app *App, logger zap.Logger, gameID string, payload *HookPayload) (*models.Hook, error) {
+	start := time.Now()
+	log.D(logger, "Creating hook...")
+	hook, err := models.CreateHook(
+		app.Db(ctx),
+		gameID,
+		payload.Type,
+		payload.HookURL,
+	)
+
+	if err != nil {
+		log.E(logger, "Failed to create the hook.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	log.I(logger, "Created hook successfully.", func(cm log.CM) {
+		cm.Write(
+			zap.String("hookPublicID", hook.PublicID),
+			zap.Duration("duration", time.Now().Sub(start)),
+		)
+	})
+	return hook, nil
+}
+
 // RemoveHookHandler is the handler responsible for removing existing hooks
 func RemoveHookHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "RemoveHook")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		publicID := c.Param("publicID")
 
-		db := app.Db(c.StdContext())
-
 		logger := app.Logger.With(
 			zap.String("source", "RemoveHookHandler"),
 			zap.String("operation", "removeHook"),
@@ -85,23 +91,33 @@ func RemoveHookHandler(app *App) func(c echo.Context) error {
 			zap.String("hookPublicID", publicID),
 		)
 
-		log.D(logger, "Removing hook...")
-		err := models.RemoveHook(
-			db,
-			gameID,
-			publicID,
-		)
-
-		if err != nil {
-			log.E(logger, "Failed to remove hook.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
+		if err := removeHook(c.StdContext(), app, logger, gameID, publicID); err != nil {
+			return FailWithError(err, c)
 		}
 
-		log.I(logger, "Hook removed successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
-		})
 		return SucceedWith(map[string]interface{}{}, c)
 	}
 }
+
+// removeHook removes the hook of the game
+func removeHook(ctx context.Context, app *App, logger zap.Logger, gameID, publicID string) error {
+	start := time.Now()
+	log.D(logger, "Removing hook...")
+	err := models.RemoveHook(
+		app.Db(ctx),
+		gameID,
+		publicID,
+	)
+
+	if err != nil {
+		log.E(logger, "Failed to remove hook.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return withStatus(http.StatusInternalServerError, err)
+	}
+
+	log.I(logger, "Hook removed successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return nil
+}
@@ -0,0 +1,121 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Pallinder/go-randomdata"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/khan/api"
+	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+var _ = Describe("Idempotency Middleware", func() {
+	var db models.DB
+	var a *api.App
+	var game *models.Game
+
+	BeforeEach(func() {
+		a = GetDefaultTestApp()
+		db = a.Db(nil)
+		game = fixtures.GameFactory.MustCreate().(*models.Game)
+		err := db.Insert(game)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	createPlayerBody := func() string {
+		body, _ := json.Marshal(map[string]interface{}{
+			"publicID": uuid.NewV4().String(),
+			"name":     randomdata.FullName(randomdata.RandomGender),
+			"metadata": map[string]interface{}{"x": "a"},
+		})
+		return string(body)
+	}
+
+	It("Should replay the response of a retried request", func() {
+		route := GetGameRoute(game.PublicID, "/players")
+		header := http.Header{api.IdempotencyKeyHeader: []string{uuid.NewV4().String()}}
+		body := createPlayerBody()
+
+		status, response, _ := doRequestWithHeader(a, "POST", route, body, header)
+		Expect(status).To(Equal(http.StatusOK))
+
+		status, replayed, responseHeader := doRequestWithHeader(a, "POST", route, body, header)
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(replayed).To(Equal(response))
+		Expect(responseHeader.Get(api.IdempotentReplayedHeader)).To(Equal("true"))
+	})
+
+	It("Should replay the ETag of a retried request", func() {
+		_, player, err := fixtures.CreatePlayerFactory(db, game.PublicID, true)
+		Expect(err).NotTo(HaveOccurred())
+		route := GetGameRoute(game.PublicID, "/players/"+player.PublicID)
+		header := http.Header{api.IdempotencyKeyHeader: []string{uuid.NewV4().String()}}
+		body, _ := json.Marshal(map[string]interface{}{
+			"name":     player.Name,
+			"metadata": map[string]interface{}{"x": "b"},
+		})
+
+		status, _, responseHeader := doRequestWithHeader(a, "PUT", route, string(body), header)
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(responseHeader.Get("ETag")).NotTo(BeEmpty())
+
+		status, _, replayedHeader := doRequestWithHeader(a, "PUT", route, string(body), header)
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(replayedHeader.Get(api.IdempotentReplayedHeader)).To(Equal("true"))
+		Expect(replayedHeader.Get("ETag")).To(Equal(responseHeader.Get("ETag")))
+	})
+
+	It("Should not replay responses to requests without the key", func() {
+		route := GetGameRoute(game.PublicID, "/players")
+		body := createPlayerBody()
+
+		status, _ := Post(a, route, body)
+		Expect(status).To(Equal(http.StatusOK))
+
+		status, _ = Post(a, route, body)
+		Expect(status).NotTo(Equal(http.StatusOK))
+	})
+
+	It("Should keep the keys of each API key apart", func() {
+		a.Config.Set("apiKeys.enabled", true)
+		a.Configure()
+		_, firstKey, err := models.CreateAPIKey(db, game.PublicID, []string{models.APIKeyScopePlayerWrite})
+		Expect(err).NotTo(HaveOccurred())
+		_, secondKey, err := models.CreateAPIKey(db, game.PublicID, []string{models.APIKeyScopePlayerWrite})
+		Expect(err).NotTo(HaveOccurred())
+		route := GetGameRoute(game.PublicID, "/players")
+		idempotencyKey := uuid.NewV4().String()
+
+		for _, apiKey := range []string{firstKey, secondKey} {
+			header := http.Header{
+				api.IdempotencyKeyHeader: []string{idempotencyKey},
+				api.APIKeyHeader:         []string{apiKey},
+			}
+			status, _, responseHeader := doRequestWithHeader(a, "POST", route, createPlayerBody(), header)
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(responseHeader.Get(api.IdempotentReplayedHeader)).To(BeEmpty())
+		}
+	})
+
+	It("Should not reuse a key for a different request", func() {
+		route := GetGameRoute(game.PublicID, "/players")
+		header := http.Header{api.IdempotencyKeyHeader: []string{uuid.NewV4().String()}}
+
+		status, _, _ := doRequestWithHeader(a, "POST", route, createPlayerBody(), header)
+		Expect(status).To(Equal(http.StatusOK))
+
+		status, _, _ = doRequestWithHeader(a, "POST", route, createPlayerBody(), header)
+		Expect(status).To(Equal(http.StatusUnprocessableEntity))
+	})
+})
@@ -8,6 +8,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -19,13 +20,12 @@ import (
 )
 
 // Helper methods are located in membership_helpers module.
-// This module is only for handlers
+// This module is only for handlers and the operations they run
 
 // ApplyForMembershipHandler is the handler responsible for applying for new memberships
 func ApplyForMembershipHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "ApplyForMembership")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		clanPublicID := c.Param("clanPublicID")
 
@@ -47,90 +47,104 @@ func ApplyForMembershipHandler(app *App) func(c echo.Context) error {
 			return FailWith(400, err.Error(), c)
 		}
 
-		logger = logger.With(
-			zap.String("level", payload.Level),
-			zap.String("playerPublicID", payload.PlayerPublicID),
-		)
-
-		var membership *models.Membership
-		game, err := app.GetGame(c.StdContext(), gameID)
-		if err != nil {
-			log.W(logger, "Could not find game.")
-			return FailWith(404, err.Error(), c)
-		}
-
-		tx, err := app.BeginTrans(c.StdContext(), logger)
+		membership, err := applyForMembership(c.StdContext(), app, logger, gameID, clanPublicID, &payload, optional.Message)
 		if err != nil {
 			return FailWithError(err, c)
 		}
-		log.D(logger, "DB Tx begun successful.")
-
-		log.D(logger, "Applying for membership...")
-		membership, err = models.CreateMembership(
-			tx,
-			app.EncryptionKey,
-			game,
-			gameID,
-			payload.Level,
-			payload.PlayerPublicID,
-			clanPublicID,
-			payload.PlayerPublicID,
-			optional.Message,
-		)
-		if err != nil {
-			log.E(logger, "Could not create membership", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
 
-			txErr := app.Rollback(tx, "Membership application failed", c, logger, err)
-			if txErr != nil {
-				log.E(logger, "Could not rollback transaction", func(cm log.CM) {
-					cm.Write(zap.Error(txErr))
-				})
-			}
+		return SucceedWithTyped(map[string]interface{}{
+			"approved": membership.Approved,
+		}, func() (EasyJSONMarshaler, error) {
+			return &ApplyForMembershipResponse{Success: true, Approved: membership.Approved}, nil
+		}, c)
+	}
+}
 
-			log.E(logger, "Membership application failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
+// applyForMembership creates the membership application of the payload to the clan
+func applyForMembership(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, clanPublicID string, payload *ApplyForMembershipPayload, message string,
+) (*models.Membership, error) {
+	start := time.Now()
+	logger = logger.With(
+		zap.String("level", payload.Level),
+		zap.String("playerPublicID", payload.PlayerPublicID),
+	)
+
+	var membership *models.Membership
+	game, err := getMembershipGame(ctx, app, logger, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+	log.D(logger, "DB Tx begun successful.")
+
+	log.D(logger, "Applying for membership...")
+	membership, err = models.CreateMembership(
+		tx,
+		app.EncryptionKey,
+		game,
+		gameID,
+		payload.Level,
+		payload.PlayerPublicID,
+		clanPublicID,
+		payload.PlayerPublicID,
+		message,
+	)
+	if err != nil {
+		log.E(logger, "Could not create membership", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+
+		txErr := app.Rollback(tx, "Membership application failed", logger, err)
+		if txErr != nil {
+			log.E(logger, "Could not rollback transaction", func(cm log.CM) {
+				cm.Write(zap.Error(txErr))
 			})
-			return FailWithError(err, c)
 		}
 
-		err = dispatchMembershipHookByID(
-			app, tx, models.MembershipApplicationCreatedHook,
-			membership.GameID, membership.ClanID, membership.PlayerID,
-			membership.RequestorID, membership.Message, membership.Level,
-		)
-		if err != nil {
-			log.E(logger, "Could not dispatch membership hook by id", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
+		log.E(logger, "Membership application failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, err
+	}
 
-			txErr := app.Rollback(tx, "Membership application failed", c, logger, err)
-			if txErr != nil {
-				log.E(logger, "Could not rollback transaction", func(cm log.CM) {
-					cm.Write(zap.Error(txErr))
-				})
-			}
+	err = dispatchMembershipHookByID(
+		app, tx, models.MembershipApplicationCreatedHook,
+		membership.GameID, membership.ClanID, membership.PlayerID,
+		membership.RequestorID, membership.Message, membership.Level,
+	)
+	if err != nil {
+		log.E(logger, "Could not dispatch membership hook by id", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
 
-			log.E(logger, "Membership application created dispatch hook failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
+		txErr := app.Rollback(tx, "Membership application failed", logger, err)
+		if txErr != nil {
+			log.E(logger, "Could not rollback transaction", func(cm log.CM) {
+				cm.Write(zap.Error(txErr))
 			})
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
 		}
 
-		err = app.Commit(tx, "Membership application", c, logger)
-		if err != nil {
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
-		}
-
-		log.I(logger, "Membership application created successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+		log.E(logger, "Membership application created dispatch hook failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
 
-		return SucceedWith(map[string]interface{}{
-			"approved": membership.Approved,
-		}, c)
+	err = app.Commit(tx, "Membership application", logger)
+	if err != nil {
+		return nil, withStatus(http.StatusInternalServerError, err)
 	}
+
+	log.I(logger, "Membership application created successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return membership, nil
 }
 
 // InviteForMembershipHandler is the handler responsible for creating new memberships
@@ -139,12 +153,8 @@ func InviteForMembershipHandler(app *App) func(c echo.Context) error {
 		var payload InviteForMembershipPayload
 		var optional *membershipOptionalParams
 		var err error
-		var game *models.Game
-		var membership *models.Membership
-		var tx interfaces.Transaction
 
 		c.Set("route", "InviteForMembership")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		clanPublicID := c.Param("clanPublicID")
 
@@ -163,94 +173,110 @@ func InviteForMembershipHandler(app *App) func(c echo.Context) error {
 			return FailWith(400, err.Error(), c)
 		}
 
-		logger = logger.With(
-			zap.String("level", payload.Level),
-			zap.String("playerPublicID", payload.PlayerPublicID),
-			zap.String("requestorPublicID", payload.RequestorPublicID),
-		)
-
-		game, err = app.GetGame(c.StdContext(), gameID)
-		if err != nil {
-			log.W(logger, "Could not find game.")
-			return FailWith(404, err.Error(), c)
-		}
-
-		tx, err = app.BeginTrans(c.StdContext(), logger)
+		err = inviteForMembership(c.StdContext(), app, logger, gameID, clanPublicID, &payload, optional.Message)
 		if err != nil {
 			return FailWithError(err, c)
 		}
-		log.D(logger, "DB Tx begun successful.")
-
-		log.D(logger, "Inviting for membership...")
-		membership, err = models.CreateMembership(
-			tx,
-			app.EncryptionKey,
-			game,
-			gameID,
-			payload.Level,
-			payload.PlayerPublicID,
-			clanPublicID,
-			payload.RequestorPublicID,
-			optional.Message,
-		)
 
-		if err != nil {
-			log.E(logger, "Could not create membership", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
+		return SucceedWithTyped(map[string]interface{}{}, successResponse, c)
+	}
+}
 
-			txErr := app.Rollback(tx, "Membership invitation failed", c, logger, err)
-			if txErr != nil {
-				return FailWith(http.StatusInternalServerError, err.Error(), c)
-			}
+// inviteForMembership creates the membership invitation of the payload to the clan
+func inviteForMembership(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, clanPublicID string, payload *InviteForMembershipPayload, message string,
+) error {
+	var err error
+	var game *models.Game
+	var membership *models.Membership
+	var tx interfaces.Transaction
+
+	start := time.Now()
+	logger = logger.With(
+		zap.String("level", payload.Level),
+		zap.String("playerPublicID", payload.PlayerPublicID),
+		zap.String("requestorPublicID", payload.RequestorPublicID),
+	)
+
+	game, err = getMembershipGame(ctx, app, logger, gameID)
+	if err != nil {
+		return err
+	}
 
-			log.E(logger, "Membership invitation failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWithError(err, c)
+	tx, err = app.BeginTrans(ctx, logger)
+	if err != nil {
+		return err
+	}
+	log.D(logger, "DB Tx begun successful.")
+
+	log.D(logger, "Inviting for membership...")
+	membership, err = models.CreateMembership(
+		tx,
+		app.EncryptionKey,
+		game,
+		gameID,
+		payload.Level,
+		payload.PlayerPublicID,
+		clanPublicID,
+		payload.RequestorPublicID,
+		message,
+	)
+
+	if err != nil {
+		log.E(logger, "Could not create membership", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+
+		txErr := app.Rollback(tx, "Membership invitation failed", logger, err)
+		if txErr != nil {
+			return withStatus(http.StatusInternalServerError, err)
 		}
 
-		err = dispatchMembershipHookByID(
-			app, tx, models.MembershipApplicationCreatedHook,
-			membership.GameID, membership.ClanID, membership.PlayerID,
-			membership.RequestorID, membership.Message, membership.Level,
-		)
-		if err != nil {
-			log.E(logger, "Could not dispatch membership hook by id", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
+		log.E(logger, "Membership invitation failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return err
+	}
 
-			txErr := app.Rollback(tx, "Membership invitation dispatch hook failed", c, logger, err)
-			if txErr != nil {
-				log.E(logger, "Could not rollback transaction", func(cm log.CM) {
-					cm.Write(zap.Error(txErr))
-				})
-			}
+	err = dispatchMembershipHookByID(
+		app, tx, models.MembershipApplicationCreatedHook,
+		membership.GameID, membership.ClanID, membership.PlayerID,
+		membership.RequestorID, membership.Message, membership.Level,
+	)
+	if err != nil {
+		log.E(logger, "Could not dispatch membership hook by id", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
 
-			log.E(logger, "Membership invitation dispatch hook failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
+		txErr := app.Rollback(tx, "Membership invitation dispatch hook failed", logger, err)
+		if txErr != nil {
+			log.E(logger, "Could not rollback transaction", func(cm log.CM) {
+				cm.Write(zap.Error(txErr))
 			})
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
 		}
 
-		err = app.Commit(tx, "Membership invitation", c, logger)
-		if err != nil {
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
-		}
-
-		log.I(logger, "Membership invitation created successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+		log.E(logger, "Membership invitation dispatch hook failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
+		return withStatus(http.StatusInternalServerError, err)
+	}
 
-		return SucceedWith(map[string]interface{}{}, c)
+	err = app.Commit(tx, "Membership invitation", logger)
+	if err != nil {
+		return withStatus(http.StatusInternalServerError, err)
 	}
+
+	log.I(logger, "Membership invitation created successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return nil
 }
 
 // ApproveOrDenyMembershipApplicationHandler is the handler responsible for approving or denying a membership invitation
 func ApproveOrDenyMembershipApplicationHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "ApproverOrDenyApplication")
-		start := time.Now()
 		action := c.Param("action")
 		gameID := c.Param("gameID")
 		clanPublicID := c.Param("clanPublicID")
@@ -268,127 +294,131 @@ func ApproveOrDenyMembershipApplicationHandler(app *App) func(c echo.Context) er
 			return FailWith(400, err.Error(), c)
 		}
 
-		logger = logger.With(
-			zap.String("playerPublicID", payload.PlayerPublicID),
-			zap.String("requestorPublicID", payload.RequestorPublicID),
-		)
-
-		game, err := app.GetGame(c.StdContext(), gameID)
-		if err != nil {
-			log.W(logger, "Could not find game.")
-			return FailWith(404, err.Error(), c)
-		}
-
-		tx, err := app.BeginTrans(c.StdContext(), logger)
+		err := approveOrDenyMembershipApplication(c.StdContext(), app, logger, gameID, clanPublicID, action, &payload)
 		if err != nil {
 			return FailWithError(err, c)
 		}
-		log.D(logger, "DB Tx begun successful.")
 
-		rollback := func(err error) error {
-			return app.Rollback(tx, "Approving/Denying membership application failed", c, logger, err)
-		}
+		return SucceedWithTyped(map[string]interface{}{}, successResponse, c)
+	}
+}
 
-		log.D(logger, "Approving/Denying membership application.")
-		membership, err := models.ApproveOrDenyMembershipApplication(
-			tx,
-			app.EncryptionKey,
-			game,
-			gameID,
-			payload.PlayerPublicID,
-			clanPublicID,
-			payload.RequestorPublicID,
-			action,
-		)
+// approveOrDenyMembershipApplication approves or denies, as told by action, the membership application of the
+// player of the payload
+func approveOrDenyMembershipApplication(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, clanPublicID, action string, payload *BasePayloadWithRequestorAndPlayerPublicIDs,
+) error {
+	start := time.Now()
+	logger = logger.With(
+		zap.String("playerPublicID", payload.PlayerPublicID),
+		zap.String("requestorPublicID", payload.RequestorPublicID),
+	)
+
+	game, err := getMembershipGame(ctx, app, logger, gameID)
+	if err != nil {
+		return err
+	}
 
-		if err != nil {
-			log.E(logger, "Could not approve or deny membership application", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
+	tx, err := app.BeginTrans(ctx, logger)
+	if err != nil {
+		return err
+	}
+	log.D(logger, "DB Tx begun successful.")
 
-			txErr := rollback(err)
-			if txErr != nil {
-				log.E(logger, "Could not rollback transaction", func(cm log.CM) {
-					cm.Write(zap.Error(txErr))
-				})
-			}
-			return FailWithError(err, c)
-		}
+	rollback := func(err error) error {
+		return app.Rollback(tx, "Approving/Denying membership application failed", logger, err)
+	}
 
-		log.D(logger, "Retrieving requestor details.")
-		requestor, err := models.GetPlayerByPublicID(tx, app.EncryptionKey, gameID, payload.RequestorPublicID)
-		if err != nil {
-			log.E(logger, "Could not get player by public id", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
+	log.D(logger, "Approving/Denying membership application.")
+	membership, err := models.ApproveOrDenyMembershipApplication(
+		tx,
+		app.EncryptionKey,
+		game,
+		gameID,
+		payload.PlayerPublicID,
+		clanPublicID,
+		payload.RequestorPublicID,
+		action,
+	)
+
+	if err != nil {
+		log.E(logger, "Could not approve or deny membership application", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
 
-			txErr := rollback(err)
-			if txErr != nil {
-				log.E(logger, "Could not rollback transaction", func(cm log.CM) {
-					cm.Write(zap.Error(txErr))
-				})
-			}
-			log.E(logger, "Requestor details retrieval failed", func(cm log.CM) {
+		txErr := rollback(err)
+		if txErr != nil {
+			log.E(logger, "Could not rollback transaction", func(cm log.CM) {
 				cm.Write(zap.Error(txErr))
 			})
-
-			return FailWithError(err, c)
 		}
-		log.D(logger, "Requestor details retrieved successfully.")
+		return err
+	}
 
-		if err != nil {
-			return FailWithError(err, c)
-		}
+	log.D(logger, "Retrieving requestor details.")
+	requestor, err := models.GetPlayerByPublicID(tx, app.EncryptionKey, gameID, payload.RequestorPublicID)
+	if err != nil {
+		log.E(logger, "Could not get player by public id", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
 
-		hookType := models.MembershipApprovedHook
-		if action == "deny" {
-			hookType = models.MembershipDeniedHook
-		}
-		err = dispatchApproveDenyMembershipHookByID(
-			app, tx, hookType,
-			membership.GameID, membership.ClanID, membership.PlayerID,
-			requestor.ID, membership.RequestorID, membership.Message, membership.Level,
-		)
-		if err != nil {
-			log.E(logger, "Could not dispatch approve deny membership by id", func(cm log.CM) {
-				cm.Write(zap.Error(err))
+		txErr := rollback(err)
+		if txErr != nil {
+			log.E(logger, "Could not rollback transaction", func(cm log.CM) {
+				cm.Write(zap.Error(txErr))
 			})
+		}
+		log.E(logger, "Requestor details retrieval failed", func(cm log.CM) {
+			cm.Write(zap.Error(txErr))
+		})
+
+		return err
+	}
+	log.D(logger, "Requestor details retrieved successfully.")
 
-			txErr := rollback(err)
-			if txErr != nil {
-				log.E(logger, "Could not rollback transaction", func(cm log.CM) {
-					cm.Write(zap.Error(txErr))
-				})
-			}
+	hookType := models.MembershipApprovedHook
+	if action == "deny" {
+		hookType = models.MembershipDeniedHook
+	}
+	err = dispatchApproveDenyMembershipHookByID(
+		app, tx, hookType,
+		membership.GameID, membership.ClanID, membership.PlayerID,
+		requestor.ID, membership.RequestorID, membership.Message, membership.Level,
+	)
+	if err != nil {
+		log.E(logger, "Could not dispatch approve deny membership by id", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
 
-			log.E(logger, "Membership approved/denied application dispatch hook failed.", func(cm log.CM) {
+		txErr := rollback(err)
+		if txErr != nil {
+			log.E(logger, "Could not rollback transaction", func(cm log.CM) {
 				cm.Write(zap.Error(txErr))
 			})
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
-		}
-
-		err = app.Commit(tx, "Membership application approval/deny", c, logger)
-		if err != nil {
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
 		}
 
-		log.I(logger, "Membership application approved/denied successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+		log.E(logger, "Membership approved/denied application dispatch hook failed.", func(cm log.CM) {
+			cm.Write(zap.Error(txErr))
 		})
+		return withStatus(http.StatusInternalServerError, err)
+	}
 
-		return SucceedWith(map[string]interface{}{}, c)
+	err = app.Commit(tx, "Membership application approval/deny", logger)
+	if err != nil {
+		return withStatus(http.StatusInternalServerError, err)
 	}
+
+	log.I(logger, "Membership application approved/denied successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return nil
 }
 
 // ApproveOrDenyMembershipInvitationHandler is the handler responsible for approving or denying a membership invitation
 func ApproveOrDenyMembershipInvitationHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
-		var membership *models.Membership
-		var err error
-		var tx interfaces.Transaction
-
 		c.Set("route", "ApproveOrDenyInvitation")
-		start := time.Now()
 		action := c.Param("action")
 		gameID := c.Param("gameID")
 		clanPublicID := c.Param("clanPublicID")
@@ -402,103 +432,120 @@ func ApproveOrDenyMembershipInvitationHandler(app *App) func(c echo.Context) err
 		)
 
 		var payload ApproveOrDenyMembershipInvitationPayload
-		err = LoadJSONPayload(&payload, c, logger)
+		err := LoadJSONPayload(&payload, c, logger)
 		if err != nil {
 			return FailWith(400, err.Error(), c)
 		}
 
-		logger = logger.With(
-			zap.String("playerPublicID", payload.PlayerPublicID),
-		)
-
-		game, err := app.GetGame(c.StdContext(), gameID)
+		err = approveOrDenyMembershipInvitation(c.StdContext(), app, logger, gameID, clanPublicID, action, &payload)
 		if err != nil {
-			log.W(logger, "Could not find game.")
-			return FailWith(404, err.Error(), c)
+			return FailWithError(err, c)
 		}
 
-		rollback := func(err error) error {
-			txErr := app.Rollback(tx, "Approving/Denying membership invitation failed", c, logger, err)
-			if txErr != nil {
-				return txErr
-			}
+		return SucceedWithTyped(map[string]interface{}{}, successResponse, c)
+	}
+}
 
-			return nil
-		}
+// approveOrDenyMembershipInvitation approves or denies, as told by action, the membership invitation of the
+// player of the payload
+func approveOrDenyMembershipInvitation(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, clanPublicID, action string, payload *ApproveOrDenyMembershipInvitationPayload,
+) error {
+	var membership *models.Membership
+	var err error
+	var tx interfaces.Transaction
+
+	start := time.Now()
+	logger = logger.With(
+		zap.String("playerPublicID", payload.PlayerPublicID),
+	)
+
+	game, err := getMembershipGame(ctx, app, logger, gameID)
+	if err != nil {
+		return err
+	}
 
-		tx, err = app.BeginTrans(c.StdContext(), logger)
-		if err != nil {
-			return FailWithError(err, c)
+	rollback := func(err error) error {
+		txErr := app.Rollback(tx, "Approving/Denying membership invitation failed", logger, err)
+		if txErr != nil {
+			return txErr
 		}
-		log.D(logger, "DB Tx begun successful.")
-
-		log.D(logger, "Approving/Denying membership invitation...")
-		membership, err = models.ApproveOrDenyMembershipInvitation(
-			tx,
-			app.EncryptionKey,
-			game,
-			gameID,
-			payload.PlayerPublicID,
-			clanPublicID,
-			action,
-		)
-		if err != nil {
-			log.E(logger, "Could not approve or deny membership invitation", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-
-			txErr := rollback(err)
-			if txErr != nil {
-				log.E(logger, "Could not rollback transaction", func(cm log.CM) {
-					cm.Write(zap.Error(txErr))
-				})
-			}
 
-			return FailWithError(err, c)
-		}
+		return nil
+	}
 
-		hookType := models.MembershipApprovedHook
-		if action == "deny" {
-			hookType = models.MembershipDeniedHook
-		}
-		err = dispatchApproveDenyMembershipHookByID(
-			app, tx, hookType,
-			membership.GameID, membership.ClanID, membership.PlayerID,
-			membership.PlayerID, membership.RequestorID, membership.Message, membership.Level,
-		)
+	tx, err = app.BeginTrans(ctx, logger)
+	if err != nil {
+		return err
+	}
+	log.D(logger, "DB Tx begun successful.")
+
+	log.D(logger, "Approving/Denying membership invitation...")
+	membership, err = models.ApproveOrDenyMembershipInvitation(
+		tx,
+		app.EncryptionKey,
+		game,
+		gameID,
+		payload.PlayerPublicID,
+		clanPublicID,
+		action,
+	)
+	if err != nil {
+		log.E(logger, "Could not approve or deny membership invitation", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
 
-		if err != nil {
-			log.E(logger, "Could not dispatch approve deny membership hook by id", func(cm log.CM) {
-				cm.Write(zap.Error(err))
+		txErr := rollback(err)
+		if txErr != nil {
+			log.E(logger, "Could not rollback transaction", func(cm log.CM) {
+				cm.Write(zap.Error(txErr))
 			})
-
-			txErr := rollback(err)
-			if txErr != nil {
-				log.E(logger, "Membership invitation approval/deny hook dispatch failed.", func(cm log.CM) {
-					cm.Write(zap.Error(err))
-				})
-			}
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
 		}
 
-		log.I(logger, "Membership invitation approved/denied successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+		return err
+	}
+
+	hookType := models.MembershipApprovedHook
+	if action == "deny" {
+		hookType = models.MembershipDeniedHook
+	}
+	err = dispatchApproveDenyMembershipHookByID(
+		app, tx, hookType,
+		membership.GameID, membership.ClanID, membership.PlayerID,
+		membership.PlayerID, membership.RequestorID, membership.Message, membership.Level,
+	)
+
+	if err != nil {
+		log.E(logger, "Could not dispatch approve deny membership hook by id", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
 
-		err = app.Commit(tx, "Membership invitation approval/deny", c, logger)
-		if err != nil {
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
+		txErr := rollback(err)
+		if txErr != nil {
+			log.E(logger, "Membership invitation approval/deny hook dispatch failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
 		}
+		return withStatus(http.StatusInternalServerError, err)
+	}
+
+	log.I(logger, "Membership invitation approved/denied successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
 
-		return SucceedWith(map[string]interface{}{}, c)
+	err = app.Commit(tx, "Membership invitation approval/deny", logger)
+	if err != nil {
+		return withStatus(http.StatusInternalServerError, err)
 	}
+	return nil
 }
 
 // DeleteMembershipHandler is the handler responsible for deleting a member
 func DeleteMembershipHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "DeleteMembership")
-		start := time.Now()
+		gameID := c.Param("gameID")
 		clanPublicID := c.Param("clanPublicID")
 
 		logger := app.Logger.With(
@@ -507,102 +554,114 @@ func DeleteMembershipHandler(app *App) func(c echo.Context) error {
 			zap.String("clanPublicID", clanPublicID),
 		)
 
-		payload, game, status, err := getPayloadAndGame(app, c, logger)
-		if err != nil {
-			return FailWith(status, err.Error(), c)
+		var payload BasePayloadWithRequestorAndPlayerPublicIDs
+		if err := LoadJSONPayload(&payload, c, logger.With(zap.String("gameID", gameID))); err != nil {
+			return FailWith(400, err.Error(), c)
 		}
 
-		logger = logger.With(
-			zap.String("gameID", game.PublicID),
-			zap.String("playerPublicID", payload.PlayerPublicID),
-			zap.String("requestorPublicID", payload.RequestorPublicID),
-		)
-
-		tx, err := app.BeginTrans(c.StdContext(), logger)
-		if err != nil {
+		if err := deleteMembership(c.StdContext(), app, logger, gameID, clanPublicID, &payload); err != nil {
 			return FailWithError(err, c)
 		}
-		log.D(logger, "DB Tx began successfully.")
 
-		rollback := func(err error) error {
-			txErr := app.Rollback(tx, "Deleting membership failed", c, logger, err)
-			if txErr != nil {
-				return txErr
-			}
-
-			return nil
-		}
+		return SucceedWithTyped(map[string]interface{}{}, successResponse, c)
+	}
+}
 
-		log.D(logger, "Deleting membership...")
-		membership, err := models.DeleteMembership(
-			tx,
-			game,
-			game.PublicID,
-			payload.PlayerPublicID,
-			clanPublicID,
-			payload.RequestorPublicID,
-		)
+// deleteMembership deletes the membership of the player of the payload
+func deleteMembership(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, clanPublicID string, payload *BasePayloadWithRequestorAndPlayerPublicIDs,
+) error {
+	start := time.Now()
+	game, err := getMembershipGame(ctx, app, logger, gameID)
+	if err != nil {
+		return err
+	}
 
-		if err != nil {
-			log.E(logger, "Could not delete membership", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
+	logger = logger.With(
+		zap.String("gameID", game.PublicID),
+		zap.String("playerPublicID", payload.PlayerPublicID),
+		zap.String("requestorPublicID", payload.RequestorPublicID),
+	)
 
-			txErr := rollback(err)
-			if txErr != nil {
+	tx, err := app.BeginTrans(ctx, logger)
+	if err != nil {
+		return err
+	}
+	log.D(logger, "DB Tx began successfully.")
 
-			}
-			log.E(logger, "Membership delete failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWithError(err, c)
+	rollback := func(err error) error {
+		txErr := app.Rollback(tx, "Deleting membership failed", logger, err)
+		if txErr != nil {
+			return txErr
 		}
 
-		err = dispatchMembershipHookByPublicID(
-			app, tx, models.MembershipLeftHook,
-			game.PublicID, clanPublicID, payload.PlayerPublicID,
-			payload.RequestorPublicID, membership.Level,
-		)
-		if err != nil {
-			log.E(logger, "Could not dispatch membership hook by public id", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
+		return nil
+	}
 
-			txErr := rollback(err)
-			if txErr != nil {
-				log.E(logger, "Could not rollback transaction", func(cm log.CM) {
-					cm.Write(zap.Error(txErr))
-				})
-			}
+	log.D(logger, "Deleting membership...")
+	membership, err := models.DeleteMembership(
+		tx,
+		game,
+		game.PublicID,
+		payload.PlayerPublicID,
+		clanPublicID,
+		payload.RequestorPublicID,
+	)
+
+	if err != nil {
+		log.E(logger, "Could not delete membership", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
 
-			log.E(logger, "Membership deleted hook dispatch failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
+		rollback(err)
+		log.E(logger, "Membership delete failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return err
+	}
+
+	err = dispatchMembershipHookByPublicID(
+		app, tx, models.MembershipLeftHook,
+		game.PublicID, clanPublicID, payload.PlayerPublicID,
+		payload.RequestorPublicID, membership.Level,
+	)
+	if err != nil {
+		log.E(logger, "Could not dispatch membership hook by public id", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+
+		txErr := rollback(err)
+		if txErr != nil {
+			log.E(logger, "Could not rollback transaction", func(cm log.CM) {
+				cm.Write(zap.Error(txErr))
 			})
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
 		}
 
-		log.I(logger, "Membership deleted successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+		log.E(logger, "Membership deleted hook dispatch failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
+		return withStatus(http.StatusInternalServerError, err)
+	}
 
-		err = app.Commit(tx, "Membership invitation approval/deny", c, logger)
-		if err != nil {
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
-		}
+	log.I(logger, "Membership deleted successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
 
-		return SucceedWith(map[string]interface{}{}, c)
+	err = app.Commit(tx, "Membership invitation approval/deny", logger)
+	if err != nil {
+		return withStatus(http.StatusInternalServerError, err)
 	}
+	return nil
 }
 
 // PromoteOrDemoteMembershipHandler is the handler responsible for promoting or demoting a member
 func PromoteOrDemoteMembershipHandler(app *App, action string) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "PromoteOrDemoteMember")
-		start := time.Now()
+		gameID := c.Param("gameID")
 		clanPublicID := c.Param("clanPublicID")
 
-		db := app.Db(c.StdContext())
-
 		logger := app.Logger.With(
 			zap.String("source", "membershipHandler"),
 			zap.String("operation", "promoteOrDemoteMembership"),
@@ -610,69 +669,92 @@ func PromoteOrDemoteMembershipHandler(app *App, action string) func(c echo.Conte
 			zap.String("action", action),
 		)
 
-		payload, game, status, err := getPayloadAndGame(app, c, logger)
-		if err != nil {
-			return FailWith(status, err.Error(), c)
+		var payload BasePayloadWithRequestorAndPlayerPublicIDs
+		if err := LoadJSONPayload(&payload, c, logger.With(zap.String("gameID", gameID))); err != nil {
+			return FailWith(400, err.Error(), c)
 		}
 
-		logger = logger.With(
-			zap.String("gameID", game.PublicID),
-			zap.String("playerPublicID", payload.PlayerPublicID),
-			zap.String("requestorPublicID", payload.RequestorPublicID),
-		)
-
-		log.D(logger, "Promoting/Demoting member...")
-		membership, err := models.PromoteOrDemoteMember(
-			db,
-			game,
-			game.PublicID,
-			payload.PlayerPublicID,
-			clanPublicID,
-			payload.RequestorPublicID,
-			action,
-		)
-
+		level, err := promoteOrDemoteMembership(c.StdContext(), app, logger, gameID, clanPublicID, action, &payload)
 		if err != nil {
-			log.E(logger, "Member promotion/demotion failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
 			return FailWithError(err, c)
 		}
-		log.D(logger, "Member promoted/demoted successful.")
 
-		log.D(logger, "Retrieving promoter/demoter member...")
-		requestor, err := models.GetPlayerByPublicID(db, app.EncryptionKey, membership.GameID, payload.RequestorPublicID)
-		if err != nil {
-			log.E(logger, "Promoter/Demoter member retrieval failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWithError(err, c)
-		}
-		log.D(logger, "Promoter/Demoter member retrieved successfully.")
+		return SucceedWithTyped(map[string]interface{}{
+			"level": level,
+		}, func() (EasyJSONMarshaler, error) {
+			return &MembershipLevelResponse{Success: true, Level: level}, nil
+		}, c)
+	}
+}
 
-		hookType := models.MembershipPromotedHook
-		if action == "demote" {
-			hookType = models.MembershipDemotedHook
-		}
+// promoteOrDemoteMembership promotes or demotes, as told by action, the member of the payload and returns its
+// new level
+func promoteOrDemoteMembership(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, clanPublicID, action string, payload *BasePayloadWithRequestorAndPlayerPublicIDs,
+) (string, error) {
+	start := time.Now()
+	db := app.Db(ctx)
+
+	game, err := getMembershipGame(ctx, app, logger, gameID)
+	if err != nil {
+		return "", err
+	}
 
-		err = dispatchMembershipHookByID(
-			app, db, hookType,
-			membership.GameID, membership.ClanID, membership.PlayerID,
-			requestor.ID, membership.Message, membership.Level,
-		)
-		if err != nil {
-			log.E(logger, "Promote/Demote member hook dispatch failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
-		}
+	logger = logger.With(
+		zap.String("gameID", game.PublicID),
+		zap.String("playerPublicID", payload.PlayerPublicID),
+		zap.String("requestorPublicID", payload.RequestorPublicID),
+	)
+
+	log.D(logger, "Promoting/Demoting member...")
+	membership, err := models.PromoteOrDemoteMember(
+		db,
+		game,
+		game.PublicID,
+		payload.PlayerPublicID,
+		clanPublicID,
+		payload.RequestorPublicID,
+		action,
+	)
+
+	if err != nil {
+		log.E(logger, "Member promotion/demotion failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return "", err
+	}
+	log.D(logger, "Member promoted/demoted successful.")
 
-		log.I(logger, "Member promoted/demoted successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	log.D(logger, "Retrieving promoter/demoter member...")
+	requestor, err := models.GetPlayerByPublicID(db, app.EncryptionKey, membership.GameID, payload.RequestorPublicID)
+	if err != nil {
+		log.E(logger, "Promoter/Demoter member retrieval failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
+		return "", err
+	}
+	log.D(logger, "Promoter/Demoter member retrieved successfully.")
 
-		return SucceedWith(map[string]interface{}{
-			"level": membership.Level,
-		}, c)
+	hookType := models.MembershipPromotedHook
+	if action == "demote" {
+		hookType = models.MembershipDemotedHook
+	}
+
+	err = dispatchMembershipHookByID(
+		app, db, hookType,
+		membership.GameID, membership.ClanID, membership.PlayerID,
+		requestor.ID, membership.Message, membership.Level,
+	)
+	if err != nil {
+		log.E(logger, "Promote/Demote member hook dispatch failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return "", withStatus(http.StatusInternalServerError, err)
 	}
+
+	log.I(logger, "Member promoted/demoted successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return membership.Level, nil
 }
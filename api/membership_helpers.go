@@ -8,6 +8,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/labstack/echo"
@@ -177,19 +178,12 @@ func dispatchApproveDenyMembershipHook(app *App, db models.DB, hookType int, gam
 	return nil
 }
 
-func getPayloadAndGame(app *App, c echo.Context, logger zap.Logger) (*BasePayloadWithRequestorAndPlayerPublicIDs, *models.Game, int, error) {
-	gameID := c.Param("gameID")
-
-	var payload BasePayloadWithRequestorAndPlayerPublicIDs
-	if err := LoadJSONPayload(&payload, c, logger.With(zap.String("gameID", gameID))); err != nil {
-		return nil, nil, 400, err
-	}
-
-	game, err := app.GetGame(c.StdContext(), gameID)
+// getMembershipGame returns the game of a membership request, failing with 404 if it does not exist
+func getMembershipGame(ctx context.Context, app *App, logger zap.Logger, gameID string) (*models.Game, error) {
+	game, err := app.GetGame(ctx, gameID)
 	if err != nil {
 		log.W(logger, "Could not find game.")
-		return nil, nil, 404, err
+		return nil, withStatus(404, err)
 	}
-
-	return &payload, game, 200, nil
+	return game, nil
 }
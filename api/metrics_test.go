@@ -0,0 +1,75 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+var _ = Describe("Metrics API Handler", func() {
+	Describe("Metrics Handler", func() {
+		It("Should respond with the Prometheus metrics of the requests", func() {
+			a := GetDefaultTestApp()
+			status, _ := Get(a, "/games/"+uuid.NewV4().String())
+			Expect(status).To(Equal(http.StatusNotFound))
+
+			status, body := Get(a, "/metrics")
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(ContainSubstring(
+				`khan_http_request_duration_seconds_count{method="GET",route="/games/:gameID",status="404"} 1`,
+			))
+			Expect(body).To(ContainSubstring("khan_db_open_connections"))
+		})
+
+		It("Should observe the requests that panic", func() {
+			a := GetDefaultTestApp()
+			a.App.Get("/panic", func(c echo.Context) error {
+				panic("failed")
+			})
+			status, _ := Get(a, "/panic")
+			Expect(status).To(Equal(http.StatusInternalServerError))
+
+			status, body := Get(a, "/metrics")
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(ContainSubstring(
+				`khan_http_request_duration_seconds_count{method="GET",route="/panic",status="500"} 1`,
+			))
+		})
+
+		It("Should count the hits and misses of the game cache", func() {
+			a := GetDefaultTestApp()
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := a.Db(nil).Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = a.GetGame(context.Background(), game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = a.GetGame(context.Background(), game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+
+			status, body := Get(a, "/metrics")
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(ContainSubstring(`khan_cache_requests_total{cache="getGame",result="hit"} 1`))
+			Expect(body).To(ContainSubstring(`khan_cache_requests_total{cache="getGame",result="miss"} 1`))
+		})
+
+		It("Should not require basic auth", func() {
+			a := GetTestAppWithBasicAuth("basicauthuser", "basicauthpass")
+			status, _ := Get(a, "/metrics")
+			Expect(status).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -9,14 +9,25 @@ package api
 
 import (
 	"bytes"
+	"context"
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"net/http"
 	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/labstack/echo"
+	extnethttpmiddleware "github.com/topfreegames/extensions/v9/middleware"
 	"github.com/topfreegames/khan/log"
+	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/ratelimit"
 	"github.com/topfreegames/khan/util"
 	"github.com/uber-go/zap"
 )
@@ -34,12 +45,73 @@ func getBodyFromNext(c echo.Context, next echo.HandlerFunc) (string, error) {
 	return body, err
 }
 
-//NewBodyExtractionMiddleware with API version
+// apiRequest is a request to a route of the API, served over HTTP or gRPC, as the middlewares check it
+type apiRequest interface {
+	Context() context.Context
+	Method() string
+	// Path returns the path of the route without the version prefix
+	Path() string
+	Param(name string) string
+	Header(name string) string
+	// BodyField returns the string field of the payload with the given name, empty if missing
+	BodyField(name string) (string, error)
+	RealIP() string
+}
+
+// echoRequest is an HTTP request to a route of the API
+type echoRequest struct {
+	c       echo.Context
+	payload map[string]interface{}
+}
+
+func newEchoRequest(c echo.Context) *echoRequest {
+	return &echoRequest{c: c}
+}
+
+func (r *echoRequest) Context() context.Context {
+	return r.c.StdContext()
+}
+
+func (r *echoRequest) Method() string {
+	return r.c.Request().Method()
+}
+
+func (r *echoRequest) Path() string {
+	return routePath(r.c)
+}
+
+func (r *echoRequest) Param(name string) string {
+	return r.c.Param(name)
+}
+
+func (r *echoRequest) Header(name string) string {
+	return r.c.Request().Header().Get(name)
+}
+
+func (r *echoRequest) BodyField(name string) (string, error) {
+	if r.payload == nil {
+		body, err := GetRequestBody(r.c)
+		if err != nil {
+			return "", err
+		}
+		if err := json.Unmarshal(body, &r.payload); err != nil {
+			return "", err
+		}
+	}
+	value, _ := r.payload[name].(string)
+	return value, nil
+}
+
+func (r *echoRequest) RealIP() string {
+	return r.c.Request().RealIP()
+}
+
+// NewBodyExtractionMiddleware with API version
 func NewBodyExtractionMiddleware() *BodyExtractionMiddleware {
 	return &BodyExtractionMiddleware{}
 }
 
-//BodyExtractionMiddleware extracts the body
+// BodyExtractionMiddleware extracts the body
 type BodyExtractionMiddleware struct{}
 
 // Serve serves the middleware
@@ -51,14 +123,14 @@ func (v *BodyExtractionMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc
 	}
 }
 
-//NewVersionMiddleware with API version
+// NewVersionMiddleware with API version
 func NewVersionMiddleware() *VersionMiddleware {
 	return &VersionMiddleware{
 		Version: util.VERSION,
 	}
 }
 
-//VersionMiddleware inserts the current version in all requests
+// VersionMiddleware inserts the current version in all requests
 type VersionMiddleware struct {
 	Version string
 }
@@ -88,19 +160,38 @@ func getHTTPParams(ctx echo.Context) (string, map[string]string, string) {
 	return qs, headers, cookies
 }
 
-//NewRecoveryMiddleware returns a configured middleware
+// NewInFlightMiddleware returns a middleware that counts the requests being served in inFlight
+func NewInFlightMiddleware(inFlight *int64) *InFlightMiddleware {
+	return &InFlightMiddleware{InFlight: inFlight}
+}
+
+// InFlightMiddleware counts the requests being served, so that shutdowns can wait for them to finish
+type InFlightMiddleware struct {
+	InFlight *int64
+}
+
+// Serve serves the middleware
+func (m *InFlightMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		atomic.AddInt64(m.InFlight, 1)
+		defer atomic.AddInt64(m.InFlight, -1)
+		return next(c)
+	}
+}
+
+// NewRecoveryMiddleware returns a configured middleware
 func NewRecoveryMiddleware(onError func(error, []byte)) *RecoveryMiddleware {
 	return &RecoveryMiddleware{
 		OnError: onError,
 	}
 }
 
-//RecoveryMiddleware recovers from errors
+// RecoveryMiddleware recovers from errors
 type RecoveryMiddleware struct {
 	OnError func(error, []byte)
 }
 
-//Serve executes on error handler when errors happen
+// Serve executes on error handler when errors happen
 func (r *RecoveryMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		defer func() {
@@ -119,13 +210,33 @@ func (r *RecoveryMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
 	}
 }
 
+// NewDeprecationMiddleware returns the middleware that marks v1 routes served by the v2 API as deprecated
+func NewDeprecationMiddleware() *DeprecationMiddleware {
+	return &DeprecationMiddleware{}
+}
+
+// DeprecationMiddleware sets the Deprecation header and a link to the v2 route in responses of deprecated routes
+type DeprecationMiddleware struct{}
+
+// Serve serves the middleware
+func (m *DeprecationMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if _, ok := v2Responses[fmt.Sprintf("%s %s", c.Request().Method(), c.Path())]; ok {
+			header := c.Response().Header()
+			header.Set("Deprecation", "true")
+			header.Set("Link", fmt.Sprintf("<%s%s>; rel=\"successor-version\"", V2Prefix, c.Request().URL().Path()))
+		}
+		return next(c)
+	}
+}
+
 // NewLoggerMiddleware returns the logger middleware
 func NewLoggerMiddleware(theLogger zap.Logger) *LoggerMiddleware {
 	l := &LoggerMiddleware{Logger: theLogger}
 	return l
 }
 
-//LoggerMiddleware is responsible for logging to Zap all requests
+// LoggerMiddleware is responsible for logging to Zap all requests
 type LoggerMiddleware struct {
 	Logger zap.Logger
 }
@@ -193,3 +304,624 @@ func (l *LoggerMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
 		return err
 	}
 }
+
+// AdminTokenHeader is the header admin routes read the admin token from
+const AdminTokenHeader = "X-Khan-Admin-Token"
+
+// NewAdminMiddleware returns a middleware that only allows requests with the given admin token
+func NewAdminMiddleware(token string) *AdminMiddleware {
+	return &AdminMiddleware{Token: token}
+}
+
+// AdminMiddleware restricts routes to admins. Admin routes are disabled if no token is configured
+type AdminMiddleware struct {
+	Token string
+}
+
+// authorize returns an error if the request does not send the admin token
+func (a *AdminMiddleware) authorize(r apiRequest) error {
+	if a.Token == "" {
+		return withStatus(http.StatusForbidden, errors.New("Admin routes are disabled."))
+	}
+	token := r.Header(AdminTokenHeader)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(a.Token)) != 1 {
+		return withStatus(http.StatusUnauthorized, errors.New("Invalid admin token."))
+	}
+	return nil
+}
+
+// Serve serves the middleware
+func (a *AdminMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if err := a.authorize(newEchoRequest(c)); err != nil {
+			return FailWithError(err, c)
+		}
+		return next(c)
+	}
+}
+
+// APIKeyHeader is the header requests to game routes send the API key of the game in
+const APIKeyHeader = "X-Khan-API-Key"
+
+// apiKeyPublicIDKey is the context key the public ID of the verified API key of the request is stored at
+const apiKeyPublicIDKey = "apiKeyPublicID"
+
+// NewAPIKeyMiddleware returns a middleware that authorizes requests to game routes with API keys of the game
+func NewAPIKeyMiddleware(app *App) *APIKeyMiddleware {
+	return &APIKeyMiddleware{
+		App:        app,
+		AdminToken: app.Config.GetString("admin.token"),
+	}
+}
+
+// APIKeyMiddleware requires requests to game routes to send an API key of the game with the scope the route needs.
+// Requests with the admin token are always allowed
+type APIKeyMiddleware struct {
+	App        *App
+	AdminToken string
+}
+
+// apiKeyScope returns the scope an API key needs to call the route with the given method and path.
+// Routes that are not game routes are not protected, and an empty scope means only admins can call the route
+func apiKeyScope(method, path string) (string, bool) {
+	switch {
+	case !strings.HasPrefix(path, "/games"):
+		return "", false
+	case path == "/games", strings.HasPrefix(path, "/games/:gameID/api-keys"):
+		return "", true
+	case method == echo.GET && strings.HasSuffix(path, "/export"):
+		return models.APIKeyScopePlayerExport, true
+	case method == echo.GET:
+		return models.APIKeyScopeReadOnly, true
+	case method == echo.DELETE && path == "/games/:gameID":
+		return "", true
+	case strings.HasPrefix(path, "/games/:gameID/players"):
+		return models.APIKeyScopePlayerWrite, true
+	case strings.HasPrefix(path, "/games/:gameID/clans"):
+		return models.APIKeyScopeClanWrite, true
+	default:
+		return models.APIKeyScopeGameAdmin, true
+	}
+}
+
+// authorize returns the public ID of the API key of the request, which is empty for requests that do not need
+// one, or an error if the request is not allowed. Requests of verified player tokens do not need an API key
+func (m *APIKeyMiddleware) authorize(r apiRequest, playerTokenSubject string) (string, error) {
+	scope, protected := apiKeyScope(r.Method(), r.Path())
+	if !protected || playerTokenSubject != "" {
+		return "", nil
+	}
+
+	adminToken := r.Header(AdminTokenHeader)
+	if m.AdminToken != "" && subtle.ConstantTimeCompare([]byte(adminToken), []byte(m.AdminToken)) == 1 {
+		return "", nil
+	}
+	if scope == "" {
+		return "", withStatus(http.StatusUnauthorized, errors.New("Invalid admin token."))
+	}
+
+	key := r.Header(APIKeyHeader)
+	if key == "" {
+		return "", withStatus(http.StatusUnauthorized, errors.New("API key is required."))
+	}
+	apiKey, err := m.App.GetAPIKey(r.Context(), key)
+	if err != nil {
+		if _, ok := err.(*models.ModelNotFoundError); ok {
+			return "", withStatus(http.StatusUnauthorized, errors.New("Invalid API key."))
+		}
+		return "", withStatus(http.StatusInternalServerError, err)
+	}
+
+	if apiKey.GameID != r.Param("gameID") {
+		return "", withStatus(http.StatusForbidden, errors.New("API key does not belong to the game."))
+	}
+	if !apiKey.Allows(scope) {
+		return "", withStatus(http.StatusForbidden, fmt.Errorf("API key does not have the %s scope.", scope))
+	}
+	return apiKey.PublicID, nil
+}
+
+// Serve serves the middleware
+func (m *APIKeyMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		subject, _ := c.Get(playerTokenSubjectKey).(string)
+		apiKeyPublicID, err := m.authorize(newEchoRequest(c), subject)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+		if apiKeyPublicID != "" {
+			c.Set(apiKeyPublicIDKey, apiKeyPublicID)
+		}
+		return next(c)
+	}
+}
+
+// PlayerTokenHeader is the header game clients send their player token in
+const PlayerTokenHeader = "X-Khan-Player-Token"
+
+// playerTokenSubjectKey is the context key the public ID of the player of a verified token is stored at
+const playerTokenSubjectKey = "playerTokenSubject"
+
+// NewPlayerTokenMiddleware returns a middleware that authorizes requests of game clients with player tokens
+func NewPlayerTokenMiddleware(app *App) *PlayerTokenMiddleware {
+	return &PlayerTokenMiddleware{
+		App:    app,
+		MaxTTL: app.Config.GetDuration("playerTokens.maxTTL"),
+	}
+}
+
+// PlayerTokenMiddleware verifies the player tokens of requests that send one and requires the player the request
+// acts as to be the subject of the token. Requests without a player token are not changed
+type PlayerTokenMiddleware struct {
+	App    *App
+	MaxTTL time.Duration
+}
+
+// playerTokenActor describes where the public ID of the player a route acts as is
+type playerTokenActor struct {
+	// bodyField is the field of the payload with the public ID of the player
+	bodyField string
+	// clanOwner means the player is the owner of the clan of the route
+	clanOwner bool
+	// clanMember means the player is the owner or an approved member of the clan of the route
+	clanMember bool
+}
+
+// playerTokenActors are the routes that player tokens can call. Routes with a playerPublicID param act as that player
+var playerTokenActors = map[string]playerTokenActor{
+	"GET /games/:gameID/players/:playerPublicID":                              {},
+	"GET /games/:gameID/players/:playerPublicID/name-history":                 {},
+	"GET /games/:gameID/clans/:clanPublicID":                                  {clanMember: true},
+	"GET /games/:gameID/clans/:clanPublicID/members":                          {clanMember: true},
+	"GET /games/:gameID/clans/:clanPublicID/summary":                          {clanMember: true},
+	"POST /games/:gameID/players":                                             {bodyField: "publicID"},
+	"PUT /games/:gameID/players/:playerPublicID":                              {},
+	"PATCH /games/:gameID/players/:playerPublicID":                            {},
+	"DELETE /games/:gameID/players/:playerPublicID":                           {},
+	"POST /games/:gameID/clans":                                               {bodyField: "ownerPublicID"},
+	"PUT /games/:gameID/clans/:clanPublicID":                                  {bodyField: "ownerPublicID"},
+	"PATCH /games/:gameID/clans/:clanPublicID":                                {bodyField: "ownerPublicID"},
+	"POST /games/:gameID/clans/:clanPublicID/leave":                           {clanOwner: true},
+	"POST /games/:gameID/clans/:clanPublicID/transfer-ownership":              {clanOwner: true},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/application":         {bodyField: "playerPublicID"},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/application/:action": {bodyField: "requestorPublicID"},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/invitation":          {bodyField: "requestorPublicID"},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/invitation/:action":  {bodyField: "playerPublicID"},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/delete":              {bodyField: "requestorPublicID"},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/promote":             {bodyField: "requestorPublicID"},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/demote":              {bodyField: "requestorPublicID"},
+}
+
+// playerTokenActor returns the player a request of the token subject acts as. ok is false if player tokens
+// cannot call the route
+func (m *PlayerTokenMiddleware) playerTokenActor(r apiRequest, subject string) (string, bool, error) {
+	actor, ok := playerTokenActors[fmt.Sprintf("%s %s", r.Method(), r.Path())]
+	switch {
+	case !ok:
+		return "", false, nil
+	case actor.clanOwner:
+		_, err := models.GetClanByPublicIDAndOwnerPublicID(
+			m.App.Db(r.Context()), r.Param("gameID"), r.Param("clanPublicID"), subject,
+		)
+		if err != nil {
+			return "", false, err
+		}
+		return subject, true, nil
+	case actor.clanMember:
+		if err := m.requireClanMember(r, subject); err != nil {
+			return "", false, err
+		}
+		return subject, true, nil
+	case actor.bodyField != "":
+		publicID, err := r.BodyField(actor.bodyField)
+		if err != nil {
+			return "", false, err
+		}
+		return publicID, true, nil
+	default:
+		return r.Param("playerPublicID"), true, nil
+	}
+}
+
+// requireClanMember returns a ForbiddenError if the player is neither the owner nor an approved member of the
+// clan of the route
+func (m *PlayerTokenMiddleware) requireClanMember(r apiRequest, playerPublicID string) error {
+	db := m.App.Db(r.Context())
+	gameID, clanPublicID := r.Param("gameID"), r.Param("clanPublicID")
+	_, err := models.GetClanByPublicIDAndOwnerPublicID(db, gameID, clanPublicID, playerPublicID)
+	if _, ok := err.(*models.ForbiddenError); !ok {
+		return err
+	}
+	membership, err := models.GetValidMembershipByClanAndPlayerPublicID(db, gameID, clanPublicID, playerPublicID)
+	if err != nil {
+		if _, ok := err.(*models.ModelNotFoundError); ok {
+			return &models.ForbiddenError{GameID: gameID, PlayerID: playerPublicID, ClanID: clanPublicID}
+		}
+		return err
+	}
+	if !membership.Approved || membership.Denied || membership.Banned {
+		return &models.ForbiddenError{GameID: gameID, PlayerID: playerPublicID, ClanID: clanPublicID}
+	}
+	return nil
+}
+
+// verify returns the public ID of the player of the token of the request, which is empty for requests without
+// a player token, or an error if the token is not valid or cannot call the route
+func (m *PlayerTokenMiddleware) verify(r apiRequest) (string, error) {
+	token := r.Header(PlayerTokenHeader)
+	if token == "" {
+		return "", nil
+	}
+
+	gameID := r.Param("gameID")
+	if gameID == "" {
+		return "", withStatus(http.StatusForbidden, errors.New("Player tokens cannot call this route."))
+	}
+	game, err := m.App.GetGame(r.Context(), gameID)
+	if err != nil {
+		return "", err
+	}
+	subject, err := game.PlayerTokenSettings.VerifyPlayerToken(gameID, token, m.MaxTTL)
+	if err != nil {
+		return "", err
+	}
+
+	actor, ok, err := m.playerTokenActor(r, subject)
+	if err != nil {
+		switch err.(type) {
+		case *models.ModelNotFoundError, *models.ForbiddenError:
+			return "", err
+		}
+		return "", withStatus(http.StatusBadRequest, err)
+	}
+	if !ok {
+		return "", withStatus(http.StatusForbidden, errors.New("Player tokens cannot call this route."))
+	}
+	if actor != subject {
+		return "", withStatus(http.StatusForbidden, errors.New("Player token was not issued to the player of the request."))
+	}
+	return subject, nil
+}
+
+// Serve serves the middleware
+func (m *PlayerTokenMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		subject, err := m.verify(newEchoRequest(c))
+		if err != nil {
+			return FailWithError(err, c)
+		}
+		if subject != "" {
+			c.Set(playerTokenSubjectKey, subject)
+		}
+		return next(c)
+	}
+}
+
+// Route groups with their own rate limits, configured under "rateLimit.groups.<group>"
+const (
+	RateLimitGroupSearch      = "search"
+	RateLimitGroupMemberships = "memberships"
+	RateLimitGroupRead        = "read"
+	RateLimitGroupWrite       = "write"
+)
+
+// rateLimitGroups are the route groups with their own rate limits
+var rateLimitGroups = []string{RateLimitGroupSearch, RateLimitGroupMemberships, RateLimitGroupRead, RateLimitGroupWrite}
+
+//NewRateLimitMiddleware returns a middleware that limits the requests of each player to each route of a game
+func NewRateLimitMiddleware(app *App, store ratelimit.Store) *RateLimitMiddleware {
+	limits := map[string]ratelimit.Limit{}
+	for _, group := range rateLimitGroups {
+		limits[group] = ratelimit.Limit{
+			Rate:  app.Config.GetFloat64(fmt.Sprintf("rateLimit.groups.%s.rate", group)),
+			Burst: app.Config.GetInt(fmt.Sprintf("rateLimit.groups.%s.burst", group)),
+		}
+	}
+	m := &RateLimitMiddleware{
+		Store:         store,
+		Limits:        limits,
+		FailOpen:      app.Config.GetBool("rateLimit.failOpen"),
+		BasicAuthUser: app.Config.GetString("basicauth.username"),
+		Logger:        app.Logger.With(zap.String("source", "rateLimitMiddleware")),
+	}
+	if app.DDStatsD != nil {
+		m.MetricsReporter = app.DDStatsD
+	}
+	return m
+}
+
+//RateLimitMiddleware limits the requests to game routes with a token bucket per game, route and requestor,
+// see rateLimitRequestor. If the store fails, requests are allowed if FailOpen is true and rejected otherwise.
+// BasicAuthUser is the configured basic auth user, whose credentials are verified before the rate limit
+type RateLimitMiddleware struct {
+	Store           ratelimit.Store
+	Limits          map[string]ratelimit.Limit
+	FailOpen        bool
+	BasicAuthUser   string
+	MetricsReporter extnethttpmiddleware.MetricsReporter
+	Logger          zap.Logger
+}
+
+// rateLimitGroup returns the route group of the route with the given method and path. Routes that are not
+// routes of a game are not limited
+func rateLimitGroup(method, path string) (string, bool) {
+	switch {
+	case !strings.HasPrefix(path, "/games/:gameID"):
+		return "", false
+	case strings.HasPrefix(path, "/games/:gameID/clans/search"), strings.HasPrefix(path, "/games/:gameID/clans/suggest"):
+		return RateLimitGroupSearch, true
+	case strings.Contains(path, "/memberships/"):
+		return RateLimitGroupMemberships, true
+	case method == echo.GET:
+		return RateLimitGroupRead, true
+	default:
+		return RateLimitGroupWrite, true
+	}
+}
+
+// rateLimitRequestor returns the authenticated identity of the request: the player of its player token, or its
+// API key or basic auth user, which are split by the player the game backend acts for, since a backend sends the
+// requests of every player. Other requests are identified by the client IP, since the players they name are not
+// authenticated
+func rateLimitRequestor(r apiRequest, playerTokenSubject, apiKeyPublicID, basicAuthUser string) string {
+	if playerTokenSubject != "" {
+		return fmt.Sprintf("player:%s", playerTokenSubject)
+	}
+	if apiKeyPublicID != "" {
+		return fmt.Sprintf("key:%s:%s", apiKeyPublicID, rateLimitPayloadPlayer(r))
+	}
+	if basicAuthUser != "" {
+		return fmt.Sprintf("basic:%s:%s", basicAuthUser, rateLimitPayloadPlayer(r))
+	}
+	return fmt.Sprintf("ip:%s", r.RealIP())
+}
+
+// verifiedBasicAuthUser returns the basic auth user of the request, if basic auth is configured for
+// configuredUser and the request sends its credentials. They were already verified, since basic auth is
+// checked before the middlewares that identify callers
+func verifiedBasicAuthUser(r apiRequest, configuredUser string) string {
+	if configuredUser == "" {
+		return ""
+	}
+	req := &http.Request{Header: http.Header{"Authorization": {r.Header("Authorization")}}}
+	if username, _, ok := req.BasicAuth(); ok && username == configuredUser {
+		return username
+	}
+	return ""
+}
+
+// rateLimitPayloadPlayer returns the public ID of the player the request names in its payload or route, if any
+func rateLimitPayloadPlayer(r apiRequest) string {
+	if r.Method() != echo.GET {
+		for _, field := range []string{"requestorPublicID", "ownerPublicID", "playerPublicID"} {
+			if publicID, err := r.BodyField(field); err == nil && publicID != "" {
+				return publicID
+			}
+		}
+	}
+	return r.Param("playerPublicID")
+}
+
+// take takes a token of the bucket of the request of requestor, see rateLimitRequestor. Requests over the limit
+// fail with the seconds to wait before retrying
+func (m *RateLimitMiddleware) take(r apiRequest, requestor string) (int64, error) {
+	method, path := r.Method(), r.Path()
+	group, limited := rateLimitGroup(method, path)
+	if !limited {
+		return 0, nil
+	}
+
+	gameID := r.Param("gameID")
+	key := fmt.Sprintf("%s:%s %s:%s", gameID, method, path, requestor)
+	allowed, retryAfter, err := m.Store.Take(key, m.Limits[group], time.Now())
+	if err != nil {
+		log.E(m.Logger, "Rate limit store failed.", func(cm log.CM) {
+			cm.Write(zap.Bool("failOpen", m.FailOpen), zap.Error(err))
+		})
+		if !m.FailOpen {
+			return 0, withStatus(http.StatusServiceUnavailable, errors.New("Rate limit is unavailable."))
+		}
+		if m.MetricsReporter != nil {
+			m.MetricsReporter.Increment(ratelimit.FailOpenMetric, fmt.Sprintf("group:%s", group), fmt.Sprintf("game:%s", gameID))
+		}
+		return 0, nil
+	}
+	if allowed {
+		return 0, nil
+	}
+
+	if m.MetricsReporter != nil {
+		m.MetricsReporter.Increment(ratelimit.RejectedMetric, fmt.Sprintf("group:%s", group), fmt.Sprintf("game:%s", gameID))
+	}
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds, withStatus(http.StatusTooManyRequests, errors.New("Rate limit exceeded."))
+}
+
+// Serve serves the middleware
+func (m *RateLimitMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		subject, _ := c.Get(playerTokenSubjectKey).(string)
+		apiKeyPublicID, _ := c.Get(apiKeyPublicIDKey).(string)
+		r := newEchoRequest(c)
+		basicAuthUser := verifiedBasicAuthUser(r, m.BasicAuthUser)
+		retryAfter, err := m.take(r, rateLimitRequestor(r, subject, apiKeyPublicID, basicAuthUser))
+		if err != nil {
+			if retryAfter > 0 {
+				c.Response().Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+			}
+			return FailWithError(err, c)
+		}
+		return next(c)
+	}
+}
+
+// IdempotencyKeyHeader is the header POST and PUT requests send their idempotency key in
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader is set in responses replayed from a previous request with the same idempotency key
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+//NewGameDeletionMiddleware returns a middleware that rejects writes to games being deleted
+func NewGameDeletionMiddleware(app *App) *GameDeletionMiddleware {
+	return &GameDeletionMiddleware{App: app}
+}
+
+//GameDeletionMiddleware rejects requests that write to a game being deleted, other than deleting it again,
+// so its rows are not written while they are deleted in batches
+type GameDeletionMiddleware struct {
+	App *App
+}
+
+// check returns a GameDeletingError if the request writes to a game being deleted
+func (m *GameDeletionMiddleware) check(r apiRequest) error {
+	gameID := r.Param("gameID")
+	method := r.Method()
+	if gameID == "" || method == echo.GET || method == echo.HEAD ||
+		(method == echo.DELETE && r.Path() == "/games/:gameID") {
+		return nil
+	}
+
+	game, err := m.App.GetGame(r.Context(), gameID)
+	if err != nil {
+		// routes of games that do not exist fail or create the game as they did
+		return nil
+	}
+	if game.Deleting {
+		return &models.GameDeletingError{GameID: gameID}
+	}
+	return nil
+}
+
+// Serve serves the middleware
+func (m *GameDeletionMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if err := m.check(newEchoRequest(c)); err != nil {
+			return FailWithError(err, c)
+		}
+		return next(c)
+	}
+}
+
+// maxIdempotencyKeyLength is the length of the longest idempotency key accepted
+const maxIdempotencyKeyLength = 255
+
+// idempotencyReplayedHeaders are the response headers stored with the response of a request and replayed to its retries
+var idempotencyReplayedHeaders = []string{"ETag", "Deprecation", "Link"}
+
+//NewIdempotencyMiddleware returns a middleware that replays the responses of retried POST and PUT requests
+func NewIdempotencyMiddleware(app *App) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		App:           app,
+		Window:        app.Config.GetDuration("idempotency.window"),
+		InProgressTTL: app.Config.GetDuration("idempotency.inProgressTTL"),
+		BasicAuthUser: app.Config.GetString("basicauth.username"),
+		Logger:        app.Logger.With(zap.String("source", "idempotencyMiddleware")),
+	}
+}
+
+//IdempotencyMiddleware stores the response of POST and PUT requests with an idempotency key for Window, and
+// replays it to requests of the same caller with the same key instead of calling the route again. Keys of
+// requests that have not finished within InProgressTTL can be used again. BasicAuthUser is the configured
+// basic auth user, whose credentials are verified before the middleware
+type IdempotencyMiddleware struct {
+	App           *App
+	Window        time.Duration
+	InProgressTTL time.Duration
+	BasicAuthUser string
+	Logger        zap.Logger
+}
+
+// release removes the reservation of a key whose request failed, so that it can be retried
+func (m *IdempotencyMiddleware) release(db models.DB, gameID, caller, key string) {
+	if err := models.ReleaseIdempotencyKey(db, gameID, caller, key); err != nil {
+		log.E(m.Logger, "Failed to release the idempotency key.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+}
+
+// Serve serves the middleware
+func (m *IdempotencyMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		method := c.Request().Method()
+		key := c.Request().Header().Get(IdempotencyKeyHeader)
+		if key == "" || (method != echo.POST && method != echo.PUT) {
+			return next(c)
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return FailWith(
+				http.StatusBadRequest,
+				fmt.Sprintf("%s must have at most %d characters.", IdempotencyKeyHeader, maxIdempotencyKeyLength),
+				c,
+			)
+		}
+
+		body, err := GetRequestBody(c)
+		if err != nil {
+			return FailWith(http.StatusBadRequest, err.Error(), c)
+		}
+
+		db := m.App.Db(c.StdContext())
+		gameID := c.Param("gameID")
+		path := c.Request().URL().Path()
+		subject, _ := c.Get(playerTokenSubjectKey).(string)
+		apiKeyPublicID, _ := c.Get(apiKeyPublicIDKey).(string)
+		caller := models.IdempotencyCaller(
+			subject, apiKeyPublicID, verifiedBasicAuthUser(newEchoRequest(c), m.BasicAuthUser),
+		)
+		stored, err := models.ReserveIdempotencyKey(
+			db, gameID, caller, key, method, path, util.HashToken(string(body)), m.Window, m.InProgressTTL,
+		)
+		if err != nil {
+			switch err.(type) {
+			case *models.IdempotencyKeyMismatchError, *models.IdempotencyKeyInProgressError:
+				return FailWithError(err, c)
+			}
+			return FailWith(http.StatusInternalServerError, err.Error(), c)
+		}
+		if stored != nil {
+			response, err := stored.GetResponse(m.App.EncryptionKey)
+			if err != nil {
+				return FailWith(http.StatusInternalServerError, err.Error(), c)
+			}
+			headers, err := stored.GetHeaders()
+			if err != nil {
+				return FailWith(http.StatusInternalServerError, err.Error(), c)
+			}
+			for name, value := range headers {
+				c.Response().Header().Set(name, value)
+			}
+			c.Response().Header().Set(IdempotentReplayedHeader, "true")
+			return c.JSONBlob(stored.Status, []byte(response))
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				m.release(db, gameID, caller, key)
+				panic(r)
+			}
+		}()
+		response, err := getBodyFromNext(c, next)
+		status := c.Response().Status()
+		if err != nil || status >= http.StatusInternalServerError {
+			m.release(db, gameID, caller, key)
+			return err
+		}
+		headers := map[string]string{}
+		for _, name := range idempotencyReplayedHeaders {
+			if value := c.Response().Header().Get(name); value != "" {
+				headers[name] = value
+			}
+		}
+		cErr := models.CompleteIdempotencyKey(db, m.App.EncryptionKey, gameID, caller, key, status, response, headers)
+		if cErr != nil {
+			log.E(m.Logger, "Failed to store the response of the idempotency key.", func(cm log.CM) {
+				cm.Write(zap.Error(cErr))
+			})
+		}
+		return nil
+	}
+}
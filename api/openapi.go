@@ -0,0 +1,462 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo"
+	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/util"
+)
+
+// openAPIOperation documents a route of the API
+type openAPIOperation struct {
+	Summary string
+	Tag     string
+	// Payload is the payload type of the route, if it has one
+	Payload interface{}
+	// Fields are the fields of the payload that are not in its type, mapped to their type and description
+	Fields map[string]openAPIField
+	// Query are the query parameters of the route mapped to their descriptions
+	Query map[string]string
+}
+
+// openAPIField documents a field of a payload
+type openAPIField struct {
+	Type        interface{}
+	Description string
+}
+
+// gameSettingsFields are the optional fields of the create and update game payloads
+var gameSettingsFields = map[string]openAPIField{
+	"maxPendingInvites":             {0, "Maximum number of pending invites of a player, -1 for no limit"},
+	"cooldownBeforeInvite":          {0, "Seconds a member waits to invite again after an application or invite was created"},
+	"cooldownBeforeApply":           {0, "Seconds a player waits to apply again after an application or invite was created"},
+	"clanHookFieldsWhitelist":       {"", "Comma-separated clan metadata fields whose changes trigger the clan updated hook"},
+	"playerHookFieldsWhitelist":     {"", "Comma-separated player metadata fields whose changes trigger the player updated hook"},
+	"playerEncryptedMetadataFields": {"", "Comma-separated player metadata fields encrypted at rest"},
+	"searchSettings":                {models.SearchSettings{}, "Clan name search settings"},
+	"playerNameSettings":            {models.PlayerNameSettings{}, "Player name history and uniqueness settings"},
+	"playerTokenSettings":           {models.PlayerTokenSettings{}, "Settings of the player tokens game clients call Khan with"},
+}
+
+// openAPIOperations documents the routes of the API, by method and path
+var openAPIOperations = map[string]openAPIOperation{
+	"GET /healthcheck":       {Summary: "Check that Khan is up", Tag: "healthcheck"},
+	"GET /healthcheck/live":  {Summary: "Check that the Khan process is up", Tag: "healthcheck"},
+	"GET /healthcheck/ready": {Summary: "Check that every enabled dependency of Khan is reachable", Tag: "healthcheck"},
+	"GET /status":            {Summary: "Retrieve the status of Khan and the depths of its worker queues", Tag: "healthcheck"},
+	"GET /metrics":           {Summary: "Retrieve the metrics of Khan in the Prometheus text format", Tag: "healthcheck"},
+	"GET /openapi.json":      {Summary: "Retrieve this document", Tag: "healthcheck"},
+	"GET /games": {
+		Summary: "List games", Tag: "games",
+		Query: map[string]string{
+			"limit":  "Maximum number of games returned",
+			"offset": "Number of games skipped, in creation order",
+		},
+	},
+	"POST /games":        {Summary: "Create a game", Tag: "games", Payload: CreateGamePayload{}, Fields: gameSettingsFields},
+	"GET /games/:gameID": {Summary: "Retrieve a game", Tag: "games"},
+	"PUT /games/:gameID": {
+		Summary: "Create or update a game", Tag: "games", Payload: UpdateGamePayload{}, Fields: gameSettingsFields,
+	},
+	"DELETE /games/:gameID":                             {Summary: "Delete a game with its players and clans", Tag: "games"},
+	"GET /games/:gameID/versions":                       {Summary: "List the versions of a game", Tag: "games"},
+	"POST /games/:gameID/versions/:version/rollback":    {Summary: "Roll a game back to a version", Tag: "games"},
+	"GET /games/:gameID/api-keys":                       {Summary: "List the API keys of a game", Tag: "api-keys"},
+	"POST /games/:gameID/api-keys":                      {Summary: "Issue an API key", Tag: "api-keys", Payload: APIKeyPayload{}},
+	"DELETE /games/:gameID/api-keys/:apiKeyPublicID":    {Summary: "Revoke an API key", Tag: "api-keys"},
+	"POST /games/:gameID/hooks":                         {Summary: "Create a hook", Tag: "hooks", Payload: HookPayload{}},
+	"DELETE /games/:gameID/hooks/:publicID":             {Summary: "Remove a hook", Tag: "hooks"},
+	"POST /games/:gameID/players":                       {Summary: "Create a player", Tag: "players", Payload: CreatePlayerPayload{}},
+	"PUT /games/:gameID/players":                        {Summary: "Create or update players", Tag: "players", Payload: UpsertPlayersPayload{}},
+	"PUT /games/:gameID/players/:playerPublicID":        {Summary: "Create or update a player", Tag: "players", Payload: UpdatePlayerPayload{}},
+	"PATCH /games/:gameID/players/:playerPublicID":      {Summary: "Patch a player", Tag: "players", Payload: PatchPlayerPayload{}},
+	"GET /games/:gameID/players/:playerPublicID":        {Summary: "Retrieve a player with its memberships", Tag: "players"},
+	"DELETE /games/:gameID/players/:playerPublicID":     {Summary: "Delete a player", Tag: "players"},
+	"GET /games/:gameID/players/:playerPublicID/export": {Summary: "Export the data of a player", Tag: "players"},
+	"GET /games/:gameID/players/:playerPublicID/name-history": {
+		Summary: "Retrieve the previous names of a player", Tag: "players",
+	},
+	"GET /games/:gameID/clans/search": {
+		Summary: "Search clans by name", Tag: "clans", Query: map[string]string{"term": "Text to search clan names for"},
+	},
+	"GET /games/:gameID/clans/suggest": {
+		Summary: "Suggest clans by name prefix", Tag: "clans", Query: map[string]string{"prefix": "Prefix of the clan names"},
+	},
+	"GET /games/:gameID/clans":  {Summary: "List clans", Tag: "clans"},
+	"POST /games/:gameID/clans": {Summary: "Create a clan", Tag: "clans", Payload: CreateClanPayload{}},
+	"GET /games/:gameID/clans-summary": {
+		Summary: "Retrieve the summaries of clans", Tag: "clans",
+		Query: map[string]string{"clanPublicIds": "Comma-separated public IDs of the clans"},
+	},
+	"GET /games/:gameID/clans/:clanPublicID": {
+		Summary: "Retrieve a clan with its members", Tag: "clans",
+		Query: map[string]string{
+			"shortID":                  "If true, clanPublicID is the short ID of the clan",
+			"maxPendingApplications":   "Maximum number of pending applications returned",
+			"maxPendingInvites":        "Maximum number of pending invites returned",
+			"pendingApplicationsOrder": "Order of the pending applications, newest or oldest",
+			"pendingInvitesOrder":      "Order of the pending invites, newest or oldest",
+		},
+	},
+	"GET /games/:gameID/clans/:clanPublicID/members": {Summary: "Retrieve the members of a clan", Tag: "clans"},
+	"GET /games/:gameID/clans/:clanPublicID/summary": {Summary: "Retrieve the summary of a clan", Tag: "clans"},
+	"PUT /games/:gameID/clans/:clanPublicID":         {Summary: "Update a clan", Tag: "clans", Payload: UpdateClanPayload{}},
+	"PATCH /games/:gameID/clans/:clanPublicID":       {Summary: "Patch a clan", Tag: "clans", Payload: PatchClanPayload{}},
+	"POST /games/:gameID/clans/:clanPublicID/leave":  {Summary: "Make the owner leave a clan", Tag: "clans"},
+	"POST /games/:gameID/clans/:clanPublicID/transfer-ownership": {
+		Summary: "Transfer the ownership of a clan", Tag: "clans", Payload: TransferClanOwnershipPayload{},
+	},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/application": {
+		Summary: "Apply for membership in a clan", Tag: "memberships", Payload: ApplyForMembershipPayload{},
+	},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/application/:action": {
+		Summary: "Approve or deny a membership application", Tag: "memberships",
+		Payload: BasePayloadWithRequestorAndPlayerPublicIDs{},
+	},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/invitation": {
+		Summary: "Invite a player for membership in a clan", Tag: "memberships", Payload: InviteForMembershipPayload{},
+	},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/invitation/:action": {
+		Summary: "Approve or deny a membership invitation", Tag: "memberships",
+		Payload: ApproveOrDenyMembershipInvitationPayload{},
+	},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/delete": {
+		Summary: "Remove a member from a clan", Tag: "memberships", Payload: BasePayloadWithRequestorAndPlayerPublicIDs{},
+	},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/promote": {
+		Summary: "Promote a member of a clan", Tag: "memberships", Payload: BasePayloadWithRequestorAndPlayerPublicIDs{},
+	},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/demote": {
+		Summary: "Demote a member of a clan", Tag: "memberships", Payload: BasePayloadWithRequestorAndPlayerPublicIDs{},
+	},
+}
+
+// OpenAPIUndocumented returns the routes without documentation and the fields of their payloads and responses,
+// including the fields of nested structs, without descriptions
+func OpenAPIUndocumented(routes []echo.Route) []string {
+	var undocumented []string
+	types := map[reflect.Type]bool{}
+	for _, route := range routes {
+		operation, ok := openAPIOperations[openAPIOperationKey(route)]
+		if !ok || operation.Summary == "" {
+			undocumented = append(undocumented, fmt.Sprintf("%s %s", route.Method, route.Path))
+			continue
+		}
+		if operation.Payload != nil {
+			openAPICollectStructs(reflect.TypeOf(operation.Payload), types)
+		}
+		for _, field := range operation.Fields {
+			openAPICollectStructs(reflect.TypeOf(field.Type), types)
+		}
+		response, ok := v2Responses[openAPIOperationKey(route)]
+		if !ok {
+			if strings.HasPrefix(route.Path, V2Prefix+"/") {
+				undocumented = append(undocumented, fmt.Sprintf("%s %s", route.Method, route.Path))
+			}
+			continue
+		}
+		openAPICollectStructs(reflect.TypeOf(response), types)
+	}
+	for t := range types {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Anonymous || !openAPIDocumentedField(field) {
+				continue
+			}
+			if field.Tag.Get("doc") == "" {
+				undocumented = append(undocumented, fmt.Sprintf("%s.%s", t.Name(), openAPIFieldName(field)))
+			}
+		}
+	}
+	sort.Strings(undocumented)
+	return undocumented
+}
+
+// openAPICollectStructs adds the struct types of t, the types of its fields and the types they nest to types
+func openAPICollectStructs(t reflect.Type, types map[reflect.Type]bool) {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		openAPICollectStructs(t.Elem(), types)
+	case reflect.Struct:
+		if types[t] {
+			return
+		}
+		types[t] = true
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Anonymous || openAPIDocumentedField(field) {
+				openAPICollectStructs(field.Type, types)
+			}
+		}
+	}
+}
+
+// openAPIDocumentedField returns whether a struct field is in the schema of the struct
+func openAPIDocumentedField(field reflect.StructField) bool {
+	return field.PkgPath == "" && field.Tag.Get("json") != "-"
+}
+
+// BuildOpenAPI returns the OpenAPI 3 document of the given routes
+func BuildOpenAPI(routes []echo.Route) map[string]interface{} {
+	schemas := map[string]interface{}{
+		"Error": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"success": map[string]interface{}{"type": "boolean"},
+				"reason":  map[string]interface{}{"type": "string"},
+			},
+		},
+	}
+
+	paths := map[string]map[string]interface{}{}
+	for _, route := range routes {
+		operation := openAPIOperations[openAPIOperationKey(route)]
+
+		var parameters []interface{}
+		for _, segment := range strings.Split(route.Path, "/") {
+			if strings.HasPrefix(segment, ":") {
+				parameters = append(parameters, map[string]interface{}{
+					"name":     strings.TrimPrefix(segment, ":"),
+					"in":       "path",
+					"required": true,
+					"schema":   map[string]interface{}{"type": "string"},
+				})
+			}
+		}
+		for _, name := range sortedKeys(operation.Query) {
+			parameters = append(parameters, map[string]interface{}{
+				"name":        name,
+				"in":          "query",
+				"description": operation.Query[name],
+				"schema":      map[string]interface{}{"type": "string"},
+			})
+		}
+
+		doc := map[string]interface{}{
+			"summary":     operation.Summary,
+			"operationId": openAPIOperationID(route.Method, route.Path),
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{"description": "Success"},
+				"default": map[string]interface{}{
+					"description": "Error",
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{
+							"schema": map[string]interface{}{"$ref": "#/components/schemas/Error"},
+						},
+					},
+				},
+			},
+		}
+		if operation.Tag != "" {
+			doc["tags"] = []string{operation.Tag}
+		}
+		if response, ok := v2Responses[openAPIOperationKey(route)]; ok {
+			// v1 routes respond with at least the fields of the typed v2 responses
+			doc["responses"].(map[string]interface{})["200"] = map[string]interface{}{
+				"description": "Success",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": openAPISchema(reflect.TypeOf(response), schemas),
+					},
+				},
+			}
+			if !strings.HasPrefix(route.Path, V2Prefix+"/") {
+				doc["deprecated"] = true
+			}
+		}
+		if len(parameters) > 0 {
+			doc["parameters"] = parameters
+		}
+		if operation.Payload != nil {
+			payloadType := reflect.TypeOf(operation.Payload)
+			schema := openAPISchema(payloadType, schemas)
+			if len(operation.Fields) > 0 {
+				name := fmt.Sprintf("%sWithSettings", payloadType.Name())
+				withFields := openAPIObjectSchema(payloadType, schemas)
+				properties := withFields["properties"].(map[string]interface{})
+				for fieldName, field := range operation.Fields {
+					fieldSchema := openAPISchema(reflect.TypeOf(field.Type), schemas)
+					properties[fieldName] = openAPIDescribe(fieldSchema, field.Description)
+				}
+				schemas[name] = withFields
+				schema = map[string]interface{}{"$ref": fmt.Sprintf("#/components/schemas/%s", name)}
+			}
+			doc["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{"schema": schema},
+				},
+			}
+		}
+
+		path := openAPIPath(route.Path)
+		if paths[path] == nil {
+			paths[path] = map[string]interface{}{}
+		}
+		paths[path][strings.ToLower(route.Method)] = doc
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   "Khan",
+			"version": util.VERSION,
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+		},
+	}
+}
+
+// openAPIOperationKey returns the key of the route in openAPIOperations. v2 routes share the operations of v1
+func openAPIOperationKey(route echo.Route) string {
+	return fmt.Sprintf("%s %s", route.Method, strings.TrimPrefix(route.Path, V2Prefix))
+}
+
+// openAPIPath converts an echo path to an OpenAPI path
+func openAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = fmt.Sprintf("{%s}", strings.TrimPrefix(segment, ":"))
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// openAPIOperationID returns a unique ID of the route with the given method and path, such as getGamesGameID
+func openAPIOperationID(method, path string) string {
+	id := strings.ToLower(method)
+	for _, segment := range strings.Split(path, "/") {
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool {
+			return r == ':' || r == '-' || r == '.'
+		}) {
+			id += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return id
+}
+
+// openAPIDescribe returns the schema with a description. References cannot have siblings, so they are wrapped
+func openAPIDescribe(schema map[string]interface{}, description string) map[string]interface{} {
+	if _, ok := schema["$ref"]; ok {
+		return map[string]interface{}{"allOf": []interface{}{schema}, "description": description}
+	}
+	described := map[string]interface{}{"description": description}
+	for key, value := range schema {
+		described[key] = value
+	}
+	return described
+}
+
+// openAPIFieldName returns the JSON name of a struct field
+func openAPIFieldName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// openAPISchema returns the schema of a type. Structs are added to schemas and referenced
+func openAPISchema(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return openAPISchema(t.Elem(), schemas)
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": openAPISchema(t.Elem(), schemas)}
+	case reflect.Struct:
+		if _, ok := schemas[t.Name()]; !ok {
+			schemas[t.Name()] = openAPIObjectSchema(t, schemas)
+		}
+		return map[string]interface{}{"$ref": fmt.Sprintf("#/components/schemas/%s", t.Name())}
+	default:
+		return map[string]interface{}{"type": "object"}
+	}
+}
+
+// openAPIObjectSchema returns the schema of the fields of a struct. Fields reported as required by the Validate
+// method of the struct, if it has one, are required
+func openAPIObjectSchema(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			embedded := openAPIObjectSchema(field.Type, schemas)
+			for name, property := range embedded["properties"].(map[string]interface{}) {
+				properties[name] = property
+			}
+			continue
+		}
+		if !openAPIDocumentedField(field) {
+			continue
+		}
+		property := openAPISchema(field.Type, schemas)
+		if doc := field.Tag.Get("doc"); doc != "" {
+			property = openAPIDescribe(property, doc)
+		}
+		properties[openAPIFieldName(field)] = property
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if payload, ok := reflect.New(t).Interface().(Validatable); ok {
+		var required []string
+		for _, validationError := range payload.Validate() {
+			if strings.HasSuffix(validationError, " is required") {
+				required = append(required, strings.TrimSuffix(validationError, " is required"))
+			}
+		}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+	}
+	return schema
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+//OpenAPIHandler is the handler responsible for serving the OpenAPI document of the API
+func OpenAPIHandler(app *App) func(c echo.Context) error {
+	var once sync.Once
+	var document map[string]interface{}
+	return func(c echo.Context) error {
+		c.Set("route", "OpenAPI")
+		once.Do(func() {
+			document = BuildOpenAPI(app.App.Routes())
+		})
+		return c.JSON(http.StatusOK, document)
+	}
+}
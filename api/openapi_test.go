@@ -0,0 +1,76 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/khan/api"
+)
+
+var _ = Describe("OpenAPI Handler", func() {
+	var a *api.App
+
+	BeforeEach(func() {
+		a = GetDefaultTestApp()
+	})
+
+	It("Should document every route and payload field", func() {
+		Expect(api.OpenAPIUndocumented(a.App.Routes())).To(BeEmpty())
+	})
+
+	It("Should serve the OpenAPI document", func() {
+		status, body := Get(a, "/openapi.json")
+		Expect(status).To(Equal(http.StatusOK))
+
+		var document map[string]interface{}
+		err := json.Unmarshal([]byte(body), &document)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(document["openapi"]).To(Equal("3.0.0"))
+
+		paths := document["paths"].(map[string]interface{})
+		Expect(paths).To(HaveLen(len(pathsOf(a))))
+		clans := paths["/games/{gameID}/clans"].(map[string]interface{})
+		Expect(clans).To(HaveKey("get"))
+		Expect(clans).To(HaveKey("post"))
+
+		schemas := document["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+		createClan := schemas["CreateClanPayload"].(map[string]interface{})
+		Expect(createClan["required"]).To(ConsistOf("publicID", "name", "ownerPublicID", "metadata"))
+		Expect(createClan["properties"]).To(HaveKey("allowApplication"))
+	})
+
+	It("Should reference the response schemas of deprecated v1 routes", func() {
+		status, body := Get(a, "/openapi.json")
+		Expect(status).To(Equal(http.StatusOK))
+
+		var document map[string]interface{}
+		err := json.Unmarshal([]byte(body), &document)
+		Expect(err).NotTo(HaveOccurred())
+
+		paths := document["paths"].(map[string]interface{})
+		for _, path := range []string{"/games/{gameID}/clans/{clanPublicID}", api.V2Prefix + "/games/{gameID}/clans/{clanPublicID}"} {
+			operation := paths[path].(map[string]interface{})["get"].(map[string]interface{})
+			success := operation["responses"].(map[string]interface{})["200"].(map[string]interface{})
+			Expect(success).To(HaveKey("content"), path)
+		}
+		v1 := paths["/games/{gameID}/clans/{clanPublicID}"].(map[string]interface{})["get"].(map[string]interface{})
+		Expect(v1["deprecated"]).To(BeTrue())
+	})
+})
+
+func pathsOf(a *api.App) map[string]bool {
+	paths := map[string]bool{}
+	for _, route := range a.App.Routes() {
+		paths[route.Path] = true
+	}
+	return paths
+}
@@ -87,12 +87,12 @@ func logPayloadErrors(logger zap.Logger, errors []string) {
 
 //CreateClanPayload maps the payload for the Create Clan route
 type CreateClanPayload struct {
-	PublicID         string                 `json:"publicID"`
-	Name             string                 `json:"name"`
-	OwnerPublicID    string                 `json:"ownerPublicID"`
-	Metadata         map[string]interface{} `json:"metadata"`
-	AllowApplication bool                   `json:"allowApplication"`
-	AutoJoin         bool                   `json:"autoJoin"`
+	PublicID         string                 `json:"publicID" doc:"Public ID of the clan"`
+	Name             string                 `json:"name" doc:"Name of the clan"`
+	OwnerPublicID    string                 `json:"ownerPublicID" doc:"Public ID of the player that owns the clan"`
+	Metadata         map[string]interface{} `json:"metadata" doc:"Game specific data of the clan"`
+	AllowApplication bool                   `json:"allowApplication" doc:"Whether players can apply to the clan"`
+	AutoJoin         bool                   `json:"autoJoin" doc:"Whether applications to the clan are approved automatically"`
 }
 
 //Validate all the required fields for creating a clan
@@ -107,11 +107,11 @@ func (ccp *CreateClanPayload) Validate() []string {
 
 //UpdateClanPayload maps the payload for the Update Clan route
 type UpdateClanPayload struct {
-	Name             string                 `json:"name"`
-	OwnerPublicID    string                 `json:"ownerPublicID"`
-	Metadata         map[string]interface{} `json:"metadata"`
-	AllowApplication bool                   `json:"allowApplication"`
-	AutoJoin         bool                   `json:"autoJoin"`
+	Name             string                 `json:"name" doc:"Name of the clan"`
+	OwnerPublicID    string                 `json:"ownerPublicID" doc:"Public ID of the player that owns the clan"`
+	Metadata         map[string]interface{} `json:"metadata" doc:"Game specific data of the clan"`
+	AllowApplication bool                   `json:"allowApplication" doc:"Whether players can apply to the clan"`
+	AutoJoin         bool                   `json:"autoJoin" doc:"Whether applications to the clan are approved automatically"`
 }
 
 //Validate all the required fields for updating a clan
@@ -123,9 +123,28 @@ func (ucp *UpdateClanPayload) Validate() []string {
 	return v.Errors()
 }
 
+//PatchClanPayload maps the JSON Merge Patch of the Patch Clan route
+type PatchClanPayload struct {
+	Name             *string                `json:"name" doc:"Name of the clan, unchanged if omitted"`
+	OwnerPublicID    string                 `json:"ownerPublicID" doc:"Public ID of the player that owns the clan"`
+	Metadata         map[string]interface{} `json:"metadata" doc:"Metadata fields to merge into the clan metadata. Null fields are removed, and null metadata removes every field"`
+	AllowApplication *bool                  `json:"allowApplication" doc:"Whether players can apply to the clan, unchanged if omitted"`
+	AutoJoin         *bool                  `json:"autoJoin" doc:"Whether applications to the clan are approved automatically, unchanged if omitted"`
+}
+
+//Validate all the required fields for patching a clan
+func (pcp *PatchClanPayload) Validate() []string {
+	v := NewValidation()
+	v.validateRequiredString("ownerPublicID", pcp.OwnerPublicID)
+	if pcp.Name != nil {
+		v.validateRequiredString("name", *pcp.Name)
+	}
+	return v.Errors()
+}
+
 //TransferClanOwnershipPayload maps the payload for the Transfer Clan Ownership route
 type TransferClanOwnershipPayload struct {
-	PlayerPublicID string `json:"playerPublicID"`
+	PlayerPublicID string `json:"playerPublicID" doc:"Public ID of the member that becomes the owner"`
 }
 
 //Validate all the required fields for transferring a clan ownership
@@ -137,9 +156,9 @@ func (tcop *TransferClanOwnershipPayload) Validate() []string {
 
 //CreatePlayerPayload maps the payload for the Create Player route
 type CreatePlayerPayload struct {
-	PublicID string                 `json:"publicID"`
-	Name     string                 `json:"name"`
-	Metadata map[string]interface{} `json:"metadata"`
+	PublicID string                 `json:"publicID" doc:"Public ID of the player"`
+	Name     string                 `json:"name" doc:"Name of the player"`
+	Metadata map[string]interface{} `json:"metadata" doc:"Game specific data of the player"`
 }
 
 //Validate all the required fields for creating a player
@@ -153,8 +172,8 @@ func (cpp *CreatePlayerPayload) Validate() []string {
 
 //UpdatePlayerPayload maps the payload for the Update Player route
 type UpdatePlayerPayload struct {
-	Name     string                 `json:"name"`
-	Metadata map[string]interface{} `json:"metadata"`
+	Name     string                 `json:"name" doc:"Name of the player"`
+	Metadata map[string]interface{} `json:"metadata" doc:"Game specific data of the player"`
 }
 
 //Validate all the required fields for updating a player
@@ -165,21 +184,45 @@ func (upp *UpdatePlayerPayload) Validate() []string {
 	return v.Errors()
 }
 
+//PatchPlayerPayload maps the JSON Merge Patch of the Patch Player route
+type PatchPlayerPayload struct {
+	Name     string                 `json:"name" doc:"Name of the player, unchanged if omitted"`
+	Metadata map[string]interface{} `json:"metadata" doc:"Metadata fields to merge into the player metadata. Null fields are removed"`
+}
+
+//UpsertPlayersPayload maps the payload for the Upsert Players route
+type UpsertPlayersPayload struct {
+	Players []*CreatePlayerPayload `json:"players" doc:"Players to create or update"`
+}
+
+//Validate that there are players to upsert. Each player is validated by the route, so that
+//invalid players are reported without failing the others
+func (upp *UpsertPlayersPayload) Validate() []string {
+	v := NewValidation()
+	v.validateCustom("players", func() []string {
+		if len(upp.Players) == 0 {
+			return []string{"players is required"}
+		}
+		return nil
+	})
+	return v.Errors()
+}
+
 //UpdateGamePayload maps the payload required for the Update game route
 type UpdateGamePayload struct {
-	Name                          string                 `json:"name"`
-	MembershipLevels              map[string]interface{} `json:"membershipLevels"`
-	Metadata                      map[string]interface{} `json:"metadata"`
-	MinLevelToAcceptApplication   int                    `json:"minLevelToAcceptApplication"`
-	MinLevelToCreateInvitation    int                    `json:"minLevelToCreateInvitation"`
-	MinLevelToRemoveMember        int                    `json:"minLevelToRemoveMember"`
-	MinLevelOffsetToRemoveMember  int                    `json:"minLevelOffsetToRemoveMember"`
-	MinLevelOffsetToPromoteMember int                    `json:"minLevelOffsetToPromoteMember"`
-	MinLevelOffsetToDemoteMember  int                    `json:"minLevelOffsetToDemoteMember"`
-	MaxMembers                    int                    `json:"maxMembers"`
-	MaxClansPerPlayer             int                    `json:"maxClansPerPlayer"`
-	CooldownAfterDeny             int                    `json:"cooldownAfterDeny"`
-	CooldownAfterDelete           int                    `json:"cooldownAfterDelete"`
+	Name                          string                 `json:"name" doc:"Name of the game"`
+	MembershipLevels              map[string]interface{} `json:"membershipLevels" doc:"Membership levels of the game mapped to their integer values"`
+	Metadata                      map[string]interface{} `json:"metadata" doc:"Game specific configuration"`
+	MinLevelToAcceptApplication   int                    `json:"minLevelToAcceptApplication" doc:"Lowest level of a member that can accept applications"`
+	MinLevelToCreateInvitation    int                    `json:"minLevelToCreateInvitation" doc:"Lowest level of a member that can invite players"`
+	MinLevelToRemoveMember        int                    `json:"minLevelToRemoveMember" doc:"Lowest level of a member that can remove members"`
+	MinLevelOffsetToRemoveMember  int                    `json:"minLevelOffsetToRemoveMember" doc:"Levels a member must be above another member to remove them"`
+	MinLevelOffsetToPromoteMember int                    `json:"minLevelOffsetToPromoteMember" doc:"Levels a member must be above another member to promote them"`
+	MinLevelOffsetToDemoteMember  int                    `json:"minLevelOffsetToDemoteMember" doc:"Levels a member must be above another member to demote them"`
+	MaxMembers                    int                    `json:"maxMembers" doc:"Maximum number of members of a clan"`
+	MaxClansPerPlayer             int                    `json:"maxClansPerPlayer" doc:"Maximum number of clans a player can be member of"`
+	CooldownAfterDeny             int                    `json:"cooldownAfterDeny" doc:"Seconds a player waits to apply or be invited again after being denied"`
+	CooldownAfterDelete           int                    `json:"cooldownAfterDelete" doc:"Seconds a player waits to apply or be invited again after being removed"`
 }
 
 //Validate the update game payload
@@ -227,20 +270,20 @@ func (p *UpdateGamePayload) Validate() []string {
 
 //CreateGamePayload maps the payload required for the Create game route
 type CreateGamePayload struct {
-	PublicID                      string                 `json:"publicID"`
-	Name                          string                 `json:"name"`
-	MembershipLevels              map[string]interface{} `json:"membershipLevels"`
-	Metadata                      map[string]interface{} `json:"metadata"`
-	MinLevelToAcceptApplication   int                    `json:"minLevelToAcceptApplication"`
-	MinLevelToCreateInvitation    int                    `json:"minLevelToCreateInvitation"`
-	MinLevelToRemoveMember        int                    `json:"minLevelToRemoveMember"`
-	MinLevelOffsetToRemoveMember  int                    `json:"minLevelOffsetToRemoveMember"`
-	MinLevelOffsetToPromoteMember int                    `json:"minLevelOffsetToPromoteMember"`
-	MinLevelOffsetToDemoteMember  int                    `json:"minLevelOffsetToDemoteMember"`
-	MaxMembers                    int                    `json:"maxMembers"`
-	MaxClansPerPlayer             int                    `json:"maxClansPerPlayer"`
-	CooldownAfterDeny             int                    `json:"cooldownAfterDeny"`
-	CooldownAfterDelete           int                    `json:"cooldownAfterDelete"`
+	PublicID                      string                 `json:"publicID" doc:"Public ID of the game"`
+	Name                          string                 `json:"name" doc:"Name of the game"`
+	MembershipLevels              map[string]interface{} `json:"membershipLevels" doc:"Membership levels of the game mapped to their integer values"`
+	Metadata                      map[string]interface{} `json:"metadata" doc:"Game specific configuration"`
+	MinLevelToAcceptApplication   int                    `json:"minLevelToAcceptApplication" doc:"Lowest level of a member that can accept applications"`
+	MinLevelToCreateInvitation    int                    `json:"minLevelToCreateInvitation" doc:"Lowest level of a member that can invite players"`
+	MinLevelToRemoveMember        int                    `json:"minLevelToRemoveMember" doc:"Lowest level of a member that can remove members"`
+	MinLevelOffsetToRemoveMember  int                    `json:"minLevelOffsetToRemoveMember" doc:"Levels a member must be above another member to remove them"`
+	MinLevelOffsetToPromoteMember int                    `json:"minLevelOffsetToPromoteMember" doc:"Levels a member must be above another member to promote them"`
+	MinLevelOffsetToDemoteMember  int                    `json:"minLevelOffsetToDemoteMember" doc:"Levels a member must be above another member to demote them"`
+	MaxMembers                    int                    `json:"maxMembers" doc:"Maximum number of members of a clan"`
+	MaxClansPerPlayer             int                    `json:"maxClansPerPlayer" doc:"Maximum number of clans a player can be member of"`
+	CooldownAfterDeny             int                    `json:"cooldownAfterDeny" doc:"Seconds a player waits to apply or be invited again after being denied"`
+	CooldownAfterDelete           int                    `json:"cooldownAfterDelete" doc:"Seconds a player waits to apply or be invited again after being removed"`
 }
 
 //Validate the create game payload
@@ -288,8 +331,8 @@ func (p *CreateGamePayload) Validate() []string {
 
 //ApplyForMembershipPayload maps the payload required for the Apply for Membership route
 type ApplyForMembershipPayload struct {
-	Level          string `json:"level"`
-	PlayerPublicID string `json:"playerPublicID"`
+	Level          string `json:"level" doc:"Membership level the player applies for"`
+	PlayerPublicID string `json:"playerPublicID" doc:"Public ID of the player that applies"`
 }
 
 //Validate all the required fields
@@ -302,9 +345,9 @@ func (afmp *ApplyForMembershipPayload) Validate() []string {
 
 //InviteForMembershipPayload maps the payload required for the Invite for Membership route
 type InviteForMembershipPayload struct {
-	Level             string `json:"level"`
-	PlayerPublicID    string `json:"playerPublicID"`
-	RequestorPublicID string `json:"requestorPublicID"`
+	Level             string `json:"level" doc:"Membership level the player is invited for"`
+	PlayerPublicID    string `json:"playerPublicID" doc:"Public ID of the invited player"`
+	RequestorPublicID string `json:"requestorPublicID" doc:"Public ID of the member or owner that invites"`
 }
 
 //Validate all the required fields
@@ -318,8 +361,8 @@ func (ifmp *InviteForMembershipPayload) Validate() []string {
 
 //BasePayloadWithRequestorAndPlayerPublicIDs maps the payload required for many routes
 type BasePayloadWithRequestorAndPlayerPublicIDs struct {
-	PlayerPublicID    string `json:"playerPublicID"`
-	RequestorPublicID string `json:"requestorPublicID"`
+	PlayerPublicID    string `json:"playerPublicID" doc:"Public ID of the player the action is performed on"`
+	RequestorPublicID string `json:"requestorPublicID" doc:"Public ID of the member or owner that performs the action"`
 }
 
 //Validate all the required fields
@@ -332,7 +375,7 @@ func (base *BasePayloadWithRequestorAndPlayerPublicIDs) Validate() []string {
 
 //ApproveOrDenyMembershipInvitationPayload maps the payload required for Approving or Denying a membership
 type ApproveOrDenyMembershipInvitationPayload struct {
-	PlayerPublicID string `json:"playerPublicID"`
+	PlayerPublicID string `json:"playerPublicID" doc:"Public ID of the invited player"`
 }
 
 //Validate all the required fields
@@ -344,8 +387,8 @@ func (admip *ApproveOrDenyMembershipInvitationPayload) Validate() []string {
 
 //HookPayload maps the payload required to create or update hooks
 type HookPayload struct {
-	Type    int    `json:"type"`
-	HookURL string `json:"hookURL"`
+	Type    int    `json:"type" doc:"Event that triggers the hook"`
+	HookURL string `json:"hookURL" doc:"URL the event is posted to"`
 }
 
 //Validate all the required fields
@@ -354,3 +397,17 @@ func (hp *HookPayload) Validate() []string {
 	v.validateRequiredString("hookURL", hp.HookURL)
 	return v.Errors()
 }
+
+//APIKeyPayload maps the payload required to issue API keys
+type APIKeyPayload struct {
+	Scopes []string `json:"scopes" doc:"Scopes of the key: read-only, player-write, player-export, clan-write or game-admin"`
+}
+
+//Validate all the required fields
+func (akp *APIKeyPayload) Validate() []string {
+	v := NewValidation()
+	if len(akp.Scopes) == 0 {
+		v.errors = append(v.errors, "scopes is required")
+	}
+	return v.Errors()
+}
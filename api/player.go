@@ -8,8 +8,12 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo"
@@ -23,7 +27,6 @@ import (
 func CreatePlayerHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "CreatePlayer")
-		start := time.Now()
 		gameID := c.Param("gameID")
 
 		logger := app.Logger.With(
@@ -37,78 +40,95 @@ func CreatePlayerHandler(app *App) func(c echo.Context) error {
 			return FailWith(http.StatusBadRequest, err.Error(), c)
 		}
 
-		var transaction interfaces.Transaction
-		transaction, err := app.BeginTrans(c.StdContext(), logger)
+		player, err := createPlayer(c.StdContext(), app, logger, gameID, &payload)
 		if err != nil {
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
+			return FailWithError(err, c)
 		}
 
-		log.D(logger, "Creating player...")
-		player, err := models.CreatePlayer(
-			transaction,
-			logger,
-			app.EncryptionKey,
-			gameID,
-			payload.PublicID,
-			payload.Name,
-			payload.Metadata,
-		)
-
-		if err != nil {
-			log.E(logger, "Player creation failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-
-			txErr := app.Rollback(transaction, "Player creation failed, rolling back", c, logger, err)
-			if txErr != nil {
-				return FailWith(http.StatusInternalServerError, fmt.Sprint(err.Error(), ", rolback error: ", txErr.Error()), c)
-			}
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
-		}
-
-		err = app.Commit(transaction, "Player created successful", c, logger)
-		if err != nil {
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
-		}
-
-		result := map[string]interface{}{
+		return SucceedWithTyped(map[string]interface{}{
 			"success":  true,
 			"gameID":   gameID,
 			"publicID": player.PublicID,
 			"name":     player.Name,
 			"metadata": player.Metadata,
-		}
+		}, func() (EasyJSONMarshaler, error) {
+			return newCreatePlayerResponse(gameID, player)
+		}, c)
+	}
+}
 
-		err = app.DispatchHooks(
-			gameID,
-			models.PlayerCreatedHook,
-			player.Serialize(app.EncryptionKey),
-		)
-		if err != nil {
-			log.E(logger, "Player creation hook dispatch failed.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
-		}
+// rollbackFailed returns the error of a failed operation whose transaction could not be rolled back
+func rollbackFailed(err, txErr error) error {
+	return withStatus(http.StatusInternalServerError, errors.New(fmt.Sprint(err.Error(), ", rolback error: ", txErr.Error())))
+}
+
+// createPlayer creates the player of the payload in the game
+func createPlayer(
+	ctx context.Context, app *App, logger zap.Logger, gameID string, payload *CreatePlayerPayload,
+) (*models.Player, error) {
+	start := time.Now()
+	var transaction interfaces.Transaction
+	transaction, err := app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
 
-		log.D(logger, "Player created successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	log.D(logger, "Creating player...")
+	player, err := models.CreatePlayer(
+		transaction,
+		logger,
+		app.EncryptionKey,
+		gameID,
+		payload.PublicID,
+		payload.Name,
+		payload.Metadata,
+	)
+
+	if err != nil {
+		log.E(logger, "Player creation failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
 
-		return SucceedWith(result, c)
+		txErr := app.Rollback(transaction, "Player creation failed, rolling back", logger, err)
+		if txErr != nil {
+			return nil, rollbackFailed(err, txErr)
+		}
+		if _, ok := err.(*models.PlayerNameTakenError); ok {
+			return nil, err
+		}
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	err = app.Commit(transaction, "Player created successful", logger)
+	if err != nil {
+		return nil, withStatus(http.StatusInternalServerError, err)
 	}
+
+	err = app.DispatchHooks(
+		gameID,
+		models.PlayerCreatedHook,
+		player.Serialize(app.EncryptionKey),
+	)
+	if err != nil {
+		log.E(logger, "Player creation hook dispatch failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	log.D(logger, "Player created successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return player, nil
 }
 
 // UpdatePlayerHandler is the handler responsible for updating existing
 func UpdatePlayerHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "UpdatePlayer")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		playerPublicID := c.Param("playerPublicID")
 
-		db := app.Db(c.StdContext())
-
 		logger := app.Logger.With(
 			zap.String("source", "playerHandler"),
 			zap.String("operation", "updatePlayer"),
@@ -122,86 +142,231 @@ func UpdatePlayerHandler(app *App) func(c echo.Context) error {
 			return FailWith(http.StatusBadRequest, err.Error(), c)
 		}
 
-		var player, beforeUpdatePlayer *models.Player
-		var game *models.Game
-
-		log.D(logger, "Retrieving game...")
-		game, err = models.GetGameByPublicID(db, gameID)
-
+		expectedVersion, err := getIfMatchVersion(c)
 		if err != nil {
 			return FailWith(http.StatusBadRequest, err.Error(), c)
 		}
-		log.D(logger, "Game retrieved successfully")
 
-		log.D(logger, "Retrieving player...")
-		beforeUpdatePlayer, err = models.GetPlayerByPublicID(db, app.EncryptionKey, gameID, playerPublicID)
-		if err != nil && err.Error() != (&models.ModelNotFoundError{Type: "Player", ID: playerPublicID}).Error() {
-			return FailWith(http.StatusBadRequest, err.Error(), c)
+		player, err := updatePlayer(c.StdContext(), app, logger, gameID, playerPublicID, &payload, expectedVersion)
+		if err != nil {
+			return FailWithError(err, c)
 		}
-		log.D(logger, "Player retrieved successfully")
 
-		var transaction interfaces.Transaction
-		transaction, err = app.BeginTrans(c.StdContext(), logger)
-		if err != nil {
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
+		setETag(c, player.Version)
+		return SucceedWithTyped(map[string]interface{}{}, successResponse, c)
+	}
+}
+
+// updatePlayer updates the player with the payload, creating it if it does not exist. expectedVersion is the
+// version the player must be at, zero for any version
+func updatePlayer(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, playerPublicID string, payload *UpdatePlayerPayload, expectedVersion int64,
+) (*models.Player, error) {
+	start := time.Now()
+	db := app.Db(ctx)
+
+	var player, beforeUpdatePlayer *models.Player
+	var game *models.Game
+
+	log.D(logger, "Retrieving game...")
+	game, err := models.GetGameByPublicID(db, gameID)
+
+	if err != nil {
+		return nil, withStatus(http.StatusBadRequest, err)
+	}
+	log.D(logger, "Game retrieved successfully")
+
+	log.D(logger, "Retrieving player...")
+	beforeUpdatePlayer, err = models.GetPlayerByPublicID(db, app.EncryptionKey, gameID, playerPublicID)
+	if err != nil && err.Error() != (&models.ModelNotFoundError{Type: "Player", ID: playerPublicID}).Error() {
+		return nil, withStatus(http.StatusBadRequest, err)
+	}
+	log.D(logger, "Player retrieved successfully")
+
+	var transaction interfaces.Transaction
+	transaction, err = app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	log.D(logger, "Updating player...")
+	player, err = models.UpdatePlayer(
+		transaction,
+		logger,
+		app.EncryptionKey,
+		gameID,
+		playerPublicID,
+		payload.Name,
+		payload.Metadata,
+		expectedVersion,
+	)
+
+	if err != nil {
+		log.E(logger, "Updating player failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+
+		txErr := app.Rollback(transaction, "Player update failed, rolling back", logger, err)
+		if txErr != nil {
+			return nil, rollbackFailed(err, txErr)
 		}
+		switch err.(type) {
+		case *models.VersionMismatchError, *models.PlayerNameTakenError:
+			return nil, err
+		}
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
 
-		log.D(logger, "Updating player...")
-		player, err = models.UpdatePlayer(
-			transaction,
-			logger,
-			app.EncryptionKey,
+	err = app.Commit(transaction, "Player created successful", logger)
+	if err != nil {
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	shouldDispatch := validateUpdatePlayerDispatch(game, beforeUpdatePlayer, player, payload.Metadata, logger)
+	if shouldDispatch {
+		log.D(logger, "Dispatching player update hooks...")
+		err = app.DispatchHooks(
 			gameID,
-			playerPublicID,
-			payload.Name,
-			payload.Metadata,
+			models.PlayerUpdatedHook,
+			player.Serialize(app.EncryptionKey),
 		)
-
 		if err != nil {
-			log.E(logger, "Updating player failed.", func(cm log.CM) {
+			log.E(logger, "Update player hook dispatch failed.", func(cm log.CM) {
 				cm.Write(zap.Error(err))
 			})
+			return nil, withStatus(http.StatusInternalServerError, err)
+		}
+	}
 
-			txErr := app.Rollback(transaction, "Player update failed, rolling back", c, logger, err)
-			if txErr != nil {
-				return FailWith(http.StatusInternalServerError, fmt.Sprint(err.Error(), ", rolback error: ", txErr.Error()), c)
-			}
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
+	log.D(logger, "Player updated successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return player, nil
+}
+
+// PatchPlayerHandler is the handler responsible for applying a JSON Merge Patch to a player
+func PatchPlayerHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "PatchPlayer")
+		gameID := c.Param("gameID")
+		playerPublicID := c.Param("playerPublicID")
+
+		logger := app.Logger.With(
+			zap.String("source", "playerHandler"),
+			zap.String("operation", "patchPlayer"),
+			zap.String("gameID", gameID),
+			zap.String("playerPublicID", playerPublicID),
+		)
+
+		var payload PatchPlayerPayload
+		err := LoadJSONPayload(&payload, c, logger)
+		if err != nil {
+			return FailWith(http.StatusBadRequest, err.Error(), c)
 		}
 
-		err = app.Commit(transaction, "Player created successful", c, logger)
+		expectedVersion, err := getIfMatchVersion(c)
 		if err != nil {
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
+			return FailWith(http.StatusBadRequest, err.Error(), c)
 		}
 
-		shouldDispatch := validateUpdatePlayerDispatch(game, beforeUpdatePlayer, player, payload.Metadata, logger)
-		if shouldDispatch {
-			log.D(logger, "Dispatching player update hooks...")
-			err = app.DispatchHooks(
-				gameID,
-				models.PlayerUpdatedHook,
-				player.Serialize(app.EncryptionKey),
-			)
-			if err != nil {
-				log.E(logger, "Update player hook dispatch failed.", func(cm log.CM) {
-					cm.Write(zap.Error(err))
-				})
-				return FailWith(http.StatusInternalServerError, err.Error(), c)
-			}
+		player, err := patchPlayer(c.StdContext(), app, logger, gameID, playerPublicID, &payload, expectedVersion)
+		if err != nil {
+			return FailWithError(err, c)
 		}
 
-		log.D(logger, "Player updated successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+		setETag(c, player.Version)
+		return SucceedWithTyped(map[string]interface{}{
+			"name":     player.Name,
+			"metadata": player.Metadata,
+		}, func() (EasyJSONMarshaler, error) {
+			return newPatchPlayerResponse(player)
+		}, c)
+	}
+}
+
+// patchPlayer applies the JSON Merge Patch of the payload to the player. expectedVersion is the version the
+// player must be at, zero for any version
+func patchPlayer(
+	ctx context.Context, app *App, logger zap.Logger,
+	gameID, playerPublicID string, payload *PatchPlayerPayload, expectedVersion int64,
+) (*models.Player, error) {
+	start := time.Now()
+	db := app.Db(ctx)
+
+	log.D(logger, "Retrieving game...")
+	game, err := models.GetGameByPublicID(db, gameID)
+	if err != nil {
+		return nil, withStatus(http.StatusBadRequest, err)
+	}
+	log.D(logger, "Game retrieved successfully")
+
+	log.D(logger, "Retrieving player...")
+	beforeUpdatePlayer, err := models.GetPlayerByPublicID(db, app.EncryptionKey, gameID, playerPublicID)
+	if err != nil {
+		return nil, err
+	}
+	log.D(logger, "Player retrieved successfully")
+
+	transaction, err := app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	log.D(logger, "Patching player...")
+	player, err := models.PatchPlayer(
+		transaction,
+		logger,
+		app.EncryptionKey,
+		gameID,
+		playerPublicID,
+		payload.Name,
+		payload.Metadata,
+		expectedVersion,
+	)
+	if err != nil {
+		log.E(logger, "Patching player failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
 		})
-		return SucceedWith(map[string]interface{}{}, c)
+
+		txErr := app.Rollback(transaction, "Player patch failed, rolling back", logger, err)
+		if txErr != nil {
+			return nil, rollbackFailed(err, txErr)
+		}
+		return nil, err
+	}
+
+	err = app.Commit(transaction, "Player patched successful", logger)
+	if err != nil {
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	shouldDispatch := validateUpdatePlayerDispatch(game, beforeUpdatePlayer, player, player.Metadata, logger)
+	if shouldDispatch {
+		log.D(logger, "Dispatching player update hooks...")
+		err = app.DispatchHooks(
+			gameID,
+			models.PlayerUpdatedHook,
+			player.Serialize(app.EncryptionKey),
+		)
+		if err != nil {
+			log.E(logger, "Update player hook dispatch failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+			return nil, withStatus(http.StatusInternalServerError, err)
+		}
 	}
+
+	log.D(logger, "Player patched successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return player, nil
 }
 
 // RetrievePlayerHandler is the handler responsible for returning details for a given player
 func RetrievePlayerHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "RetrievePlayer")
-		start := time.Now()
 		gameID := c.Param("gameID")
 		publicID := c.Param("playerPublicID")
 
@@ -212,42 +377,397 @@ func RetrievePlayerHandler(app *App) func(c echo.Context) error {
 			zap.String("playerPublicID", publicID),
 		)
 
-		log.D(logger, "Getting DB connection...")
-		db, err := app.GetCtxDB(c)
+		player, err := retrievePlayer(app, logger, gameID, publicID)
 		if err != nil {
-			log.E(logger, "Failed to connect to DB.", func(cm log.CM) {
-				cm.Write(zap.Error(err))
-			})
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
+			return FailWithError(err, c)
 		}
-		log.D(logger, "DB Connection successful.")
 
-		log.D(logger, "Retrieving player details...")
-		player, err := models.GetPlayerDetails(
+		setDetailsETag(c, player)
+		return SucceedWithTyped(player, func() (EasyJSONMarshaler, error) {
+			return newPlayerResponse(player)
+		}, c)
+	}
+}
+
+// retrievePlayer returns the details of the player
+func retrievePlayer(app *App, logger zap.Logger, gameID, publicID string) (map[string]interface{}, error) {
+	start := time.Now()
+	// the payloads are shared with other requests and may be loaded again in background after this
+	// request ends, so they are not loaded with the connection of the request
+	db := app.Db(context.Background())
+
+	log.D(logger, "Retrieving player details...")
+	player, err := app.playerDetailsCache.Get(gameID, publicID, func() (map[string]interface{}, error) {
+		return models.GetPlayerDetails(
 			db,
 			app.EncryptionKey,
 			gameID,
 			publicID,
 		)
+	})
+
+	if err != nil {
+		if err.Error() == fmt.Sprintf("Player was not found with id: %s", publicID) {
+			log.D(logger, "Player was not found.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+			return nil, withStatus(http.StatusNotFound, err)
+		}
+
+		log.E(logger, "Retrieve player details failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	log.D(logger, "Player details retrieved successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return player, nil
+}
 
+// ExportPlayerHandler is the handler responsible for exporting all the data tied to a given player
+func ExportPlayerHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "ExportPlayer")
+		gameID := c.Param("gameID")
+		publicID := c.Param("playerPublicID")
+
+		logger := app.Logger.With(
+			zap.String("source", "playerHandler"),
+			zap.String("operation", "exportPlayer"),
+			zap.String("gameID", gameID),
+			zap.String("playerPublicID", publicID),
+		)
+
+		data, err := exportPlayer(c.StdContext(), app, logger, gameID, publicID)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+
+		return SucceedWithTyped(data, func() (EasyJSONMarshaler, error) {
+			return newExportPlayerResponse(data)
+		}, c)
+	}
+}
+
+// exportPlayer returns all the data tied to the player
+func exportPlayer(ctx context.Context, app *App, logger zap.Logger, gameID, publicID string) (map[string]interface{}, error) {
+	start := time.Now()
+	log.D(logger, "Exporting player data...")
+	data, err := models.ExportPlayerData(app.Db(ctx), app.EncryptionKey, gameID, publicID)
+	if err != nil {
+		if _, ok := err.(*models.ModelNotFoundError); ok {
+			log.D(logger, "Player was not found.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+			return nil, err
+		}
+
+		log.E(logger, "Export player data failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	log.I(logger, "Player data exported successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return data, nil
+}
+
+// RetrievePlayerNameHistoryHandler is the handler responsible for returning the previous names of a given player
+func RetrievePlayerNameHistoryHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "RetrievePlayerNameHistory")
+		gameID := c.Param("gameID")
+		publicID := c.Param("playerPublicID")
+
+		logger := app.Logger.With(
+			zap.String("source", "playerHandler"),
+			zap.String("operation", "retrievePlayerNameHistory"),
+			zap.String("gameID", gameID),
+			zap.String("playerPublicID", publicID),
+		)
+
+		history, err := retrievePlayerNameHistory(c.StdContext(), app, logger, gameID, publicID)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+
+		return SucceedWithTyped(map[string]interface{}{
+			"nameHistory": history,
+		}, func() (EasyJSONMarshaler, error) {
+			return newPlayerNameHistoryResponse(history)
+		}, c)
+	}
+}
+
+// retrievePlayerNameHistory returns the previous names of the player
+func retrievePlayerNameHistory(
+	ctx context.Context, app *App, logger zap.Logger, gameID, publicID string,
+) ([]map[string]interface{}, error) {
+	start := time.Now()
+	log.D(logger, "Retrieving player name history...")
+	history, err := models.GetPlayerNameHistory(app.Db(ctx), app.EncryptionKey, gameID, publicID)
+	if err != nil {
+		if _, ok := err.(*models.ModelNotFoundError); ok {
+			log.D(logger, "Player was not found.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+			return nil, err
+		}
+
+		log.E(logger, "Retrieve player name history failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	log.D(logger, "Player name history retrieved successfully.", func(cm log.CM) {
+		cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+	})
+	return history, nil
+}
+
+// DeletePlayerHandler is the handler responsible for deleting the personal data of a given player
+func DeletePlayerHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "DeletePlayer")
+		gameID := c.Param("gameID")
+		publicID := c.Param("playerPublicID")
+
+		logger := app.Logger.With(
+			zap.String("source", "playerHandler"),
+			zap.String("operation", "deletePlayer"),
+			zap.String("gameID", gameID),
+			zap.String("playerPublicID", publicID),
+		)
+
+		clansJSON, err := deletePlayer(c.StdContext(), app, logger, gameID, publicID)
 		if err != nil {
-			if err.Error() == fmt.Sprintf("Player was not found with id: %s", publicID) {
-				log.D(logger, "Player was not found.", func(cm log.CM) {
+			return FailWithError(err, c)
+		}
+
+		return SucceedWithTyped(map[string]interface{}{
+			"clans": clansJSON,
+		}, func() (EasyJSONMarshaler, error) {
+			return newDeletePlayerResponse(clansJSON)
+		}, c)
+	}
+}
+
+// deletePlayer deletes the personal data of the player and returns the clans it owned
+func deletePlayer(
+	ctx context.Context, app *App, logger zap.Logger, gameID, publicID string,
+) ([]map[string]interface{}, error) {
+	start := time.Now()
+	var tx interfaces.Transaction
+	var err error
+
+	rollback := func(err error) error {
+		return app.Rollback(tx, "Deleting player failed", logger, err)
+	}
+
+	tx, err = app.BeginTrans(ctx, logger)
+	if err != nil {
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+	log.D(logger, "DB Tx begun successful.")
+
+	log.D(logger, "Deleting player...")
+	player, clans, err := models.DeletePlayer(tx, app.EncryptionKey, gameID, publicID)
+	if err != nil {
+		txErr := rollback(err)
+		if txErr == nil {
+			if _, ok := err.(*models.ModelNotFoundError); ok {
+				log.W(logger, "Player was not found.", func(cm log.CM) {
+					cm.Write(zap.Error(err))
+				})
+				return nil, err
+			}
+			log.E(logger, "Player deletion failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+		}
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	clansJSON := make([]map[string]interface{}, len(clans))
+	for i, deletedClan := range clans {
+		err = dispatchClanOwnershipChangeHook(app, models.ClanLeftHook, deletedClan.Clan, player, deletedClan.NewOwner)
+		if err != nil {
+			txErr := rollback(err)
+			if txErr == nil {
+				log.E(logger, "Leaving clan hook dispatch failed.", func(cm log.CM) {
 					cm.Write(zap.Error(err))
 				})
-				return FailWith(http.StatusNotFound, err.Error(), c)
 			}
+			return nil, withStatus(http.StatusInternalServerError, err)
+		}
+
+		clanJSON := map[string]interface{}{
+			"publicID":  deletedClan.Clan.PublicID,
+			"newOwner":  nil,
+			"isDeleted": true,
+		}
+		if deletedClan.NewOwner != nil {
+			clanJSON["newOwner"] = deletedClan.NewOwner.PublicID
+			clanJSON["isDeleted"] = false
+		}
+		clansJSON[i] = clanJSON
+	}
 
-			log.E(logger, "Retrieve player details failed.", func(cm log.CM) {
+	err = app.DispatchHooks(gameID, models.PlayerDeletedHook, map[string]interface{}{
+		"gameID":   gameID,
+		"publicID": publicID,
+		"clans":    clansJSON,
+	})
+	if err != nil {
+		txErr := rollback(err)
+		if txErr == nil {
+			log.E(logger, "Player deleted hook dispatch failed.", func(cm log.CM) {
 				cm.Write(zap.Error(err))
 			})
-			return FailWith(http.StatusInternalServerError, err.Error(), c)
 		}
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	err = app.Commit(tx, "Player deleted", logger)
+	if err != nil {
+		return nil, withStatus(http.StatusInternalServerError, err)
+	}
+
+	log.I(logger, "Player deleted successfully.", func(cm log.CM) {
+		cm.Write(
+			zap.Int("ownedClans", len(clans)),
+			zap.Duration("duration", time.Now().Sub(start)),
+		)
+	})
+	return clansJSON, nil
+}
+
+// UpsertPlayersHandler is the handler responsible for creating or updating players in bulk
+func UpsertPlayersHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "UpsertPlayers")
+		gameID := c.Param("gameID")
 
-		log.D(logger, "Player details retrieved successfully.", func(cm log.CM) {
-			cm.Write(zap.Duration("duration", time.Now().Sub(start)))
+		logger := app.Logger.With(
+			zap.String("source", "playerHandler"),
+			zap.String("operation", "upsertPlayers"),
+			zap.String("gameID", gameID),
+		)
+
+		var payload UpsertPlayersPayload
+		err := LoadJSONPayload(&payload, c, logger)
+		if err != nil {
+			return FailWith(http.StatusBadRequest, err.Error(), c)
+		}
+
+		result, err := upsertPlayers(c.StdContext(), app, logger, gameID, &payload)
+		if err != nil {
+			return FailWithError(err, c)
+		}
+
+		return SucceedWithTyped(result, func() (EasyJSONMarshaler, error) {
+			return newUpsertPlayersResponse(result)
+		}, c)
+	}
+}
+
+// upsertPlayers creates or updates the players of the payload and returns how many were created and updated,
+// along with the players that failed
+func upsertPlayers(
+	ctx context.Context, app *App, logger zap.Logger, gameID string, payload *UpsertPlayersPayload,
+) (map[string]interface{}, error) {
+	start := time.Now()
+	maxPlayers := app.Config.GetInt("players.bulk.maxPlayers")
+	if len(payload.Players) > maxPlayers {
+		msg := fmt.Sprintf("At most %d players can be upserted at once", maxPlayers)
+		return nil, withStatus(http.StatusBadRequest, errors.New(msg))
+	}
+
+	// players passed to the model, by their index in the payload
+	players := make([]*models.PlayerImport, 0, len(payload.Players))
+	indexes := make([]int, 0, len(payload.Players))
+	errors := []map[string]interface{}{}
+	for i, player := range payload.Players {
+		if player == nil {
+			player = &CreatePlayerPayload{}
+		}
+		if missingFieldErrors := player.Validate(); len(missingFieldErrors) > 0 {
+			errors = append(errors, map[string]interface{}{
+				"index":    i,
+				"publicID": player.PublicID,
+				"reason":   strings.Join(missingFieldErrors, ", "),
+			})
+			continue
+		}
+		players = append(players, &models.PlayerImport{
+			PublicID: player.PublicID,
+			Name:     player.Name,
+			Metadata: player.Metadata,
 		})
+		indexes = append(indexes, i)
+	}
 
-		return SucceedWith(player, c)
+	log.D(logger, "Upserting players...")
+	result, err := models.UpsertPlayers(
+		app.Db(ctx),
+		logger,
+		app.EncryptionKey,
+		gameID,
+		players,
+		app.Config.GetInt("players.bulk.batchSize"),
+	)
+	if err != nil {
+		if _, ok := err.(*models.ModelNotFoundError); ok {
+			return nil, err
+		}
+		log.E(logger, "Upserting players failed.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, withStatus(http.StatusInternalServerError, err)
 	}
+
+	log.D(logger, "Dispatching player hooks...")
+	for _, imported := range result.Players {
+		eventType := models.PlayerUpdatedHook
+		if imported.Created {
+			eventType = models.PlayerCreatedHook
+		}
+		err = app.DispatchHooks(gameID, eventType, imported.Player.Serialize(app.EncryptionKey))
+		if err != nil {
+			log.E(logger, "Upsert players hook dispatch failed.", func(cm log.CM) {
+				cm.Write(zap.Error(err))
+			})
+			return nil, withStatus(http.StatusInternalServerError, err)
+		}
+	}
+
+	for _, importErr := range result.Errors {
+		errors = append(errors, map[string]interface{}{
+			"index":    indexes[importErr.Index],
+			"publicID": importErr.PublicID,
+			"reason":   importErr.Reason,
+		})
+	}
+	sort.Slice(errors, func(i, j int) bool {
+		return errors[i]["index"].(int) < errors[j]["index"].(int)
+	})
+
+	log.I(logger, "Players upserted.", func(cm log.CM) {
+		cm.Write(
+			zap.Int("created", result.Created),
+			zap.Int("updated", result.Updated),
+			zap.Int("failed", len(errors)),
+			zap.Duration("duration", time.Now().Sub(start)),
+		)
+	})
+	return map[string]interface{}{
+		"created": result.Created,
+		"updated": result.Updated,
+		"errors":  errors,
+	}, nil
 }
@@ -69,3 +69,254 @@ func validateUpdatePlayerDispatch(game *models.Game, sourcePlayer *models.Player
 
 	return false
 }
+
+// newPlayerProfile returns the typed player of a serialized clan participant
+func newPlayerProfile(r *payloadReader, player map[string]interface{}) *PlayerProfile {
+	if player == nil {
+		return nil
+	}
+	return &PlayerProfile{
+		PublicID: r.str(player, "publicID"),
+		Name:     r.str(player, "name"),
+		Metadata: r.object(player, "metadata"),
+	}
+}
+
+// newPlayerReference returns the typed player of a serialized clan actor
+func newPlayerReference(r *payloadReader, player map[string]interface{}) *PlayerReference {
+	if player == nil {
+		return nil
+	}
+	return &PlayerReference{
+		PublicID: r.str(player, "publicID"),
+		Name:     r.str(player, "name"),
+	}
+}
+
+// newPlayerSummary returns the typed player of a serialized player
+func newPlayerSummary(r *payloadReader, player map[string]interface{}) *PlayerSummary {
+	if player == nil {
+		return nil
+	}
+	return &PlayerSummary{
+		PublicID:        r.str(player, "publicID"),
+		Name:            r.str(player, "name"),
+		Metadata:        r.object(player, "metadata"),
+		MembershipCount: int(r.integer(player, "membershipCount")),
+		OwnershipCount:  int(r.integer(player, "ownershipCount")),
+	}
+}
+
+// newPlayerNameChanges returns the typed previous names of a player
+func newPlayerNameChanges(r *payloadReader, history []map[string]interface{}) []*PlayerNameChange {
+	changes := make([]*PlayerNameChange, len(history))
+	for i, change := range history {
+		if change != nil {
+			changes[i] = &PlayerNameChange{
+				Name:      r.str(change, "name"),
+				ChangedAt: r.integer(change, "changedAt"),
+			}
+		}
+	}
+	return changes
+}
+
+// newCreatePlayerResponse returns the v2 response of the create player route
+func newCreatePlayerResponse(gameID string, player *models.Player) (*CreatePlayerResponse, error) {
+	return &CreatePlayerResponse{
+		Success:  true,
+		GameID:   gameID,
+		PublicID: player.PublicID,
+		Name:     player.Name,
+		Metadata: player.Metadata,
+	}, nil
+}
+
+// newUpsertPlayersResponse returns the v2 response of the upsert players route
+func newUpsertPlayersResponse(result map[string]interface{}) (*UpsertPlayersResponse, error) {
+	r := &payloadReader{}
+	response := &UpsertPlayersResponse{
+		Success: true,
+		Created: int(r.integer(result, "created")),
+		Updated: int(r.integer(result, "updated")),
+	}
+	for _, upsertErr := range r.objects(result, "errors") {
+		response.Errors = append(response.Errors, &UpsertPlayerError{
+			Index:    int(r.integer(upsertErr, "index")),
+			PublicID: r.str(upsertErr, "publicID"),
+			Reason:   r.str(upsertErr, "reason"),
+		})
+	}
+	return response, r.err
+}
+
+// newPatchPlayerResponse returns the v2 response of the patch player route
+func newPatchPlayerResponse(player *models.Player) (*PatchPlayerResponse, error) {
+	return &PatchPlayerResponse{
+		Success:  true,
+		Name:     player.Name,
+		Metadata: player.Metadata,
+	}, nil
+}
+
+// newPlayerMembership returns the typed membership of the details of a player
+func newPlayerMembership(r *payloadReader, membership map[string]interface{}) *PlayerMembership {
+	if membership == nil {
+		return nil
+	}
+	typed := &PlayerMembership{
+		Level:      r.str(membership, "level"),
+		Approved:   r.boolean(membership, "approved"),
+		Denied:     r.boolean(membership, "denied"),
+		Banned:     r.boolean(membership, "banned"),
+		Message:    r.str(membership, "message"),
+		CreatedAt:  r.integer(membership, "createdAt"),
+		UpdatedAt:  r.integer(membership, "updatedAt"),
+		DeletedAt:  r.integer(membership, "deletedAt"),
+		ApprovedAt: r.integer(membership, "approvedAt"),
+		DeniedAt:   r.integer(membership, "deniedAt"),
+		Approver:   newPlayerProfile(r, r.object(membership, "approver")),
+		Denier:     newPlayerProfile(r, r.object(membership, "denier")),
+		DeletedBy:  newPlayerReference(r, r.object(membership, "deletedBy")),
+	}
+	if clan := r.object(membership, "clan"); clan != nil {
+		typed.Clan = &MembershipClan{
+			PublicID:        r.str(clan, "publicID"),
+			Name:            r.str(clan, "name"),
+			Metadata:        r.object(clan, "metadata"),
+			MembershipCount: int(r.integer(clan, "membershipCount")),
+		}
+	}
+	if requestor := r.object(membership, "requestor"); requestor != nil {
+		typed.Requestor = &MembershipRequestor{
+			PublicID: r.str(requestor, "publicID"),
+			Name:     r.str(requestor, "name"),
+			Metadata: r.object(requestor, "metadata"),
+			Level:    r.str(requestor, "level"),
+		}
+	}
+	return typed
+}
+
+// newPlayerResponse returns the v2 response of the retrieve player route
+func newPlayerResponse(details map[string]interface{}) (*PlayerResponse, error) {
+	r := &payloadReader{}
+	response := &PlayerResponse{
+		Success:   true,
+		PublicID:  r.str(details, "publicID"),
+		Name:      r.str(details, "name"),
+		Metadata:  r.object(details, "metadata"),
+		CreatedAt: r.integer(details, "createdAt"),
+		UpdatedAt: r.integer(details, "updatedAt"),
+		Version:   r.integer(details, "version"),
+	}
+	if clans := r.object(details, "clans"); clans != nil {
+		response.Clans = &PlayerClans{
+			Owned:               newClanReferences(r, r.objects(clans, "owned")),
+			Approved:            newClanReferences(r, r.objects(clans, "approved")),
+			Banned:              newClanReferences(r, r.objects(clans, "banned")),
+			Denied:              newClanReferences(r, r.objects(clans, "denied")),
+			PendingApplications: newClanReferences(r, r.objects(clans, "pendingApplications")),
+			PendingInvites:      newClanReferences(r, r.objects(clans, "pendingInvites")),
+		}
+	}
+	for _, membership := range r.objects(details, "memberships") {
+		response.Memberships = append(response.Memberships, newPlayerMembership(r, membership))
+	}
+	return response, r.err
+}
+
+// newExportedMemberships returns the typed memberships of the export of the data of a player
+func newExportedMemberships(r *payloadReader, memberships []map[string]interface{}) []*ExportedMembership {
+	typed := make([]*ExportedMembership, len(memberships))
+	for i, membership := range memberships {
+		if membership == nil {
+			continue
+		}
+		typed[i] = &ExportedMembership{
+			Clan:       newClanReference(r, r.object(membership, "clan")),
+			Level:      r.str(membership, "level"),
+			Approved:   r.boolean(membership, "approved"),
+			Denied:     r.boolean(membership, "denied"),
+			Banned:     r.boolean(membership, "banned"),
+			Message:    r.str(membership, "message"),
+			CreatedAt:  r.integer(membership, "createdAt"),
+			UpdatedAt:  r.integer(membership, "updatedAt"),
+			ApprovedAt: r.integer(membership, "approvedAt"),
+			DeniedAt:   r.integer(membership, "deniedAt"),
+			DeletedAt:  r.integer(membership, "deletedAt"),
+			Player:     r.str(membership, "player"),
+			Requestor:  r.str(membership, "requestor"),
+			Approver:   r.str(membership, "approver"),
+			Denier:     r.str(membership, "denier"),
+			DeletedBy:  r.str(membership, "deletedBy"),
+		}
+	}
+	return typed
+}
+
+// newExportPlayerResponse returns the v2 response of the export player route
+func newExportPlayerResponse(data map[string]interface{}) (*ExportPlayerResponse, error) {
+	r := &payloadReader{}
+	response := &ExportPlayerResponse{
+		Success:            true,
+		NameHistory:        newPlayerNameChanges(r, r.objects(data, "nameHistory")),
+		Memberships:        newExportedMemberships(r, r.objects(data, "memberships")),
+		MembershipsActions: newExportedMemberships(r, r.objects(data, "membershipsActions")),
+	}
+	if player := r.object(data, "player"); player != nil {
+		response.Player = &ExportedPlayer{
+			GameID:          r.str(player, "gameID"),
+			PublicID:        r.str(player, "publicID"),
+			Name:            r.str(player, "name"),
+			Metadata:        r.object(player, "metadata"),
+			MembershipCount: int(r.integer(player, "membershipCount")),
+			OwnershipCount:  int(r.integer(player, "ownershipCount")),
+			CreatedAt:       r.integer(player, "createdAt"),
+			UpdatedAt:       r.integer(player, "updatedAt"),
+		}
+	}
+	for _, clan := range r.objects(data, "ownedClans") {
+		var typed *ExportedClan
+		if clan != nil {
+			typed = &ExportedClan{
+				GameID:           r.str(clan, "gameID"),
+				PublicID:         r.str(clan, "publicID"),
+				Name:             r.str(clan, "name"),
+				Metadata:         r.object(clan, "metadata"),
+				AllowApplication: r.boolean(clan, "allowApplication"),
+				AutoJoin:         r.boolean(clan, "autoJoin"),
+				MembershipCount:  int(r.integer(clan, "membershipCount")),
+			}
+		}
+		response.OwnedClans = append(response.OwnedClans, typed)
+	}
+	return response, r.err
+}
+
+// newPlayerNameHistoryResponse returns the v2 response of the player name history route
+func newPlayerNameHistoryResponse(history []map[string]interface{}) (*PlayerNameHistoryResponse, error) {
+	r := &payloadReader{}
+	return &PlayerNameHistoryResponse{
+		Success:     true,
+		NameHistory: newPlayerNameChanges(r, history),
+	}, r.err
+}
+
+// newDeletePlayerResponse returns the v2 response of the delete player route
+func newDeletePlayerResponse(clans []map[string]interface{}) (*DeletePlayerResponse, error) {
+	r := &payloadReader{}
+	response := &DeletePlayerResponse{Success: true, Clans: make([]*DeletedClan, len(clans))}
+	for i, clan := range clans {
+		deleted := &DeletedClan{
+			PublicID:  r.str(clan, "publicID"),
+			IsDeleted: r.boolean(clan, "isDeleted"),
+		}
+		if clan["newOwner"] != nil {
+			newOwner := r.str(clan, "newOwner")
+			deleted.NewOwner = &newOwner
+		}
+		response.Clans[i] = deleted
+	}
+	return response, r.err
+}
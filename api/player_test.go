@@ -109,6 +109,70 @@ var _ = Describe("Player API Handler", func() {
 		})
 	})
 
+	Describe("Upsert Players Handler", func() {
+		It("Should create and update players", func() {
+			_, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := map[string]interface{}{
+				"players": []map[string]interface{}{
+					{"publicID": player.PublicID, "name": "updated-name", "metadata": map[string]interface{}{"x": "b"}},
+					{"publicID": "new-player", "name": "new-name", "metadata": map[string]interface{}{"x": "a"}},
+					{"publicID": "invalid-player", "metadata": map[string]interface{}{}},
+					{"publicID": "new-player", "name": "repeated-name", "metadata": map[string]interface{}{}},
+				},
+			}
+			status, body := PutJSON(a, GetGameRoute(player.GameID, "/players"), payload)
+
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["created"]).To(BeEquivalentTo(1))
+			Expect(result["updated"]).To(BeEquivalentTo(1))
+
+			errors := result["errors"].([]interface{})
+			Expect(errors).To(HaveLen(2))
+			Expect(errors[0].(map[string]interface{})["index"]).To(BeEquivalentTo(2))
+			Expect(errors[0].(map[string]interface{})["reason"]).To(Equal("name is required"))
+			Expect(errors[1].(map[string]interface{})["index"]).To(BeEquivalentTo(3))
+
+			dbPlayer, err := models.GetPlayerByPublicID(db, a.EncryptionKey, player.GameID, player.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbPlayer.Name).To(Equal("updated-name"))
+			Expect(dbPlayer.Metadata["x"]).To(Equal("b"))
+
+			dbPlayer, err = models.GetPlayerByPublicID(db, a.EncryptionKey, player.GameID, "new-player")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbPlayer.Name).To(Equal("new-name"))
+		})
+
+		It("Should not upsert players without players", func() {
+			game := fixtures.GameFactory.MustCreate().(*models.Game)
+			err := db.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			status, body := PutJSON(a, GetGameRoute(game.PublicID, "/players"), map[string]interface{}{})
+
+			Expect(status).To(Equal(http.StatusBadRequest))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal("players is required"))
+		})
+
+		It("Should return 404 for invalid game", func() {
+			payload := map[string]interface{}{
+				"players": []map[string]interface{}{
+					{"publicID": "player", "name": "name", "metadata": map[string]interface{}{}},
+				},
+			}
+			status, _ := PutJSON(a, GetGameRoute("invalid-game", "/players"), payload)
+
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+	})
+
 	Describe("Update Player Handler", func() {
 		It("Should update player", func() {
 			_, player, err := fixtures.CreatePlayerFactory(db, "")
@@ -135,6 +199,82 @@ var _ = Describe("Player API Handler", func() {
 			Expect(dbPlayer.Metadata["y"]).To(BeEquivalentTo(metadata["y"]))
 		})
 
+		It("Should update player if If-Match header matches its version", func() {
+			_, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s", player.PublicID))
+			status, _, etag := GetWithETag(a, route)
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(etag).To(Equal(`"1"`))
+
+			payload := map[string]interface{}{
+				"name":     player.Name,
+				"metadata": map[string]interface{}{"y": 10},
+			}
+			status, body, etag := PutJSONIfMatch(a, route, payload, `"1"`)
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			Expect(etag).To(Equal(`"2"`))
+		})
+
+		It("Should not update player if If-Match header does not match its version", func() {
+			_, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := map[string]interface{}{
+				"name":     "other name",
+				"metadata": map[string]interface{}{"y": 10},
+			}
+			route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s", player.PublicID))
+			status, body, _ := PutJSONIfMatch(a, route, payload, `"5"`)
+			Expect(status).To(Equal(http.StatusPreconditionFailed))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal(fmt.Sprintf("Player %s is not at version 5", player.PublicID)))
+
+			dbPlayer, err := models.GetPlayerByPublicID(db, a.EncryptionKey, player.GameID, player.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbPlayer.Name).To(Equal(player.Name))
+		})
+
+		It("Should return 400 if If-Match header is not a valid version", func() {
+			_, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := map[string]interface{}{
+				"name":     "other name",
+				"metadata": map[string]interface{}{"y": 10},
+			}
+			route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s", player.PublicID))
+			status, _, _ := PutJSONIfMatch(a, route, payload, `"abc"`)
+			Expect(status).To(Equal(http.StatusBadRequest))
+		})
+
+		It("Should not update player if name is taken in a game with unique names", func() {
+			game, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.Exec("UPDATE games SET unique_player_names=true WHERE public_id=$1", game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+
+			status, _ := PostJSON(a, GetGameRoute(game.PublicID, "/players"), map[string]interface{}{
+				"publicID": uuid.NewV4().String(),
+				"name":     "Taken Name",
+				"metadata": map[string]interface{}{},
+			})
+			Expect(status).To(Equal(http.StatusOK))
+
+			route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s", player.PublicID))
+			status, body := PutJSON(a, route, map[string]interface{}{"name": "taken name", "metadata": player.Metadata})
+			Expect(status).To(Equal(http.StatusConflict))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+		})
+
 		It("Should not update player if missing parameters", func() {
 			route := GetGameRoute("game-id", "/players/player-id")
 			status, body := PutJSON(a, route, map[string]interface{}{})
@@ -177,6 +317,78 @@ var _ = Describe("Player API Handler", func() {
 		})
 	})
 
+	Describe("Patch Player Handler", func() {
+		It("Should patch player metadata", func() {
+			_, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.Exec("UPDATE players SET metadata=$1 WHERE id=$2", `{"x": "a", "y": "b"}`, player.ID)
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := map[string]interface{}{
+				"metadata": map[string]interface{}{"x": nil, "z": "c"},
+			}
+			route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s", player.PublicID))
+			status, body := PatchJSON(a, route, payload)
+
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			Expect(result["metadata"]).To(Equal(map[string]interface{}{"y": "b", "z": "c"}))
+
+			dbPlayer, err := models.GetPlayerByPublicID(db, a.EncryptionKey, player.GameID, player.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbPlayer.Name).To(Equal(player.Name))
+			Expect(dbPlayer.Metadata).To(Equal(map[string]interface{}{"y": "b", "z": "c"}))
+		})
+
+		It("Should return 404 for invalid player", func() {
+			_, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(player.GameID, "/players/invalid-player")
+			status, _ := PatchJSON(a, route, map[string]interface{}{"name": "name"})
+
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+
+		It("Should patch player if If-Match header matches its version", func() {
+			_, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s", player.PublicID))
+			status, _, etag := PatchJSONIfMatch(a, route, map[string]interface{}{"name": "new name"}, `"1"`)
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(etag).To(Equal(`"2"`))
+		})
+
+		It("Should not patch player if If-Match header does not match its version", func() {
+			_, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s", player.PublicID))
+			status, body, _ := PatchJSONIfMatch(a, route, map[string]interface{}{"name": "new name"}, `"5"`)
+			Expect(status).To(Equal(http.StatusPreconditionFailed))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeFalse())
+			Expect(result["reason"]).To(Equal(fmt.Sprintf("Player %s is not at version 5", player.PublicID)))
+
+			dbPlayer, err := models.GetPlayerByPublicID(db, a.EncryptionKey, player.GameID, player.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbPlayer.Name).To(Equal(player.Name))
+		})
+
+		It("Should return 400 if If-Match header is not a valid version", func() {
+			_, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s", player.PublicID))
+			status, _, _ := PatchJSONIfMatch(a, route, map[string]interface{}{"name": "new name"}, "W/")
+			Expect(status).To(Equal(http.StatusBadRequest))
+		})
+	})
+
 	Describe("Retrieve Player", func() {
 		It("Should retrieve player", func() {
 			gameID := uuid.NewV4().String()
@@ -272,6 +484,91 @@ var _ = Describe("Player API Handler", func() {
 		})
 	})
 
+	Describe("Export Player", func() {
+		It("Should export player data", func() {
+			gameID := uuid.NewV4().String()
+			_, player, err := fixtures.GetTestPlayerWithMemberships(testDb, gameID, 5, 2, 3, 8)
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s/export", player.PublicID))
+			status, body := Get(a, route)
+
+			Expect(status).To(Equal(http.StatusOK))
+			var data map[string]interface{}
+			json.Unmarshal([]byte(body), &data)
+			Expect(data["success"]).To(BeTrue())
+			Expect(data["player"].(map[string]interface{})["publicID"]).To(Equal(player.PublicID))
+			Expect(len(data["memberships"].([]interface{}))).To(Equal(18))
+		})
+
+		It("Should return 404 for invalid player", func() {
+			route := GetGameRoute("some-game", "/players/invalid-player/export")
+			status, _ := Get(a, route)
+
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("Retrieve Player Name History", func() {
+		It("Should retrieve the previous names of the player", func() {
+			_, player, err := fixtures.CreatePlayerFactory(db, "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.Exec("UPDATE games SET player_name_history=true WHERE public_id=$1", player.GameID)
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s", player.PublicID))
+			status, _ := PutJSON(a, route, map[string]interface{}{"name": "New Name", "metadata": player.Metadata})
+			Expect(status).To(Equal(http.StatusOK))
+
+			status, body := Get(a, route+"/name-history")
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			history := result["nameHistory"].([]interface{})
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].(map[string]interface{})["name"]).To(Equal(player.Name))
+		})
+
+		It("Should return 404 for invalid player", func() {
+			route := GetGameRoute("some-game", "/players/invalid-player/name-history")
+			status, _ := Get(a, route)
+
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("Delete Player", func() {
+		It("Should delete player", func() {
+			_, clan, owner, players, _, err := fixtures.GetClanWithMemberships(testDb, 1, 0, 0, 0, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			route := GetGameRoute(owner.GameID, fmt.Sprintf("/players/%s", owner.PublicID))
+			status, body := Delete(a, route)
+
+			Expect(status).To(Equal(http.StatusOK))
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+			Expect(result["success"]).To(BeTrue())
+			clans := result["clans"].([]interface{})
+			Expect(clans).To(HaveLen(1))
+			deletedClan := clans[0].(map[string]interface{})
+			Expect(deletedClan["publicID"]).To(Equal(clan.PublicID))
+			Expect(deletedClan["newOwner"]).To(Equal(players[0].PublicID))
+			Expect(deletedClan["isDeleted"]).To(BeFalse())
+
+			status, _ = Get(a, route)
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+
+		It("Should return 404 for invalid player", func() {
+			route := GetGameRoute("some-game", "/players/invalid-player")
+			status, _ := Delete(a, route)
+
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+	})
+
 	Describe("Player Hooks", func() {
 		It("Should call create player hook", func() {
 			hooks, err := fixtures.GetHooksForRoutes(testDb, []string{
@@ -311,6 +608,44 @@ var _ = Describe("Player API Handler", func() {
 			}
 		})
 
+		It("Should call create and update player hooks for upserted players", func() {
+			hooks, err := fixtures.GetHooksForRoutes(testDb, []string{
+				"http://localhost:52525/playercreated",
+			}, models.PlayerCreatedHook)
+			Expect(err).NotTo(HaveOccurred())
+			gameID := hooks[0].GameID
+			hook := fixtures.HookFactory.MustCreateWithOption(map[string]interface{}{
+				"GameID":    gameID,
+				"PublicID":  uuid.NewV4().String(),
+				"EventType": models.PlayerUpdatedHook,
+				"URL":       "http://localhost:52525/playerupdated",
+			}).(*models.Hook)
+			err = testDb.Insert(hook)
+			Expect(err).NotTo(HaveOccurred())
+			_, player, err := fixtures.CreatePlayerFactory(testDb, gameID, true)
+			Expect(err).NotTo(HaveOccurred())
+			responses := startRouteHandler([]string{"/playercreated", "/playerupdated"}, 52525)
+
+			payload := map[string]interface{}{
+				"players": []map[string]interface{}{
+					{"publicID": player.PublicID, "name": "updated-name", "metadata": map[string]interface{}{}},
+					{"publicID": "new-player", "name": "new-name", "metadata": map[string]interface{}{"x": "a"}},
+				},
+			}
+			status, _ := PutJSON(a, GetGameRoute(gameID, "/players"), payload)
+			Expect(status).To(Equal(http.StatusOK))
+
+			Eventually(func() int {
+				return len(*responses)
+			}).Should(Equal(2))
+
+			names := []interface{}{}
+			for _, response := range *responses {
+				names = append(names, response["payload"].(map[string]interface{})["name"])
+			}
+			Expect(names).To(ConsistOf("updated-name", "new-name"))
+		})
+
 		Describe("Update Player Hook", func() {
 			Describe("Without Whitelist", func() {
 				It("Should not call update player hook", func() {
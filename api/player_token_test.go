@@ -0,0 +1,146 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api_test
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/khan/api"
+	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+var _ = Describe("Player Token Middleware", func() {
+	var db models.DB
+	var a *api.App
+	var key *rsa.PrivateKey
+	var game *models.Game
+	var clan *models.Clan
+	var owner *models.Player
+	var players []*models.Player
+
+	BeforeEach(func() {
+		a = GetTestAppWithPlayerTokens()
+		db = a.Db(nil)
+
+		var err error
+		var publicKey string
+		key, publicKey, err = fixtures.GetPlayerTokenKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		game, clan, owner, players, _, err = fixtures.GetClanWithMemberships(db, 0, 0, 0, 0, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = db.Exec("UPDATE games SET player_token_public_key=$1 WHERE public_id=$2", publicKey, game.PublicID)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	tokenHeader := func(playerPublicID string, ttl time.Duration) http.Header {
+		token, err := fixtures.SignPlayerToken(key, game.PublicID, playerPublicID, ttl)
+		Expect(err).NotTo(HaveOccurred())
+		return http.Header{api.PlayerTokenHeader: []string{token}}
+	}
+
+	updatePlayerBody := func(player *models.Player) string {
+		body, _ := json.Marshal(map[string]interface{}{
+			"name":     player.Name,
+			"metadata": map[string]interface{}{"x": 1},
+		})
+		return string(body)
+	}
+
+	It("Should allow a player to update itself", func() {
+		route := GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", owner.PublicID))
+		status, body, _ := doRequestWithHeader(a, "PUT", route, updatePlayerBody(owner), tokenHeader(owner.PublicID, time.Minute))
+		Expect(status).To(Equal(http.StatusOK), body)
+	})
+
+	It("Should not allow a player to update another player", func() {
+		route := GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", owner.PublicID))
+		status, _, _ := doRequestWithHeader(a, "PUT", route, updatePlayerBody(owner), tokenHeader(players[0].PublicID, time.Minute))
+		Expect(status).To(Equal(http.StatusForbidden))
+	})
+
+	It("Should not accept invalid or expired tokens", func() {
+		route := GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", owner.PublicID))
+		status, _, _ := doRequestWithHeader(a, "GET", route, "", http.Header{api.PlayerTokenHeader: []string{"invalid"}})
+		Expect(status).To(Equal(http.StatusUnauthorized))
+
+		status, _, _ = doRequestWithHeader(a, "GET", route, "", tokenHeader(owner.PublicID, -time.Minute))
+		Expect(status).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("Should only allow a player to apply for membership as itself", func() {
+		route := CreateMembershipRoute(game.PublicID, clan.PublicID, "application")
+		body, _ := json.Marshal(map[string]interface{}{
+			"level":          "Member",
+			"playerPublicID": players[0].PublicID,
+		})
+
+		status, _, _ := doRequestWithHeader(a, "POST", route, string(body), tokenHeader(owner.PublicID, time.Minute))
+		Expect(status).To(Equal(http.StatusForbidden))
+
+		status, response, _ := doRequestWithHeader(a, "POST", route, string(body), tokenHeader(players[0].PublicID, time.Minute))
+		Expect(status).To(Equal(http.StatusOK), response)
+	})
+
+	It("Should only allow the clan owner to transfer the clan ownership", func() {
+		route := GetGameRoute(game.PublicID, fmt.Sprintf("/clans/%s/transfer-ownership", clan.PublicID))
+		body, _ := json.Marshal(map[string]interface{}{"playerPublicID": players[0].PublicID})
+
+		status, _, _ := doRequestWithHeader(a, "POST", route, string(body), tokenHeader(players[0].PublicID, time.Minute))
+		Expect(status).To(Equal(http.StatusForbidden))
+	})
+
+	It("Should only allow a player to read clans it is a member of", func() {
+		route := GetGameRoute(game.PublicID, fmt.Sprintf("/clans/%s", clan.PublicID))
+		status, body, _ := doRequestWithHeader(a, "GET", route, "", tokenHeader(owner.PublicID, time.Minute))
+		Expect(status).To(Equal(http.StatusOK), body)
+
+		outsider := fixtures.PlayerFactory.MustCreateWithOption(map[string]interface{}{
+			"GameID": game.PublicID,
+		}).(*models.Player)
+		err := db.Insert(outsider)
+		Expect(err).NotTo(HaveOccurred())
+		status, _, _ = doRequestWithHeader(a, "GET", route, "", tokenHeader(outsider.PublicID, time.Minute))
+		Expect(status).To(Equal(http.StatusForbidden))
+	})
+
+	It("Should not allow player tokens to list clans", func() {
+		status, _, _ := doRequestWithHeader(a, "GET", GetGameRoute(game.PublicID, "/clans"), "", tokenHeader(owner.PublicID, time.Minute))
+		Expect(status).To(Equal(http.StatusForbidden))
+	})
+
+	It("Should not skip basic auth for invalid tokens", func() {
+		basicAuthApp := GetTestAppWithBasicAuth("user", "pass")
+		basicAuthApp.Config.Set("playerTokens.enabled", true)
+		basicAuthApp.Configure()
+
+		route := GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", owner.PublicID))
+		status, _, _ := doRequestWithHeader(basicAuthApp, "GET", route, "", http.Header{api.PlayerTokenHeader: []string{"invalid"}})
+		Expect(status).To(Equal(http.StatusUnauthorized))
+
+		status, body, _ := doRequestWithHeader(basicAuthApp, "GET", route, "", tokenHeader(owner.PublicID, time.Minute))
+		Expect(status).To(Equal(http.StatusOK), body)
+	})
+
+	It("Should not allow player tokens in game routes", func() {
+		status, _, _ := doRequestWithHeader(a, "GET", fmt.Sprintf("/games/%s", game.PublicID), "", tokenHeader(owner.PublicID, time.Minute))
+		Expect(status).To(Equal(http.StatusForbidden))
+	})
+
+	It("Should still require API keys in requests without player tokens", func() {
+		status, _ := Get(a, GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", owner.PublicID)))
+		Expect(status).To(Equal(http.StatusUnauthorized))
+	})
+})
@@ -0,0 +1,204 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-gorp/gorp"
+	"github.com/jrallison/go-workers"
+	"github.com/labstack/echo"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/common/expfmt"
+	"github.com/topfreegames/khan/log"
+	"github.com/uber-go/zap"
+)
+
+// PrometheusMetrics are the metrics of the app exported to Prometheus at /metrics, alongside the ones sent to
+// DogStatsD
+type PrometheusMetrics struct {
+	Registry *prometheus.Registry
+	// RequestDuration observes the seconds taken to serve requests, by route, method and status
+	RequestDuration *prometheus.HistogramVec
+	// HookDeliveries counts the webhook requests, by game and response status. Requests that could not be
+	// sent have the error status
+	HookDeliveries *prometheus.CounterVec
+	// JobDuration observes the seconds taken by worker jobs, by queue and whether they succeeded
+	JobDuration *prometheus.HistogramVec
+	// CacheRequests counts the hits and misses of the game, clans summaries and details caches, by cache and
+	// result
+	CacheRequests *prometheus.CounterVec
+}
+
+// NewPrometheusMetrics returns the metrics of the app in a new registry, along with the Go runtime and
+// process metrics
+func NewPrometheusMetrics() *PrometheusMetrics {
+	m := &PrometheusMetrics{
+		Registry: prometheus.NewRegistry(),
+		RequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "khan_http_request_duration_seconds",
+			Help:    "Seconds taken to serve requests, by route, method and status.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "method", "status"}),
+		HookDeliveries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "khan_webhook_deliveries_total",
+			Help: "Webhook requests, by game and response status, error if the request could not be sent.",
+		}, []string{"game", "status"}),
+		JobDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "khan_worker_job_duration_seconds",
+			Help:    "Seconds taken by worker jobs, by queue and result.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"queue", "result"}),
+		CacheRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "khan_cache_requests_total",
+			Help: "Hits and misses of the game, clans summaries and details caches, by cache and result.",
+		}, []string{"cache", "result"}),
+	}
+	m.Registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		m.RequestDuration,
+		m.HookDeliveries,
+		m.JobDuration,
+		m.CacheRequests,
+	)
+	return m
+}
+
+// RegisterDB exports the connection pool statistics of db
+func (m *PrometheusMetrics) RegisterDB(db *sql.DB) {
+	gauge := func(name, help string, value func(stats sql.DBStats) float64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{Name: name, Help: help}, func() float64 {
+			return value(db.Stats())
+		})
+	}
+	counter := func(name, help string, value func(stats sql.DBStats) float64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{Name: name, Help: help}, func() float64 {
+			return value(db.Stats())
+		})
+	}
+	m.Registry.MustRegister(
+		gauge("khan_db_max_open_connections", "Maximum number of open connections to the database.",
+			func(stats sql.DBStats) float64 { return float64(stats.MaxOpenConnections) }),
+		gauge("khan_db_open_connections", "Number of open connections to the database.",
+			func(stats sql.DBStats) float64 { return float64(stats.OpenConnections) }),
+		gauge("khan_db_in_use_connections", "Number of connections to the database in use.",
+			func(stats sql.DBStats) float64 { return float64(stats.InUse) }),
+		gauge("khan_db_idle_connections", "Number of idle connections to the database.",
+			func(stats sql.DBStats) float64 { return float64(stats.Idle) }),
+		counter("khan_db_wait_count_total", "Number of connections waited for.",
+			func(stats sql.DBStats) float64 { return float64(stats.WaitCount) }),
+		counter("khan_db_wait_duration_seconds_total", "Seconds spent waiting for connections.",
+			func(stats sql.DBStats) float64 { return stats.WaitDuration.Seconds() }),
+	)
+}
+
+// configurePrometheus creates the Prometheus metrics of the app
+func (app *App) configurePrometheus() {
+	app.Metrics = NewPrometheusMetrics()
+	if db, ok := app.db.(interface{ Inner() *gorp.DbMap }); ok {
+		app.Metrics.RegisterDB(db.Inner().Db)
+	}
+}
+
+// serveWorkerMetrics serves the Prometheus metrics of the workers, such as job durations and webhook deliveries,
+// at /metrics on port, since worker processes do not serve the API
+func (app *App) serveWorkerMetrics(port int) {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "serveWorkerMetrics"),
+		zap.Int("port", port),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(app.Metrics.Registry, promhttp.HandlerOpts{}))
+	log.I(logger, "Serving worker metrics.")
+	if err := http.ListenAndServe(fmt.Sprintf("%s:%d", app.Host, port), mux); err != nil {
+		log.E(logger, "Failed to serve worker metrics.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+}
+
+// NewPrometheusMiddleware returns a middleware that observes the duration of requests in metrics
+func NewPrometheusMiddleware(metrics *PrometheusMetrics) *PrometheusMiddleware {
+	return &PrometheusMiddleware{Metrics: metrics}
+}
+
+// PrometheusMiddleware observes the duration of requests by route, method and status
+type PrometheusMiddleware struct {
+	Metrics *PrometheusMetrics
+}
+
+// Serve serves the middleware
+func (m *PrometheusMiddleware) Serve(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+		err := next(c)
+		status := c.Response().Status()
+		if err != nil {
+			status = http.StatusInternalServerError
+			if he, ok := err.(*echo.HTTPError); ok {
+				status = he.Code
+			}
+		}
+		m.Metrics.RequestDuration.WithLabelValues(
+			c.Path(), c.Request().Method(), strconv.Itoa(status),
+		).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// prometheusJobMiddleware observes the duration of worker jobs by queue and result
+type prometheusJobMiddleware struct {
+	metrics *PrometheusMetrics
+}
+
+// Call observes the job, re-panicking after jobs that fail
+func (m *prometheusJobMiddleware) Call(queue string, message *workers.Msg, next func() bool) (acknowledge bool) {
+	start := time.Now()
+	defer func() {
+		result := "success"
+		e := recover()
+		if e != nil {
+			result = "failure"
+		}
+		m.metrics.JobDuration.WithLabelValues(queue, result).Observe(time.Since(start).Seconds())
+		if e != nil {
+			panic(e)
+		}
+	}()
+	return next()
+}
+
+//MetricsHandler is the handler responsible for serving the Prometheus metrics of the app
+func MetricsHandler(app *App) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		c.Set("route", "Metrics")
+		families, err := app.Metrics.Registry.Gather()
+		if err != nil {
+			return FailWith(http.StatusInternalServerError, err.Error(), c)
+		}
+
+		res := c.Response()
+		res.Header().Set(echo.HeaderContentType, string(expfmt.FmtText))
+		res.WriteHeader(http.StatusOK)
+		encoder := expfmt.NewEncoder(res, expfmt.FmtText)
+		for _, family := range families {
+			if err := encoder.Encode(family); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
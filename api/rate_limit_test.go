@@ -0,0 +1,120 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/khan/api"
+	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+var _ = Describe("Rate Limit Middleware", func() {
+	var db models.DB
+	var a *api.App
+
+	BeforeEach(func() {
+		a = GetTestAppWithRateLimit(2)
+		db = a.Db(nil)
+	})
+
+	It("Should reject requests over the limit with Retry-After", func() {
+		_, player, err := fixtures.CreatePlayerFactory(db, "")
+		Expect(err).NotTo(HaveOccurred())
+		route := GetGameRoute(player.GameID, fmt.Sprintf("/players/%s", player.PublicID))
+
+		for i := 0; i < 2; i++ {
+			status, _ := Get(a, route)
+			Expect(status).To(Equal(http.StatusOK))
+		}
+
+		status, _, header := doRequestWithHeader(a, "GET", route, "", http.Header{})
+		Expect(status).To(Equal(http.StatusTooManyRequests))
+		retryAfter, err := strconv.Atoi(header.Get("Retry-After"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retryAfter).To(BeNumerically(">", 0))
+	})
+
+	It("Should limit requests without credentials by client IP", func() {
+		game, player, err := fixtures.CreatePlayerFactory(db, "")
+		Expect(err).NotTo(HaveOccurred())
+		_, otherPlayer, err := fixtures.CreatePlayerFactory(db, game.PublicID, true)
+		Expect(err).NotTo(HaveOccurred())
+		clientHeader := func(ip string) http.Header {
+			return http.Header{"X-Forwarded-For": []string{ip}}
+		}
+
+		for i := 0; i < 2; i++ {
+			route := GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", player.PublicID))
+			status, _, _ := doRequestWithHeader(a, "GET", route, "", clientHeader("10.0.0.1"))
+			Expect(status).To(Equal(http.StatusOK))
+		}
+
+		route := GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", otherPlayer.PublicID))
+		status, _, _ := doRequestWithHeader(a, "GET", route, "", clientHeader("10.0.0.1"))
+		Expect(status).To(Equal(http.StatusTooManyRequests))
+		status, _, _ = doRequestWithHeader(a, "GET", route, "", clientHeader("10.0.0.2"))
+		Expect(status).To(Equal(http.StatusOK))
+	})
+
+	It("Should limit each player of an API key independently", func() {
+		a.Config.Set("apiKeys.enabled", true)
+		a.Configure()
+		game, player, err := fixtures.CreatePlayerFactory(db, "")
+		Expect(err).NotTo(HaveOccurred())
+		_, otherPlayer, err := fixtures.CreatePlayerFactory(db, game.PublicID, true)
+		Expect(err).NotTo(HaveOccurred())
+		_, key, err := models.CreateAPIKey(db, game.PublicID, []string{models.APIKeyScopeReadOnly})
+		Expect(err).NotTo(HaveOccurred())
+		keyHeader := http.Header{api.APIKeyHeader: []string{key}}
+
+		for i := 0; i < 3; i++ {
+			doRequestWithHeader(a, "GET", GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", player.PublicID)), "", keyHeader)
+		}
+
+		route := GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", otherPlayer.PublicID))
+		status, _, _ := doRequestWithHeader(a, "GET", route, "", keyHeader)
+		Expect(status).To(Equal(http.StatusOK))
+	})
+
+	It("Should limit each player of the basic auth user independently", func() {
+		a.Config.Set("basicauth.username", "basicauthuser")
+		a.Config.Set("basicauth.password", "basicauthpass")
+		a.Configure()
+		game, player, err := fixtures.CreatePlayerFactory(db, "")
+		Expect(err).NotTo(HaveOccurred())
+		_, otherPlayer, err := fixtures.CreatePlayerFactory(db, game.PublicID, true)
+		Expect(err).NotTo(HaveOccurred())
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth("basicauthuser", "basicauthpass")
+		header := http.Header{
+			"Authorization":   req.Header["Authorization"],
+			"X-Forwarded-For": []string{"10.0.0.1"},
+		}
+
+		for i := 0; i < 3; i++ {
+			doRequestWithHeader(a, "GET", GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", player.PublicID)), "", header)
+		}
+
+		route := GetGameRoute(game.PublicID, fmt.Sprintf("/players/%s", otherPlayer.PublicID))
+		status, _, _ := doRequestWithHeader(a, "GET", route, "", header)
+		Expect(status).To(Equal(http.StatusOK))
+	})
+
+	It("Should not limit routes that are not game routes", func() {
+		for i := 0; i < 3; i++ {
+			status, _ := Get(a, "/healthcheck")
+			Expect(status).To(Equal(http.StatusOK))
+		}
+	})
+})
@@ -0,0 +1,345 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+//go:generate easyjson -all -no_std_marshalers $GOFILE
+
+package api
+
+//SuccessResponse is the response of the v2 routes that only report success
+type SuccessResponse struct {
+	Success bool `json:"success" doc:"Always true, failed requests respond with an error"`
+}
+
+//PublicIDResponse is the response of the v2 routes that create a resource
+type PublicIDResponse struct {
+	Success  bool   `json:"success" doc:"Always true, failed requests respond with an error"`
+	PublicID string `json:"publicID" doc:"Public ID of the created resource"`
+}
+
+//ClanReference identifies a clan
+type ClanReference struct {
+	PublicID string `json:"publicID" doc:"Public ID of the clan"`
+	Name     string `json:"name" doc:"Name of the clan"`
+}
+
+//PlayerReference identifies a player
+type PlayerReference struct {
+	PublicID string `json:"publicID" doc:"Public ID of the player"`
+	Name     string `json:"name" doc:"Name of the player"`
+}
+
+//PlayerProfile is the public information of a player
+type PlayerProfile struct {
+	PublicID string                 `json:"publicID" doc:"Public ID of the player"`
+	Name     string                 `json:"name" doc:"Name of the player"`
+	Metadata map[string]interface{} `json:"metadata" doc:"Metadata of the player"`
+}
+
+//PlayerSummary is a player with its membership counts
+type PlayerSummary struct {
+	PublicID        string                 `json:"publicID" doc:"Public ID of the player"`
+	Name            string                 `json:"name" doc:"Name of the player"`
+	Metadata        map[string]interface{} `json:"metadata" doc:"Metadata of the player"`
+	MembershipCount int                    `json:"membershipCount" doc:"Number of clans the player is a member of"`
+	OwnershipCount  int                    `json:"ownershipCount" doc:"Number of clans the player owns"`
+}
+
+//ClanSummary is a clan without its members
+type ClanSummary struct {
+	PublicID         string                 `json:"publicID" doc:"Public ID of the clan"`
+	Name             string                 `json:"name" doc:"Name of the clan"`
+	Metadata         map[string]interface{} `json:"metadata" doc:"Metadata of the clan"`
+	AllowApplication bool                   `json:"allowApplication" doc:"Whether players can apply for membership"`
+	AutoJoin         bool                   `json:"autoJoin" doc:"Whether applications are approved automatically"`
+	MembershipCount  int                    `json:"membershipCount" doc:"Number of members of the clan, including the owner"`
+}
+
+//CreatePlayerResponse is the response of the create player v2 route
+type CreatePlayerResponse struct {
+	Success  bool                   `json:"success" doc:"Always true, failed requests respond with an error"`
+	GameID   string                 `json:"gameID" doc:"Public ID of the game"`
+	PublicID string                 `json:"publicID" doc:"Public ID of the player"`
+	Name     string                 `json:"name" doc:"Name of the player"`
+	Metadata map[string]interface{} `json:"metadata" doc:"Metadata of the player"`
+}
+
+//UpsertPlayerError is a player that could not be created or updated
+type UpsertPlayerError struct {
+	Index    int    `json:"index" doc:"Index of the player in the payload"`
+	PublicID string `json:"publicID" doc:"Public ID of the player"`
+	Reason   string `json:"reason" doc:"Why the player could not be created or updated"`
+}
+
+//UpsertPlayersResponse is the response of the upsert players v2 route
+type UpsertPlayersResponse struct {
+	Success bool                 `json:"success" doc:"Always true, failed requests respond with an error"`
+	Created int                  `json:"created" doc:"Number of players created"`
+	Updated int                  `json:"updated" doc:"Number of players updated"`
+	Errors  []*UpsertPlayerError `json:"errors" doc:"Players that could not be created or updated"`
+}
+
+//PatchPlayerResponse is the response of the patch player v2 route
+type PatchPlayerResponse struct {
+	Success  bool                   `json:"success" doc:"Always true, failed requests respond with an error"`
+	Name     string                 `json:"name" doc:"Name of the player after the patch"`
+	Metadata map[string]interface{} `json:"metadata" doc:"Metadata of the player after the patch"`
+}
+
+//PlayerClans are the clans a player is related to, by relationship
+type PlayerClans struct {
+	Owned               []*ClanReference `json:"owned" doc:"Clans the player owns"`
+	Approved            []*ClanReference `json:"approved" doc:"Clans the player is a member of"`
+	Banned              []*ClanReference `json:"banned" doc:"Clans the player was banned from"`
+	Denied              []*ClanReference `json:"denied" doc:"Clans that denied the player"`
+	PendingApplications []*ClanReference `json:"pendingApplications" doc:"Clans the player applied to"`
+	PendingInvites      []*ClanReference `json:"pendingInvites" doc:"Clans the player was invited to"`
+}
+
+//MembershipClan is the clan of a membership
+type MembershipClan struct {
+	PublicID        string                 `json:"publicID" doc:"Public ID of the clan"`
+	Name            string                 `json:"name" doc:"Name of the clan"`
+	Metadata        map[string]interface{} `json:"metadata" doc:"Metadata of the clan"`
+	MembershipCount int                    `json:"membershipCount" doc:"Number of members of the clan, including the owner"`
+}
+
+//MembershipRequestor is the player that requested a membership
+type MembershipRequestor struct {
+	PublicID string                 `json:"publicID" doc:"Public ID of the player"`
+	Name     string                 `json:"name" doc:"Name of the player"`
+	Metadata map[string]interface{} `json:"metadata" doc:"Metadata of the player"`
+	Level    string                 `json:"level" doc:"Membership level of the player in the clan"`
+}
+
+//PlayerMembership is a membership of a player
+type PlayerMembership struct {
+	Clan       *MembershipClan      `json:"clan" doc:"Clan of the membership"`
+	Level      string               `json:"level" doc:"Membership level, owner for clans the player owns"`
+	Approved   bool                 `json:"approved" doc:"Whether the membership was approved"`
+	Denied     bool                 `json:"denied" doc:"Whether the membership was denied"`
+	Banned     bool                 `json:"banned" doc:"Whether the player was banned"`
+	Message    string               `json:"message" doc:"Message of the application or invitation"`
+	CreatedAt  int64                `json:"createdAt" doc:"Creation time in milliseconds"`
+	UpdatedAt  int64                `json:"updatedAt" doc:"Last update time in milliseconds"`
+	DeletedAt  int64                `json:"deletedAt" doc:"Deletion time in milliseconds, 0 if not deleted"`
+	ApprovedAt int64                `json:"approvedAt" doc:"Approval time in milliseconds, 0 if not approved"`
+	DeniedAt   int64                `json:"deniedAt" doc:"Denial time in milliseconds, 0 if not denied"`
+	Requestor  *MembershipRequestor `json:"requestor,omitempty" doc:"Player that requested the membership, omitted for owned clans"`
+	Approver   *PlayerProfile       `json:"approver,omitempty" doc:"Player that approved the membership"`
+	Denier     *PlayerProfile       `json:"denier,omitempty" doc:"Player that denied the membership"`
+	DeletedBy  *PlayerReference     `json:"deletedBy,omitempty" doc:"Player that deleted the membership"`
+}
+
+//PlayerResponse is the response of the retrieve player v2 route
+type PlayerResponse struct {
+	Success     bool                   `json:"success" doc:"Always true, failed requests respond with an error"`
+	PublicID    string                 `json:"publicID" doc:"Public ID of the player"`
+	Name        string                 `json:"name" doc:"Name of the player"`
+	Metadata    map[string]interface{} `json:"metadata" doc:"Metadata of the player"`
+	CreatedAt   int64                  `json:"createdAt" doc:"Creation time in milliseconds"`
+	UpdatedAt   int64                  `json:"updatedAt" doc:"Last update time in milliseconds"`
+	Version     int64                  `json:"version" doc:"Version of the player, sent in If-Match to update it"`
+	Clans       *PlayerClans           `json:"clans" doc:"Clans the player is related to"`
+	Memberships []*PlayerMembership    `json:"memberships" doc:"Memberships of the player, including the clans it owns"`
+}
+
+//ExportedPlayer is a player in the export of its data
+type ExportedPlayer struct {
+	GameID          string                 `json:"gameID" doc:"Public ID of the game"`
+	PublicID        string                 `json:"publicID" doc:"Public ID of the player"`
+	Name            string                 `json:"name" doc:"Name of the player"`
+	Metadata        map[string]interface{} `json:"metadata" doc:"Metadata of the player"`
+	MembershipCount int                    `json:"membershipCount" doc:"Number of clans the player is a member of"`
+	OwnershipCount  int                    `json:"ownershipCount" doc:"Number of clans the player owns"`
+	CreatedAt       int64                  `json:"createdAt" doc:"Creation time in milliseconds"`
+	UpdatedAt       int64                  `json:"updatedAt" doc:"Last update time in milliseconds"`
+}
+
+//PlayerNameChange is a previous name of a player
+type PlayerNameChange struct {
+	Name      string `json:"name" doc:"Previous name of the player"`
+	ChangedAt int64  `json:"changedAt" doc:"Time the name was replaced in milliseconds"`
+}
+
+//ExportedMembership is a membership in the export of the data of a player
+type ExportedMembership struct {
+	Clan       *ClanReference `json:"clan" doc:"Clan of the membership"`
+	Level      string         `json:"level" doc:"Membership level"`
+	Approved   bool           `json:"approved" doc:"Whether the membership was approved"`
+	Denied     bool           `json:"denied" doc:"Whether the membership was denied"`
+	Banned     bool           `json:"banned" doc:"Whether the player was banned"`
+	Message    string         `json:"message" doc:"Message of the application or invitation"`
+	CreatedAt  int64          `json:"createdAt" doc:"Creation time in milliseconds"`
+	UpdatedAt  int64          `json:"updatedAt" doc:"Last update time in milliseconds"`
+	ApprovedAt int64          `json:"approvedAt" doc:"Approval time in milliseconds, 0 if not approved"`
+	DeniedAt   int64          `json:"deniedAt" doc:"Denial time in milliseconds, 0 if not denied"`
+	DeletedAt  int64          `json:"deletedAt" doc:"Deletion time in milliseconds, 0 if not deleted"`
+	Player     string         `json:"player" doc:"Public ID of the member"`
+	Requestor  string         `json:"requestor" doc:"Public ID of the player that requested the membership"`
+	Approver   string         `json:"approver" doc:"Public ID of the player that approved the membership, if any"`
+	Denier     string         `json:"denier" doc:"Public ID of the player that denied the membership, if any"`
+	DeletedBy  string         `json:"deletedBy" doc:"Public ID of the player that deleted the membership, if any"`
+}
+
+//ExportedClan is a clan owned by a player in the export of its data
+type ExportedClan struct {
+	GameID           string                 `json:"gameID" doc:"Public ID of the game"`
+	PublicID         string                 `json:"publicID" doc:"Public ID of the clan"`
+	Name             string                 `json:"name" doc:"Name of the clan"`
+	Metadata         map[string]interface{} `json:"metadata" doc:"Metadata of the clan"`
+	AllowApplication bool                   `json:"allowApplication" doc:"Whether players can apply for membership"`
+	AutoJoin         bool                   `json:"autoJoin" doc:"Whether applications are approved automatically"`
+	MembershipCount  int                    `json:"membershipCount" doc:"Number of members of the clan, including the owner"`
+}
+
+//ExportPlayerResponse is the response of the export player v2 route
+type ExportPlayerResponse struct {
+	Success            bool                  `json:"success" doc:"Always true, failed requests respond with an error"`
+	Player             *ExportedPlayer       `json:"player" doc:"The player"`
+	NameHistory        []*PlayerNameChange   `json:"nameHistory" doc:"Previous names of the player"`
+	Memberships        []*ExportedMembership `json:"memberships" doc:"Memberships of the player, including deleted ones"`
+	OwnedClans         []*ExportedClan       `json:"ownedClans" doc:"Clans the player owns"`
+	MembershipsActions []*ExportedMembership `json:"membershipsActions" doc:"Memberships of other players the player requested, approved, denied or deleted"`
+}
+
+//PlayerNameHistoryResponse is the response of the player name history v2 route
+type PlayerNameHistoryResponse struct {
+	Success     bool                `json:"success" doc:"Always true, failed requests respond with an error"`
+	NameHistory []*PlayerNameChange `json:"nameHistory" doc:"Previous names of the player"`
+}
+
+//DeletedClan is a clan owned by a deleted player
+type DeletedClan struct {
+	PublicID  string  `json:"publicID" doc:"Public ID of the clan"`
+	NewOwner  *string `json:"newOwner" doc:"Public ID of the new owner of the clan, null if the clan was deleted"`
+	IsDeleted bool    `json:"isDeleted" doc:"Whether the clan was deleted because it had no members"`
+}
+
+//DeletePlayerResponse is the response of the delete player v2 route
+type DeletePlayerResponse struct {
+	Success bool           `json:"success" doc:"Always true, failed requests respond with an error"`
+	Clans   []*DeletedClan `json:"clans" doc:"Clans the player owned"`
+}
+
+//ClansResponse is the response of the v2 routes that list clans
+type ClansResponse struct {
+	Success bool           `json:"success" doc:"Always true, failed requests respond with an error"`
+	Clans   []*ClanSummary `json:"clans" doc:"The clans"`
+}
+
+//ClanSuggestionsResponse is the response of the suggest clans v2 route
+type ClanSuggestionsResponse struct {
+	Success bool             `json:"success" doc:"Always true, failed requests respond with an error"`
+	Clans   []*ClanReference `json:"clans" doc:"Clans with names starting with the prefix"`
+}
+
+//ClansSummariesResponse is the response of the clans summaries v2 route
+type ClansSummariesResponse struct {
+	Success      bool           `json:"success" doc:"Always true, failed requests respond with an error"`
+	Clans        []*ClanSummary `json:"clans" doc:"Summaries of the clans found"`
+	MissingClans []string       `json:"missingClans,omitempty" doc:"Public IDs of the clans not found"`
+}
+
+//ClanSummaryResponse is the response of the clan summary v2 route
+type ClanSummaryResponse struct {
+	Success          bool                   `json:"success" doc:"Always true, failed requests respond with an error"`
+	PublicID         string                 `json:"publicID" doc:"Public ID of the clan"`
+	Name             string                 `json:"name" doc:"Name of the clan"`
+	Metadata         map[string]interface{} `json:"metadata" doc:"Metadata of the clan"`
+	AllowApplication bool                   `json:"allowApplication" doc:"Whether players can apply for membership"`
+	AutoJoin         bool                   `json:"autoJoin" doc:"Whether applications are approved automatically"`
+	MembershipCount  int                    `json:"membershipCount" doc:"Number of members of the clan, including the owner"`
+}
+
+//ClanMembersResponse is the response of the clan members v2 route
+type ClanMembersResponse struct {
+	Success bool     `json:"success" doc:"Always true, failed requests respond with an error"`
+	Members []string `json:"members" doc:"Public IDs of the members of the clan, including the owner"`
+}
+
+//ClanMemberPlayer is the player of a clan membership
+type ClanMemberPlayer struct {
+	PublicID string                 `json:"publicID" doc:"Public ID of the player"`
+	Name     string                 `json:"name" doc:"Name of the player"`
+	Metadata map[string]interface{} `json:"metadata" doc:"Metadata of the player"`
+	Approver *PlayerReference       `json:"approver,omitempty" doc:"Player that approved the membership"`
+	Denier   *PlayerReference       `json:"denier,omitempty" doc:"Player that denied the membership"`
+}
+
+//ClanMember is a membership of a clan
+type ClanMember struct {
+	Player  *ClanMemberPlayer `json:"player" doc:"The member"`
+	Level   string            `json:"level,omitempty" doc:"Membership level, omitted for banned and denied players"`
+	Message string            `json:"message,omitempty" doc:"Message of the application, for pending applications"`
+}
+
+//ClanMemberships are the memberships of a clan that are not approved
+type ClanMemberships struct {
+	PendingApplications []*ClanMember `json:"pendingApplications" doc:"Pending applications for membership"`
+	PendingInvites      []*ClanMember `json:"pendingInvites" doc:"Pending invitations for membership"`
+	Banned              []*ClanMember `json:"banned" doc:"Banned players"`
+	Denied              []*ClanMember `json:"denied" doc:"Denied applications and invitations"`
+}
+
+//ClanResponse is the response of the retrieve clan v2 route
+type ClanResponse struct {
+	Success          bool                   `json:"success" doc:"Always true, failed requests respond with an error"`
+	PublicID         string                 `json:"publicID" doc:"Public ID of the clan"`
+	Name             string                 `json:"name" doc:"Name of the clan"`
+	Metadata         map[string]interface{} `json:"metadata" doc:"Metadata of the clan"`
+	AllowApplication bool                   `json:"allowApplication" doc:"Whether players can apply for membership"`
+	AutoJoin         bool                   `json:"autoJoin" doc:"Whether applications are approved automatically"`
+	MembershipCount  int                    `json:"membershipCount" doc:"Number of members of the clan, including the owner"`
+	Version          int64                  `json:"version" doc:"Version of the clan, sent in If-Match to update it"`
+	Owner            *PlayerProfile         `json:"owner" doc:"Owner of the clan"`
+	Roster           []*ClanMember          `json:"roster" doc:"Approved members of the clan"`
+	Memberships      *ClanMemberships       `json:"memberships" doc:"Memberships of the clan that are not approved"`
+}
+
+//PatchedClan is a clan after a patch
+type PatchedClan struct {
+	PublicID         string                 `json:"publicID" doc:"Public ID of the clan"`
+	Name             string                 `json:"name" doc:"Name of the clan"`
+	Metadata         map[string]interface{} `json:"metadata" doc:"Metadata of the clan"`
+	AllowApplication bool                   `json:"allowApplication" doc:"Whether players can apply for membership"`
+	AutoJoin         bool                   `json:"autoJoin" doc:"Whether applications are approved automatically"`
+	MembershipCount  int                    `json:"membershipCount" doc:"Number of members of the clan, including the owner"`
+	OwnerPublicID    string                 `json:"ownerPublicID" doc:"Public ID of the owner of the clan"`
+}
+
+//PatchClanResponse is the response of the patch clan v2 route
+type PatchClanResponse struct {
+	Success bool         `json:"success" doc:"Always true, failed requests respond with an error"`
+	Clan    *PatchedClan `json:"clan" doc:"The clan after the patch"`
+}
+
+//LeaveClanResponse is the response of the leave clan v2 route
+type LeaveClanResponse struct {
+	Success       bool           `json:"success" doc:"Always true, failed requests respond with an error"`
+	PreviousOwner *PlayerSummary `json:"previousOwner" doc:"Owner that left the clan"`
+	NewOwner      *PlayerSummary `json:"newOwner" doc:"New owner of the clan, null if the clan was deleted"`
+	IsDeleted     bool           `json:"isDeleted" doc:"Whether the clan was deleted because it had no members"`
+}
+
+//TransferClanOwnershipResponse is the response of the transfer clan ownership v2 route
+type TransferClanOwnershipResponse struct {
+	Success       bool           `json:"success" doc:"Always true, failed requests respond with an error"`
+	PreviousOwner *PlayerSummary `json:"previousOwner" doc:"Previous owner of the clan"`
+	NewOwner      *PlayerSummary `json:"newOwner" doc:"New owner of the clan"`
+}
+
+//ApplyForMembershipResponse is the response of the apply for membership v2 route
+type ApplyForMembershipResponse struct {
+	Success  bool `json:"success" doc:"Always true, failed requests respond with an error"`
+	Approved bool `json:"approved" doc:"Whether the application was approved automatically"`
+}
+
+//MembershipLevelResponse is the response of the promote and demote membership v2 routes
+type MembershipLevelResponse struct {
+	Success bool   `json:"success" doc:"Always true, failed requests respond with an error"`
+	Level   string `json:"level" doc:"Membership level after the promotion or demotion"`
+}
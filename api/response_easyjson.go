@@ -0,0 +1,4849 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package api
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi(in *jlexer.Lexer, out *UpsertPlayersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "created":
+			out.Created = int(in.Int())
+		case "updated":
+			out.Updated = int(in.Int())
+		case "errors":
+			if in.IsNull() {
+				in.Skip()
+				out.Errors = nil
+			} else {
+				in.Delim('[')
+				if out.Errors == nil {
+					if !in.IsDelim(']') {
+						out.Errors = make([]*UpsertPlayerError, 0, 8)
+					} else {
+						out.Errors = []*UpsertPlayerError{}
+					}
+				} else {
+					out.Errors = (out.Errors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *UpsertPlayerError
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(UpsertPlayerError)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Errors = append(out.Errors, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi(out *jwriter.Writer, in UpsertPlayersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Int(int(in.Created))
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Int(int(in.Updated))
+	}
+	{
+		const prefix string = ",\"errors\":"
+		out.RawString(prefix)
+		if in.Errors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Errors {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpsertPlayersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpsertPlayersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi1(in *jlexer.Lexer, out *UpsertPlayerError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "index":
+			out.Index = int(in.Int())
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi1(out *jwriter.Writer, in UpsertPlayerError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"index\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Index))
+	}
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix)
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpsertPlayerError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpsertPlayerError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi1(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi2(in *jlexer.Lexer, out *TransferClanOwnershipResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "previousOwner":
+			if in.IsNull() {
+				in.Skip()
+				out.PreviousOwner = nil
+			} else {
+				if out.PreviousOwner == nil {
+					out.PreviousOwner = new(PlayerSummary)
+				}
+				(*out.PreviousOwner).UnmarshalEasyJSON(in)
+			}
+		case "newOwner":
+			if in.IsNull() {
+				in.Skip()
+				out.NewOwner = nil
+			} else {
+				if out.NewOwner == nil {
+					out.NewOwner = new(PlayerSummary)
+				}
+				(*out.NewOwner).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi2(out *jwriter.Writer, in TransferClanOwnershipResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"previousOwner\":"
+		out.RawString(prefix)
+		if in.PreviousOwner == nil {
+			out.RawString("null")
+		} else {
+			(*in.PreviousOwner).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"newOwner\":"
+		out.RawString(prefix)
+		if in.NewOwner == nil {
+			out.RawString("null")
+		} else {
+			(*in.NewOwner).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TransferClanOwnershipResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi2(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TransferClanOwnershipResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi2(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi3(in *jlexer.Lexer, out *SuccessResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi3(out *jwriter.Writer, in SuccessResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuccessResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi3(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuccessResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi3(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi4(in *jlexer.Lexer, out *PublicIDResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "publicID":
+			out.PublicID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi4(out *jwriter.Writer, in PublicIDResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix)
+		out.String(string(in.PublicID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PublicIDResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi4(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PublicIDResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi4(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi5(in *jlexer.Lexer, out *PlayerSummary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v4 interface{}
+					if m, ok := v4.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v4.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v4 = in.Interface()
+					}
+					(out.Metadata)[key] = v4
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "membershipCount":
+			out.MembershipCount = int(in.Int())
+		case "ownershipCount":
+			out.OwnershipCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi5(out *jwriter.Writer, in PlayerSummary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v5First := true
+			for v5Name, v5Value := range in.Metadata {
+				if v5First {
+					v5First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v5Name))
+				out.RawByte(':')
+				if m, ok := v5Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v5Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v5Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"membershipCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembershipCount))
+	}
+	{
+		const prefix string = ",\"ownershipCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnershipCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlayerSummary) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi5(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlayerSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi5(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi6(in *jlexer.Lexer, out *PlayerResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v6 interface{}
+					if m, ok := v6.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v6.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v6 = in.Interface()
+					}
+					(out.Metadata)[key] = v6
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "createdAt":
+			out.CreatedAt = int64(in.Int64())
+		case "updatedAt":
+			out.UpdatedAt = int64(in.Int64())
+		case "version":
+			out.Version = int64(in.Int64())
+		case "clans":
+			if in.IsNull() {
+				in.Skip()
+				out.Clans = nil
+			} else {
+				if out.Clans == nil {
+					out.Clans = new(PlayerClans)
+				}
+				(*out.Clans).UnmarshalEasyJSON(in)
+			}
+		case "memberships":
+			if in.IsNull() {
+				in.Skip()
+				out.Memberships = nil
+			} else {
+				in.Delim('[')
+				if out.Memberships == nil {
+					if !in.IsDelim(']') {
+						out.Memberships = make([]*PlayerMembership, 0, 8)
+					} else {
+						out.Memberships = []*PlayerMembership{}
+					}
+				} else {
+					out.Memberships = (out.Memberships)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 *PlayerMembership
+					if in.IsNull() {
+						in.Skip()
+						v7 = nil
+					} else {
+						if v7 == nil {
+							v7 = new(PlayerMembership)
+						}
+						(*v7).UnmarshalEasyJSON(in)
+					}
+					out.Memberships = append(out.Memberships, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi6(out *jwriter.Writer, in PlayerResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix)
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v8First := true
+			for v8Name, v8Value := range in.Metadata {
+				if v8First {
+					v8First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v8Name))
+				out.RawByte(':')
+				if m, ok := v8Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v8Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v8Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdatedAt))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Version))
+	}
+	{
+		const prefix string = ",\"clans\":"
+		out.RawString(prefix)
+		if in.Clans == nil {
+			out.RawString("null")
+		} else {
+			(*in.Clans).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"memberships\":"
+		out.RawString(prefix)
+		if in.Memberships == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v9, v10 := range in.Memberships {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				if v10 == nil {
+					out.RawString("null")
+				} else {
+					(*v10).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlayerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi6(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlayerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi6(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi7(in *jlexer.Lexer, out *PlayerReference) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi7(out *jwriter.Writer, in PlayerReference) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlayerReference) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi7(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlayerReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi7(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi8(in *jlexer.Lexer, out *PlayerProfile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v11 interface{}
+					if m, ok := v11.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v11.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v11 = in.Interface()
+					}
+					(out.Metadata)[key] = v11
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi8(out *jwriter.Writer, in PlayerProfile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v12First := true
+			for v12Name, v12Value := range in.Metadata {
+				if v12First {
+					v12First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v12Name))
+				out.RawByte(':')
+				if m, ok := v12Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v12Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v12Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlayerProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi8(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlayerProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi8(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi9(in *jlexer.Lexer, out *PlayerNameHistoryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "nameHistory":
+			if in.IsNull() {
+				in.Skip()
+				out.NameHistory = nil
+			} else {
+				in.Delim('[')
+				if out.NameHistory == nil {
+					if !in.IsDelim(']') {
+						out.NameHistory = make([]*PlayerNameChange, 0, 8)
+					} else {
+						out.NameHistory = []*PlayerNameChange{}
+					}
+				} else {
+					out.NameHistory = (out.NameHistory)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 *PlayerNameChange
+					if in.IsNull() {
+						in.Skip()
+						v13 = nil
+					} else {
+						if v13 == nil {
+							v13 = new(PlayerNameChange)
+						}
+						(*v13).UnmarshalEasyJSON(in)
+					}
+					out.NameHistory = append(out.NameHistory, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi9(out *jwriter.Writer, in PlayerNameHistoryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"nameHistory\":"
+		out.RawString(prefix)
+		if in.NameHistory == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.NameHistory {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				if v15 == nil {
+					out.RawString("null")
+				} else {
+					(*v15).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlayerNameHistoryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi9(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlayerNameHistoryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi9(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi10(in *jlexer.Lexer, out *PlayerNameChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "changedAt":
+			out.ChangedAt = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi10(out *jwriter.Writer, in PlayerNameChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"changedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ChangedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlayerNameChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi10(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlayerNameChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi10(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi11(in *jlexer.Lexer, out *PlayerMembership) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "clan":
+			if in.IsNull() {
+				in.Skip()
+				out.Clan = nil
+			} else {
+				if out.Clan == nil {
+					out.Clan = new(MembershipClan)
+				}
+				(*out.Clan).UnmarshalEasyJSON(in)
+			}
+		case "level":
+			out.Level = string(in.String())
+		case "approved":
+			out.Approved = bool(in.Bool())
+		case "denied":
+			out.Denied = bool(in.Bool())
+		case "banned":
+			out.Banned = bool(in.Bool())
+		case "message":
+			out.Message = string(in.String())
+		case "createdAt":
+			out.CreatedAt = int64(in.Int64())
+		case "updatedAt":
+			out.UpdatedAt = int64(in.Int64())
+		case "deletedAt":
+			out.DeletedAt = int64(in.Int64())
+		case "approvedAt":
+			out.ApprovedAt = int64(in.Int64())
+		case "deniedAt":
+			out.DeniedAt = int64(in.Int64())
+		case "requestor":
+			if in.IsNull() {
+				in.Skip()
+				out.Requestor = nil
+			} else {
+				if out.Requestor == nil {
+					out.Requestor = new(MembershipRequestor)
+				}
+				(*out.Requestor).UnmarshalEasyJSON(in)
+			}
+		case "approver":
+			if in.IsNull() {
+				in.Skip()
+				out.Approver = nil
+			} else {
+				if out.Approver == nil {
+					out.Approver = new(PlayerProfile)
+				}
+				(*out.Approver).UnmarshalEasyJSON(in)
+			}
+		case "denier":
+			if in.IsNull() {
+				in.Skip()
+				out.Denier = nil
+			} else {
+				if out.Denier == nil {
+					out.Denier = new(PlayerProfile)
+				}
+				(*out.Denier).UnmarshalEasyJSON(in)
+			}
+		case "deletedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedBy = nil
+			} else {
+				if out.DeletedBy == nil {
+					out.DeletedBy = new(PlayerReference)
+				}
+				(*out.DeletedBy).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi11(out *jwriter.Writer, in PlayerMembership) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"clan\":"
+		out.RawString(prefix[1:])
+		if in.Clan == nil {
+			out.RawString("null")
+		} else {
+			(*in.Clan).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.String(string(in.Level))
+	}
+	{
+		const prefix string = ",\"approved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Approved))
+	}
+	{
+		const prefix string = ",\"denied\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Denied))
+	}
+	{
+		const prefix string = ",\"banned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Banned))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdatedAt))
+	}
+	{
+		const prefix string = ",\"deletedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DeletedAt))
+	}
+	{
+		const prefix string = ",\"approvedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ApprovedAt))
+	}
+	{
+		const prefix string = ",\"deniedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DeniedAt))
+	}
+	if in.Requestor != nil {
+		const prefix string = ",\"requestor\":"
+		out.RawString(prefix)
+		(*in.Requestor).MarshalEasyJSON(out)
+	}
+	if in.Approver != nil {
+		const prefix string = ",\"approver\":"
+		out.RawString(prefix)
+		(*in.Approver).MarshalEasyJSON(out)
+	}
+	if in.Denier != nil {
+		const prefix string = ",\"denier\":"
+		out.RawString(prefix)
+		(*in.Denier).MarshalEasyJSON(out)
+	}
+	if in.DeletedBy != nil {
+		const prefix string = ",\"deletedBy\":"
+		out.RawString(prefix)
+		(*in.DeletedBy).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlayerMembership) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi11(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlayerMembership) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi11(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi12(in *jlexer.Lexer, out *PlayerClans) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "owned":
+			if in.IsNull() {
+				in.Skip()
+				out.Owned = nil
+			} else {
+				in.Delim('[')
+				if out.Owned == nil {
+					if !in.IsDelim(']') {
+						out.Owned = make([]*ClanReference, 0, 8)
+					} else {
+						out.Owned = []*ClanReference{}
+					}
+				} else {
+					out.Owned = (out.Owned)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 *ClanReference
+					if in.IsNull() {
+						in.Skip()
+						v16 = nil
+					} else {
+						if v16 == nil {
+							v16 = new(ClanReference)
+						}
+						(*v16).UnmarshalEasyJSON(in)
+					}
+					out.Owned = append(out.Owned, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "approved":
+			if in.IsNull() {
+				in.Skip()
+				out.Approved = nil
+			} else {
+				in.Delim('[')
+				if out.Approved == nil {
+					if !in.IsDelim(']') {
+						out.Approved = make([]*ClanReference, 0, 8)
+					} else {
+						out.Approved = []*ClanReference{}
+					}
+				} else {
+					out.Approved = (out.Approved)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v17 *ClanReference
+					if in.IsNull() {
+						in.Skip()
+						v17 = nil
+					} else {
+						if v17 == nil {
+							v17 = new(ClanReference)
+						}
+						(*v17).UnmarshalEasyJSON(in)
+					}
+					out.Approved = append(out.Approved, v17)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "banned":
+			if in.IsNull() {
+				in.Skip()
+				out.Banned = nil
+			} else {
+				in.Delim('[')
+				if out.Banned == nil {
+					if !in.IsDelim(']') {
+						out.Banned = make([]*ClanReference, 0, 8)
+					} else {
+						out.Banned = []*ClanReference{}
+					}
+				} else {
+					out.Banned = (out.Banned)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 *ClanReference
+					if in.IsNull() {
+						in.Skip()
+						v18 = nil
+					} else {
+						if v18 == nil {
+							v18 = new(ClanReference)
+						}
+						(*v18).UnmarshalEasyJSON(in)
+					}
+					out.Banned = append(out.Banned, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "denied":
+			if in.IsNull() {
+				in.Skip()
+				out.Denied = nil
+			} else {
+				in.Delim('[')
+				if out.Denied == nil {
+					if !in.IsDelim(']') {
+						out.Denied = make([]*ClanReference, 0, 8)
+					} else {
+						out.Denied = []*ClanReference{}
+					}
+				} else {
+					out.Denied = (out.Denied)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 *ClanReference
+					if in.IsNull() {
+						in.Skip()
+						v19 = nil
+					} else {
+						if v19 == nil {
+							v19 = new(ClanReference)
+						}
+						(*v19).UnmarshalEasyJSON(in)
+					}
+					out.Denied = append(out.Denied, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "pendingApplications":
+			if in.IsNull() {
+				in.Skip()
+				out.PendingApplications = nil
+			} else {
+				in.Delim('[')
+				if out.PendingApplications == nil {
+					if !in.IsDelim(']') {
+						out.PendingApplications = make([]*ClanReference, 0, 8)
+					} else {
+						out.PendingApplications = []*ClanReference{}
+					}
+				} else {
+					out.PendingApplications = (out.PendingApplications)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v20 *ClanReference
+					if in.IsNull() {
+						in.Skip()
+						v20 = nil
+					} else {
+						if v20 == nil {
+							v20 = new(ClanReference)
+						}
+						(*v20).UnmarshalEasyJSON(in)
+					}
+					out.PendingApplications = append(out.PendingApplications, v20)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "pendingInvites":
+			if in.IsNull() {
+				in.Skip()
+				out.PendingInvites = nil
+			} else {
+				in.Delim('[')
+				if out.PendingInvites == nil {
+					if !in.IsDelim(']') {
+						out.PendingInvites = make([]*ClanReference, 0, 8)
+					} else {
+						out.PendingInvites = []*ClanReference{}
+					}
+				} else {
+					out.PendingInvites = (out.PendingInvites)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v21 *ClanReference
+					if in.IsNull() {
+						in.Skip()
+						v21 = nil
+					} else {
+						if v21 == nil {
+							v21 = new(ClanReference)
+						}
+						(*v21).UnmarshalEasyJSON(in)
+					}
+					out.PendingInvites = append(out.PendingInvites, v21)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi12(out *jwriter.Writer, in PlayerClans) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"owned\":"
+		out.RawString(prefix[1:])
+		if in.Owned == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v22, v23 := range in.Owned {
+				if v22 > 0 {
+					out.RawByte(',')
+				}
+				if v23 == nil {
+					out.RawString("null")
+				} else {
+					(*v23).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"approved\":"
+		out.RawString(prefix)
+		if in.Approved == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v24, v25 := range in.Approved {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				if v25 == nil {
+					out.RawString("null")
+				} else {
+					(*v25).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"banned\":"
+		out.RawString(prefix)
+		if in.Banned == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Banned {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				if v27 == nil {
+					out.RawString("null")
+				} else {
+					(*v27).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"denied\":"
+		out.RawString(prefix)
+		if in.Denied == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v28, v29 := range in.Denied {
+				if v28 > 0 {
+					out.RawByte(',')
+				}
+				if v29 == nil {
+					out.RawString("null")
+				} else {
+					(*v29).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"pendingApplications\":"
+		out.RawString(prefix)
+		if in.PendingApplications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v30, v31 := range in.PendingApplications {
+				if v30 > 0 {
+					out.RawByte(',')
+				}
+				if v31 == nil {
+					out.RawString("null")
+				} else {
+					(*v31).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"pendingInvites\":"
+		out.RawString(prefix)
+		if in.PendingInvites == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.PendingInvites {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				if v33 == nil {
+					out.RawString("null")
+				} else {
+					(*v33).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlayerClans) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi12(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlayerClans) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi12(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi13(in *jlexer.Lexer, out *PatchedClan) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v34 interface{}
+					if m, ok := v34.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v34.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v34 = in.Interface()
+					}
+					(out.Metadata)[key] = v34
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "allowApplication":
+			out.AllowApplication = bool(in.Bool())
+		case "autoJoin":
+			out.AutoJoin = bool(in.Bool())
+		case "membershipCount":
+			out.MembershipCount = int(in.Int())
+		case "ownerPublicID":
+			out.OwnerPublicID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi13(out *jwriter.Writer, in PatchedClan) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v35First := true
+			for v35Name, v35Value := range in.Metadata {
+				if v35First {
+					v35First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v35Name))
+				out.RawByte(':')
+				if m, ok := v35Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v35Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v35Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"allowApplication\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllowApplication))
+	}
+	{
+		const prefix string = ",\"autoJoin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AutoJoin))
+	}
+	{
+		const prefix string = ",\"membershipCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembershipCount))
+	}
+	{
+		const prefix string = ",\"ownerPublicID\":"
+		out.RawString(prefix)
+		out.String(string(in.OwnerPublicID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PatchedClan) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi13(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PatchedClan) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi13(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi14(in *jlexer.Lexer, out *PatchPlayerResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v36 interface{}
+					if m, ok := v36.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v36.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v36 = in.Interface()
+					}
+					(out.Metadata)[key] = v36
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi14(out *jwriter.Writer, in PatchPlayerResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v37First := true
+			for v37Name, v37Value := range in.Metadata {
+				if v37First {
+					v37First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v37Name))
+				out.RawByte(':')
+				if m, ok := v37Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v37Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v37Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PatchPlayerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi14(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PatchPlayerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi14(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi15(in *jlexer.Lexer, out *PatchClanResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "clan":
+			if in.IsNull() {
+				in.Skip()
+				out.Clan = nil
+			} else {
+				if out.Clan == nil {
+					out.Clan = new(PatchedClan)
+				}
+				(*out.Clan).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi15(out *jwriter.Writer, in PatchClanResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"clan\":"
+		out.RawString(prefix)
+		if in.Clan == nil {
+			out.RawString("null")
+		} else {
+			(*in.Clan).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PatchClanResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi15(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PatchClanResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi15(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi16(in *jlexer.Lexer, out *MembershipRequestor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v38 interface{}
+					if m, ok := v38.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v38.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v38 = in.Interface()
+					}
+					(out.Metadata)[key] = v38
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "level":
+			out.Level = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi16(out *jwriter.Writer, in MembershipRequestor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v39First := true
+			for v39Name, v39Value := range in.Metadata {
+				if v39First {
+					v39First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v39Name))
+				out.RawByte(':')
+				if m, ok := v39Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v39Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v39Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.String(string(in.Level))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MembershipRequestor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi16(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MembershipRequestor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi16(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi17(in *jlexer.Lexer, out *MembershipLevelResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "level":
+			out.Level = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi17(out *jwriter.Writer, in MembershipLevelResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.String(string(in.Level))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MembershipLevelResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi17(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MembershipLevelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi17(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi18(in *jlexer.Lexer, out *MembershipClan) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v40 interface{}
+					if m, ok := v40.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v40.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v40 = in.Interface()
+					}
+					(out.Metadata)[key] = v40
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "membershipCount":
+			out.MembershipCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi18(out *jwriter.Writer, in MembershipClan) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v41First := true
+			for v41Name, v41Value := range in.Metadata {
+				if v41First {
+					v41First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v41Name))
+				out.RawByte(':')
+				if m, ok := v41Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v41Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v41Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"membershipCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembershipCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MembershipClan) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi18(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MembershipClan) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi18(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi19(in *jlexer.Lexer, out *LeaveClanResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "previousOwner":
+			if in.IsNull() {
+				in.Skip()
+				out.PreviousOwner = nil
+			} else {
+				if out.PreviousOwner == nil {
+					out.PreviousOwner = new(PlayerSummary)
+				}
+				(*out.PreviousOwner).UnmarshalEasyJSON(in)
+			}
+		case "newOwner":
+			if in.IsNull() {
+				in.Skip()
+				out.NewOwner = nil
+			} else {
+				if out.NewOwner == nil {
+					out.NewOwner = new(PlayerSummary)
+				}
+				(*out.NewOwner).UnmarshalEasyJSON(in)
+			}
+		case "isDeleted":
+			out.IsDeleted = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi19(out *jwriter.Writer, in LeaveClanResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"previousOwner\":"
+		out.RawString(prefix)
+		if in.PreviousOwner == nil {
+			out.RawString("null")
+		} else {
+			(*in.PreviousOwner).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"newOwner\":"
+		out.RawString(prefix)
+		if in.NewOwner == nil {
+			out.RawString("null")
+		} else {
+			(*in.NewOwner).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"isDeleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDeleted))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LeaveClanResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi19(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LeaveClanResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi19(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi20(in *jlexer.Lexer, out *ExportedPlayer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "gameID":
+			out.GameID = string(in.String())
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v42 interface{}
+					if m, ok := v42.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v42.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v42 = in.Interface()
+					}
+					(out.Metadata)[key] = v42
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "membershipCount":
+			out.MembershipCount = int(in.Int())
+		case "ownershipCount":
+			out.OwnershipCount = int(in.Int())
+		case "createdAt":
+			out.CreatedAt = int64(in.Int64())
+		case "updatedAt":
+			out.UpdatedAt = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi20(out *jwriter.Writer, in ExportedPlayer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"gameID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.GameID))
+	}
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix)
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v43First := true
+			for v43Name, v43Value := range in.Metadata {
+				if v43First {
+					v43First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v43Name))
+				out.RawByte(':')
+				if m, ok := v43Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v43Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v43Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"membershipCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembershipCount))
+	}
+	{
+		const prefix string = ",\"ownershipCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnershipCount))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportedPlayer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi20(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportedPlayer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi20(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi21(in *jlexer.Lexer, out *ExportedMembership) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "clan":
+			if in.IsNull() {
+				in.Skip()
+				out.Clan = nil
+			} else {
+				if out.Clan == nil {
+					out.Clan = new(ClanReference)
+				}
+				(*out.Clan).UnmarshalEasyJSON(in)
+			}
+		case "level":
+			out.Level = string(in.String())
+		case "approved":
+			out.Approved = bool(in.Bool())
+		case "denied":
+			out.Denied = bool(in.Bool())
+		case "banned":
+			out.Banned = bool(in.Bool())
+		case "message":
+			out.Message = string(in.String())
+		case "createdAt":
+			out.CreatedAt = int64(in.Int64())
+		case "updatedAt":
+			out.UpdatedAt = int64(in.Int64())
+		case "approvedAt":
+			out.ApprovedAt = int64(in.Int64())
+		case "deniedAt":
+			out.DeniedAt = int64(in.Int64())
+		case "deletedAt":
+			out.DeletedAt = int64(in.Int64())
+		case "player":
+			out.Player = string(in.String())
+		case "requestor":
+			out.Requestor = string(in.String())
+		case "approver":
+			out.Approver = string(in.String())
+		case "denier":
+			out.Denier = string(in.String())
+		case "deletedBy":
+			out.DeletedBy = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi21(out *jwriter.Writer, in ExportedMembership) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"clan\":"
+		out.RawString(prefix[1:])
+		if in.Clan == nil {
+			out.RawString("null")
+		} else {
+			(*in.Clan).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.String(string(in.Level))
+	}
+	{
+		const prefix string = ",\"approved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Approved))
+	}
+	{
+		const prefix string = ",\"denied\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Denied))
+	}
+	{
+		const prefix string = ",\"banned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Banned))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdatedAt))
+	}
+	{
+		const prefix string = ",\"approvedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ApprovedAt))
+	}
+	{
+		const prefix string = ",\"deniedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DeniedAt))
+	}
+	{
+		const prefix string = ",\"deletedAt\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DeletedAt))
+	}
+	{
+		const prefix string = ",\"player\":"
+		out.RawString(prefix)
+		out.String(string(in.Player))
+	}
+	{
+		const prefix string = ",\"requestor\":"
+		out.RawString(prefix)
+		out.String(string(in.Requestor))
+	}
+	{
+		const prefix string = ",\"approver\":"
+		out.RawString(prefix)
+		out.String(string(in.Approver))
+	}
+	{
+		const prefix string = ",\"denier\":"
+		out.RawString(prefix)
+		out.String(string(in.Denier))
+	}
+	{
+		const prefix string = ",\"deletedBy\":"
+		out.RawString(prefix)
+		out.String(string(in.DeletedBy))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportedMembership) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi21(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportedMembership) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi21(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi22(in *jlexer.Lexer, out *ExportedClan) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "gameID":
+			out.GameID = string(in.String())
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v44 interface{}
+					if m, ok := v44.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v44.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v44 = in.Interface()
+					}
+					(out.Metadata)[key] = v44
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "allowApplication":
+			out.AllowApplication = bool(in.Bool())
+		case "autoJoin":
+			out.AutoJoin = bool(in.Bool())
+		case "membershipCount":
+			out.MembershipCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi22(out *jwriter.Writer, in ExportedClan) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"gameID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.GameID))
+	}
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix)
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v45First := true
+			for v45Name, v45Value := range in.Metadata {
+				if v45First {
+					v45First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v45Name))
+				out.RawByte(':')
+				if m, ok := v45Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v45Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v45Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"allowApplication\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllowApplication))
+	}
+	{
+		const prefix string = ",\"autoJoin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AutoJoin))
+	}
+	{
+		const prefix string = ",\"membershipCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembershipCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportedClan) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi22(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportedClan) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi22(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi23(in *jlexer.Lexer, out *ExportPlayerResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "player":
+			if in.IsNull() {
+				in.Skip()
+				out.Player = nil
+			} else {
+				if out.Player == nil {
+					out.Player = new(ExportedPlayer)
+				}
+				(*out.Player).UnmarshalEasyJSON(in)
+			}
+		case "nameHistory":
+			if in.IsNull() {
+				in.Skip()
+				out.NameHistory = nil
+			} else {
+				in.Delim('[')
+				if out.NameHistory == nil {
+					if !in.IsDelim(']') {
+						out.NameHistory = make([]*PlayerNameChange, 0, 8)
+					} else {
+						out.NameHistory = []*PlayerNameChange{}
+					}
+				} else {
+					out.NameHistory = (out.NameHistory)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 *PlayerNameChange
+					if in.IsNull() {
+						in.Skip()
+						v46 = nil
+					} else {
+						if v46 == nil {
+							v46 = new(PlayerNameChange)
+						}
+						(*v46).UnmarshalEasyJSON(in)
+					}
+					out.NameHistory = append(out.NameHistory, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "memberships":
+			if in.IsNull() {
+				in.Skip()
+				out.Memberships = nil
+			} else {
+				in.Delim('[')
+				if out.Memberships == nil {
+					if !in.IsDelim(']') {
+						out.Memberships = make([]*ExportedMembership, 0, 8)
+					} else {
+						out.Memberships = []*ExportedMembership{}
+					}
+				} else {
+					out.Memberships = (out.Memberships)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v47 *ExportedMembership
+					if in.IsNull() {
+						in.Skip()
+						v47 = nil
+					} else {
+						if v47 == nil {
+							v47 = new(ExportedMembership)
+						}
+						(*v47).UnmarshalEasyJSON(in)
+					}
+					out.Memberships = append(out.Memberships, v47)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ownedClans":
+			if in.IsNull() {
+				in.Skip()
+				out.OwnedClans = nil
+			} else {
+				in.Delim('[')
+				if out.OwnedClans == nil {
+					if !in.IsDelim(']') {
+						out.OwnedClans = make([]*ExportedClan, 0, 8)
+					} else {
+						out.OwnedClans = []*ExportedClan{}
+					}
+				} else {
+					out.OwnedClans = (out.OwnedClans)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v48 *ExportedClan
+					if in.IsNull() {
+						in.Skip()
+						v48 = nil
+					} else {
+						if v48 == nil {
+							v48 = new(ExportedClan)
+						}
+						(*v48).UnmarshalEasyJSON(in)
+					}
+					out.OwnedClans = append(out.OwnedClans, v48)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "membershipsActions":
+			if in.IsNull() {
+				in.Skip()
+				out.MembershipsActions = nil
+			} else {
+				in.Delim('[')
+				if out.MembershipsActions == nil {
+					if !in.IsDelim(']') {
+						out.MembershipsActions = make([]*ExportedMembership, 0, 8)
+					} else {
+						out.MembershipsActions = []*ExportedMembership{}
+					}
+				} else {
+					out.MembershipsActions = (out.MembershipsActions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 *ExportedMembership
+					if in.IsNull() {
+						in.Skip()
+						v49 = nil
+					} else {
+						if v49 == nil {
+							v49 = new(ExportedMembership)
+						}
+						(*v49).UnmarshalEasyJSON(in)
+					}
+					out.MembershipsActions = append(out.MembershipsActions, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi23(out *jwriter.Writer, in ExportPlayerResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"player\":"
+		out.RawString(prefix)
+		if in.Player == nil {
+			out.RawString("null")
+		} else {
+			(*in.Player).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"nameHistory\":"
+		out.RawString(prefix)
+		if in.NameHistory == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v50, v51 := range in.NameHistory {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				if v51 == nil {
+					out.RawString("null")
+				} else {
+					(*v51).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"memberships\":"
+		out.RawString(prefix)
+		if in.Memberships == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v52, v53 := range in.Memberships {
+				if v52 > 0 {
+					out.RawByte(',')
+				}
+				if v53 == nil {
+					out.RawString("null")
+				} else {
+					(*v53).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ownedClans\":"
+		out.RawString(prefix)
+		if in.OwnedClans == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v54, v55 := range in.OwnedClans {
+				if v54 > 0 {
+					out.RawByte(',')
+				}
+				if v55 == nil {
+					out.RawString("null")
+				} else {
+					(*v55).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"membershipsActions\":"
+		out.RawString(prefix)
+		if in.MembershipsActions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v56, v57 := range in.MembershipsActions {
+				if v56 > 0 {
+					out.RawByte(',')
+				}
+				if v57 == nil {
+					out.RawString("null")
+				} else {
+					(*v57).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportPlayerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi23(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportPlayerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi23(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi24(in *jlexer.Lexer, out *DeletedClan) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "newOwner":
+			if in.IsNull() {
+				in.Skip()
+				out.NewOwner = nil
+			} else {
+				if out.NewOwner == nil {
+					out.NewOwner = new(string)
+				}
+				*out.NewOwner = string(in.String())
+			}
+		case "isDeleted":
+			out.IsDeleted = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi24(out *jwriter.Writer, in DeletedClan) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"newOwner\":"
+		out.RawString(prefix)
+		if in.NewOwner == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.NewOwner))
+		}
+	}
+	{
+		const prefix string = ",\"isDeleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDeleted))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeletedClan) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi24(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeletedClan) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi24(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi25(in *jlexer.Lexer, out *DeletePlayerResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "clans":
+			if in.IsNull() {
+				in.Skip()
+				out.Clans = nil
+			} else {
+				in.Delim('[')
+				if out.Clans == nil {
+					if !in.IsDelim(']') {
+						out.Clans = make([]*DeletedClan, 0, 8)
+					} else {
+						out.Clans = []*DeletedClan{}
+					}
+				} else {
+					out.Clans = (out.Clans)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 *DeletedClan
+					if in.IsNull() {
+						in.Skip()
+						v58 = nil
+					} else {
+						if v58 == nil {
+							v58 = new(DeletedClan)
+						}
+						(*v58).UnmarshalEasyJSON(in)
+					}
+					out.Clans = append(out.Clans, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi25(out *jwriter.Writer, in DeletePlayerResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"clans\":"
+		out.RawString(prefix)
+		if in.Clans == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v59, v60 := range in.Clans {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				if v60 == nil {
+					out.RawString("null")
+				} else {
+					(*v60).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeletePlayerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi25(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeletePlayerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi25(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi26(in *jlexer.Lexer, out *CreatePlayerResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "gameID":
+			out.GameID = string(in.String())
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v61 interface{}
+					if m, ok := v61.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v61.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v61 = in.Interface()
+					}
+					(out.Metadata)[key] = v61
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi26(out *jwriter.Writer, in CreatePlayerResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"gameID\":"
+		out.RawString(prefix)
+		out.String(string(in.GameID))
+	}
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix)
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v62First := true
+			for v62Name, v62Value := range in.Metadata {
+				if v62First {
+					v62First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v62Name))
+				out.RawByte(':')
+				if m, ok := v62Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v62Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v62Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreatePlayerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi26(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreatePlayerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi26(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi27(in *jlexer.Lexer, out *ClansSummariesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "clans":
+			if in.IsNull() {
+				in.Skip()
+				out.Clans = nil
+			} else {
+				in.Delim('[')
+				if out.Clans == nil {
+					if !in.IsDelim(']') {
+						out.Clans = make([]*ClanSummary, 0, 8)
+					} else {
+						out.Clans = []*ClanSummary{}
+					}
+				} else {
+					out.Clans = (out.Clans)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v63 *ClanSummary
+					if in.IsNull() {
+						in.Skip()
+						v63 = nil
+					} else {
+						if v63 == nil {
+							v63 = new(ClanSummary)
+						}
+						(*v63).UnmarshalEasyJSON(in)
+					}
+					out.Clans = append(out.Clans, v63)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "missingClans":
+			if in.IsNull() {
+				in.Skip()
+				out.MissingClans = nil
+			} else {
+				in.Delim('[')
+				if out.MissingClans == nil {
+					if !in.IsDelim(']') {
+						out.MissingClans = make([]string, 0, 4)
+					} else {
+						out.MissingClans = []string{}
+					}
+				} else {
+					out.MissingClans = (out.MissingClans)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v64 string
+					v64 = string(in.String())
+					out.MissingClans = append(out.MissingClans, v64)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi27(out *jwriter.Writer, in ClansSummariesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"clans\":"
+		out.RawString(prefix)
+		if in.Clans == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v65, v66 := range in.Clans {
+				if v65 > 0 {
+					out.RawByte(',')
+				}
+				if v66 == nil {
+					out.RawString("null")
+				} else {
+					(*v66).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.MissingClans) != 0 {
+		const prefix string = ",\"missingClans\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v67, v68 := range in.MissingClans {
+				if v67 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v68))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClansSummariesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi27(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClansSummariesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi27(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi28(in *jlexer.Lexer, out *ClansResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "clans":
+			if in.IsNull() {
+				in.Skip()
+				out.Clans = nil
+			} else {
+				in.Delim('[')
+				if out.Clans == nil {
+					if !in.IsDelim(']') {
+						out.Clans = make([]*ClanSummary, 0, 8)
+					} else {
+						out.Clans = []*ClanSummary{}
+					}
+				} else {
+					out.Clans = (out.Clans)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v69 *ClanSummary
+					if in.IsNull() {
+						in.Skip()
+						v69 = nil
+					} else {
+						if v69 == nil {
+							v69 = new(ClanSummary)
+						}
+						(*v69).UnmarshalEasyJSON(in)
+					}
+					out.Clans = append(out.Clans, v69)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi28(out *jwriter.Writer, in ClansResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"clans\":"
+		out.RawString(prefix)
+		if in.Clans == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v70, v71 := range in.Clans {
+				if v70 > 0 {
+					out.RawByte(',')
+				}
+				if v71 == nil {
+					out.RawString("null")
+				} else {
+					(*v71).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClansResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi28(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClansResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi28(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi29(in *jlexer.Lexer, out *ClanSummaryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v72 interface{}
+					if m, ok := v72.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v72.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v72 = in.Interface()
+					}
+					(out.Metadata)[key] = v72
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "allowApplication":
+			out.AllowApplication = bool(in.Bool())
+		case "autoJoin":
+			out.AutoJoin = bool(in.Bool())
+		case "membershipCount":
+			out.MembershipCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi29(out *jwriter.Writer, in ClanSummaryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix)
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v73First := true
+			for v73Name, v73Value := range in.Metadata {
+				if v73First {
+					v73First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v73Name))
+				out.RawByte(':')
+				if m, ok := v73Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v73Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v73Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"allowApplication\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllowApplication))
+	}
+	{
+		const prefix string = ",\"autoJoin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AutoJoin))
+	}
+	{
+		const prefix string = ",\"membershipCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembershipCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClanSummaryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi29(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClanSummaryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi29(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi30(in *jlexer.Lexer, out *ClanSummary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v74 interface{}
+					if m, ok := v74.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v74.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v74 = in.Interface()
+					}
+					(out.Metadata)[key] = v74
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "allowApplication":
+			out.AllowApplication = bool(in.Bool())
+		case "autoJoin":
+			out.AutoJoin = bool(in.Bool())
+		case "membershipCount":
+			out.MembershipCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi30(out *jwriter.Writer, in ClanSummary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v75First := true
+			for v75Name, v75Value := range in.Metadata {
+				if v75First {
+					v75First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v75Name))
+				out.RawByte(':')
+				if m, ok := v75Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v75Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v75Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"allowApplication\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllowApplication))
+	}
+	{
+		const prefix string = ",\"autoJoin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AutoJoin))
+	}
+	{
+		const prefix string = ",\"membershipCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembershipCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClanSummary) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi30(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClanSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi30(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi31(in *jlexer.Lexer, out *ClanSuggestionsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "clans":
+			if in.IsNull() {
+				in.Skip()
+				out.Clans = nil
+			} else {
+				in.Delim('[')
+				if out.Clans == nil {
+					if !in.IsDelim(']') {
+						out.Clans = make([]*ClanReference, 0, 8)
+					} else {
+						out.Clans = []*ClanReference{}
+					}
+				} else {
+					out.Clans = (out.Clans)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v76 *ClanReference
+					if in.IsNull() {
+						in.Skip()
+						v76 = nil
+					} else {
+						if v76 == nil {
+							v76 = new(ClanReference)
+						}
+						(*v76).UnmarshalEasyJSON(in)
+					}
+					out.Clans = append(out.Clans, v76)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi31(out *jwriter.Writer, in ClanSuggestionsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"clans\":"
+		out.RawString(prefix)
+		if in.Clans == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v77, v78 := range in.Clans {
+				if v77 > 0 {
+					out.RawByte(',')
+				}
+				if v78 == nil {
+					out.RawString("null")
+				} else {
+					(*v78).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClanSuggestionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi31(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClanSuggestionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi31(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi32(in *jlexer.Lexer, out *ClanResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v79 interface{}
+					if m, ok := v79.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v79.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v79 = in.Interface()
+					}
+					(out.Metadata)[key] = v79
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "allowApplication":
+			out.AllowApplication = bool(in.Bool())
+		case "autoJoin":
+			out.AutoJoin = bool(in.Bool())
+		case "membershipCount":
+			out.MembershipCount = int(in.Int())
+		case "version":
+			out.Version = int64(in.Int64())
+		case "owner":
+			if in.IsNull() {
+				in.Skip()
+				out.Owner = nil
+			} else {
+				if out.Owner == nil {
+					out.Owner = new(PlayerProfile)
+				}
+				(*out.Owner).UnmarshalEasyJSON(in)
+			}
+		case "roster":
+			if in.IsNull() {
+				in.Skip()
+				out.Roster = nil
+			} else {
+				in.Delim('[')
+				if out.Roster == nil {
+					if !in.IsDelim(']') {
+						out.Roster = make([]*ClanMember, 0, 8)
+					} else {
+						out.Roster = []*ClanMember{}
+					}
+				} else {
+					out.Roster = (out.Roster)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v80 *ClanMember
+					if in.IsNull() {
+						in.Skip()
+						v80 = nil
+					} else {
+						if v80 == nil {
+							v80 = new(ClanMember)
+						}
+						(*v80).UnmarshalEasyJSON(in)
+					}
+					out.Roster = append(out.Roster, v80)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "memberships":
+			if in.IsNull() {
+				in.Skip()
+				out.Memberships = nil
+			} else {
+				if out.Memberships == nil {
+					out.Memberships = new(ClanMemberships)
+				}
+				(*out.Memberships).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi32(out *jwriter.Writer, in ClanResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix)
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v81First := true
+			for v81Name, v81Value := range in.Metadata {
+				if v81First {
+					v81First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v81Name))
+				out.RawByte(':')
+				if m, ok := v81Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v81Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v81Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"allowApplication\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllowApplication))
+	}
+	{
+		const prefix string = ",\"autoJoin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AutoJoin))
+	}
+	{
+		const prefix string = ",\"membershipCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.MembershipCount))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Version))
+	}
+	{
+		const prefix string = ",\"owner\":"
+		out.RawString(prefix)
+		if in.Owner == nil {
+			out.RawString("null")
+		} else {
+			(*in.Owner).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"roster\":"
+		out.RawString(prefix)
+		if in.Roster == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v82, v83 := range in.Roster {
+				if v82 > 0 {
+					out.RawByte(',')
+				}
+				if v83 == nil {
+					out.RawString("null")
+				} else {
+					(*v83).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"memberships\":"
+		out.RawString(prefix)
+		if in.Memberships == nil {
+			out.RawString("null")
+		} else {
+			(*in.Memberships).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClanResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi32(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClanResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi32(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi33(in *jlexer.Lexer, out *ClanReference) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi33(out *jwriter.Writer, in ClanReference) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClanReference) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi33(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClanReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi33(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi34(in *jlexer.Lexer, out *ClanMemberships) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pendingApplications":
+			if in.IsNull() {
+				in.Skip()
+				out.PendingApplications = nil
+			} else {
+				in.Delim('[')
+				if out.PendingApplications == nil {
+					if !in.IsDelim(']') {
+						out.PendingApplications = make([]*ClanMember, 0, 8)
+					} else {
+						out.PendingApplications = []*ClanMember{}
+					}
+				} else {
+					out.PendingApplications = (out.PendingApplications)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v84 *ClanMember
+					if in.IsNull() {
+						in.Skip()
+						v84 = nil
+					} else {
+						if v84 == nil {
+							v84 = new(ClanMember)
+						}
+						(*v84).UnmarshalEasyJSON(in)
+					}
+					out.PendingApplications = append(out.PendingApplications, v84)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "pendingInvites":
+			if in.IsNull() {
+				in.Skip()
+				out.PendingInvites = nil
+			} else {
+				in.Delim('[')
+				if out.PendingInvites == nil {
+					if !in.IsDelim(']') {
+						out.PendingInvites = make([]*ClanMember, 0, 8)
+					} else {
+						out.PendingInvites = []*ClanMember{}
+					}
+				} else {
+					out.PendingInvites = (out.PendingInvites)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v85 *ClanMember
+					if in.IsNull() {
+						in.Skip()
+						v85 = nil
+					} else {
+						if v85 == nil {
+							v85 = new(ClanMember)
+						}
+						(*v85).UnmarshalEasyJSON(in)
+					}
+					out.PendingInvites = append(out.PendingInvites, v85)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "banned":
+			if in.IsNull() {
+				in.Skip()
+				out.Banned = nil
+			} else {
+				in.Delim('[')
+				if out.Banned == nil {
+					if !in.IsDelim(']') {
+						out.Banned = make([]*ClanMember, 0, 8)
+					} else {
+						out.Banned = []*ClanMember{}
+					}
+				} else {
+					out.Banned = (out.Banned)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v86 *ClanMember
+					if in.IsNull() {
+						in.Skip()
+						v86 = nil
+					} else {
+						if v86 == nil {
+							v86 = new(ClanMember)
+						}
+						(*v86).UnmarshalEasyJSON(in)
+					}
+					out.Banned = append(out.Banned, v86)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "denied":
+			if in.IsNull() {
+				in.Skip()
+				out.Denied = nil
+			} else {
+				in.Delim('[')
+				if out.Denied == nil {
+					if !in.IsDelim(']') {
+						out.Denied = make([]*ClanMember, 0, 8)
+					} else {
+						out.Denied = []*ClanMember{}
+					}
+				} else {
+					out.Denied = (out.Denied)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v87 *ClanMember
+					if in.IsNull() {
+						in.Skip()
+						v87 = nil
+					} else {
+						if v87 == nil {
+							v87 = new(ClanMember)
+						}
+						(*v87).UnmarshalEasyJSON(in)
+					}
+					out.Denied = append(out.Denied, v87)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi34(out *jwriter.Writer, in ClanMemberships) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pendingApplications\":"
+		out.RawString(prefix[1:])
+		if in.PendingApplications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v88, v89 := range in.PendingApplications {
+				if v88 > 0 {
+					out.RawByte(',')
+				}
+				if v89 == nil {
+					out.RawString("null")
+				} else {
+					(*v89).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"pendingInvites\":"
+		out.RawString(prefix)
+		if in.PendingInvites == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v90, v91 := range in.PendingInvites {
+				if v90 > 0 {
+					out.RawByte(',')
+				}
+				if v91 == nil {
+					out.RawString("null")
+				} else {
+					(*v91).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"banned\":"
+		out.RawString(prefix)
+		if in.Banned == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v92, v93 := range in.Banned {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				if v93 == nil {
+					out.RawString("null")
+				} else {
+					(*v93).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"denied\":"
+		out.RawString(prefix)
+		if in.Denied == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v94, v95 := range in.Denied {
+				if v94 > 0 {
+					out.RawByte(',')
+				}
+				if v95 == nil {
+					out.RawString("null")
+				} else {
+					(*v95).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClanMemberships) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi34(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClanMemberships) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi34(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi35(in *jlexer.Lexer, out *ClanMembersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else {
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]string, 0, 4)
+					} else {
+						out.Members = []string{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v96 string
+					v96 = string(in.String())
+					out.Members = append(out.Members, v96)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi35(out *jwriter.Writer, in ClanMembersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"members\":"
+		out.RawString(prefix)
+		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v97, v98 := range in.Members {
+				if v97 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v98))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClanMembersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi35(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClanMembersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi35(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi36(in *jlexer.Lexer, out *ClanMemberPlayer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publicID":
+			out.PublicID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v99 interface{}
+					if m, ok := v99.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v99.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v99 = in.Interface()
+					}
+					(out.Metadata)[key] = v99
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "approver":
+			if in.IsNull() {
+				in.Skip()
+				out.Approver = nil
+			} else {
+				if out.Approver == nil {
+					out.Approver = new(PlayerReference)
+				}
+				(*out.Approver).UnmarshalEasyJSON(in)
+			}
+		case "denier":
+			if in.IsNull() {
+				in.Skip()
+				out.Denier = nil
+			} else {
+				if out.Denier == nil {
+					out.Denier = new(PlayerReference)
+				}
+				(*out.Denier).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi36(out *jwriter.Writer, in ClanMemberPlayer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publicID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PublicID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v100First := true
+			for v100Name, v100Value := range in.Metadata {
+				if v100First {
+					v100First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v100Name))
+				out.RawByte(':')
+				if m, ok := v100Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v100Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v100Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.Approver != nil {
+		const prefix string = ",\"approver\":"
+		out.RawString(prefix)
+		(*in.Approver).MarshalEasyJSON(out)
+	}
+	if in.Denier != nil {
+		const prefix string = ",\"denier\":"
+		out.RawString(prefix)
+		(*in.Denier).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClanMemberPlayer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi36(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClanMemberPlayer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi36(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi37(in *jlexer.Lexer, out *ClanMember) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "player":
+			if in.IsNull() {
+				in.Skip()
+				out.Player = nil
+			} else {
+				if out.Player == nil {
+					out.Player = new(ClanMemberPlayer)
+				}
+				(*out.Player).UnmarshalEasyJSON(in)
+			}
+		case "level":
+			out.Level = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi37(out *jwriter.Writer, in ClanMember) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"player\":"
+		out.RawString(prefix[1:])
+		if in.Player == nil {
+			out.RawString("null")
+		} else {
+			(*in.Player).MarshalEasyJSON(out)
+		}
+	}
+	if in.Level != "" {
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.String(string(in.Level))
+	}
+	if in.Message != "" {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ClanMember) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi37(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ClanMember) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi37(l, v)
+}
+func easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi38(in *jlexer.Lexer, out *ApplyForMembershipResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "success":
+			out.Success = bool(in.Bool())
+		case "approved":
+			out.Approved = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi38(out *jwriter.Writer, in ApplyForMembershipResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Success))
+	}
+	{
+		const prefix string = ",\"approved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Approved))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ApplyForMembershipResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeGithubComTopfreegamesKhanApi38(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ApplyForMembershipResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeGithubComTopfreegamesKhanApi38(l, v)
+}
@@ -0,0 +1,116 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api
+
+import (
+	"net"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/jrallison/go-workers"
+	"github.com/labstack/echo/engine/standard"
+	"github.com/topfreegames/khan/log"
+	"github.com/uber-go/zap"
+	"google.golang.org/grpc"
+)
+
+// waitForShutdownSignal blocks until the process receives a signal to stop
+func waitForShutdownSignal() os.Signal {
+	sg := make(chan os.Signal, 1)
+	signal.Notify(sg, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM)
+	return <-sg
+}
+
+// gracePeriod is how long requests and jobs in progress have to finish once the app is stopping
+func (app *App) gracePeriod() time.Duration {
+	return time.Duration(app.Config.GetInt("graceperiod.ms")) * time.Millisecond
+}
+
+// isShuttingDown returns whether the app is stopping, in which case it is no longer ready for requests
+func (app *App) isShuttingDown() bool {
+	return atomic.LoadInt32(&app.shuttingDown) == 1
+}
+
+// shutdown stops the app gracefully. The readiness route starts failing, so that load balancers stop sending
+// requests, and after "graceperiod.readinessDelay" the HTTP and gRPC servers stop accepting connections. The
+// requests in progress then have up to the grace period to finish, before the connections to the dependencies
+// are closed. grpcServer is nil if gRPC is disabled
+func (app *App) shutdown(listener net.Listener, grpcServer *grpc.Server) {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "shutdown"),
+	)
+
+	atomic.StoreInt32(&app.shuttingDown, 1)
+	time.Sleep(app.Config.GetDuration("graceperiod.readinessDelay"))
+
+	log.D(logger, "Closing listeners...")
+	deadline := time.Now().Add(app.gracePeriod())
+	listener.Close()
+	if server, ok := app.Engine.(*standard.Server); ok {
+		server.SetKeepAlivesEnabled(false)
+	}
+	grpcStopped := make(chan struct{})
+	if grpcServer != nil {
+		go func() {
+			grpcServer.GracefulStop()
+			close(grpcStopped)
+		}()
+	} else {
+		close(grpcStopped)
+	}
+
+	log.D(logger, "Waiting for requests in progress...")
+	for atomic.LoadInt64(&app.inFlight) > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case <-grpcStopped:
+	case <-time.After(time.Until(deadline)):
+		if grpcServer != nil {
+			grpcServer.Stop()
+		}
+	}
+	if inFlight := atomic.LoadInt64(&app.inFlight); inFlight > 0 {
+		log.W(logger, "Grace period is over, cutting requests in progress off.", func(cm log.CM) {
+			cm.Write(zap.Int64("inFlight", inFlight))
+		})
+	} else {
+		log.I(logger, "Requests in progress finished.")
+	}
+
+	app.finalizeApp()
+}
+
+// stopWorkers stops fetching jobs and waits up to the grace period for the jobs in progress to finish, before
+// the connections to the dependencies are closed. Jobs still running after the grace period are cut off
+func (app *App) stopWorkers() {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "stopWorkers"),
+	)
+
+	atomic.StoreInt32(&app.shuttingDown, 1)
+	stopped := make(chan struct{})
+	go func() {
+		workers.Quit()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		log.I(logger, "Jobs in progress finished.")
+	case <-time.After(app.gracePeriod()):
+		log.W(logger, "Grace period is over, cutting jobs in progress off.")
+	}
+
+	app.finalizeApp()
+}
@@ -8,23 +8,73 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
+	"github.com/garyburd/redigo/redis"
+	workers "github.com/jrallison/go-workers"
 	"github.com/labstack/echo"
+	"github.com/topfreegames/khan/log"
+	"github.com/topfreegames/khan/queues"
+	"github.com/uber-go/zap"
 )
 
+// statusQueues are the worker queues whose depths are reported by the status route
+var statusQueues = []string{
+	queues.KhanQueue, queues.KhanESQueue, queues.KhanMongoQueue, queues.KhanGameDeletionQueue, queues.KhanPlayerBackfillQueue,
+}
+
 //StatusHandler is the handler responsible for reporting khan status
 func StatusHandler(app *App) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		c.Set("route", "Status")
-		payload := map[string]interface{}{
-			"app": map[string]interface{}{
-				"errorRate": app.Errors.Rate(),
-			},
-		}
+		payload := khanStatus(c.StdContext(), app)
 
 		payloadJSON, _ := json.Marshal(payload)
 		return c.String(http.StatusOK, string(payloadJSON))
 	}
 }
+
+// khanStatus returns the error rate of the app and the depths of its worker queues. If the depths cannot be read
+// from Redis within the healthcheck timeout, the queues are reported as unavailable instead
+func khanStatus(ctx context.Context, app *App) map[string]interface{} {
+	ctx, cancel := context.WithTimeout(ctx, app.Config.GetDuration("healthcheck.timeout"))
+	defer cancel()
+
+	status := map[string]interface{}{
+		"app": map[string]interface{}{
+			"errorRate": app.Errors.Rate(),
+		},
+		"queuesAvailable": true,
+	}
+	depths, err := queueDepths(ctx, statusQueues)
+	if err != nil {
+		log.E(app.Logger, "Error retrieving queue depths.", func(cm log.CM) {
+			cm.Write(zap.String("source", "status"), zap.Error(err))
+		})
+		depths = map[string]int{}
+		status["queuesAvailable"] = false
+		status["queuesError"] = err.Error()
+	}
+	status["queues"] = depths
+	return status
+}
+
+// queueDepths returns the number of jobs waiting in each worker queue, by name
+func queueDepths(ctx context.Context, names []string) (map[string]int, error) {
+	if workers.Config == nil {
+		return nil, fmt.Errorf("workers are not configured")
+	}
+
+	depths := map[string]int{}
+	for _, name := range names {
+		depth, err := redis.Int(doRedis(ctx, workers.Config.Pool, "LLEN", fmt.Sprintf("%squeue:%s", workers.Config.Namespace, name)))
+		if err != nil {
+			return nil, err
+		}
+		depths[name] = depth
+	}
+	return depths, nil
+}
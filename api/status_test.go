@@ -10,6 +10,7 @@ package api_test
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -29,6 +30,30 @@ var _ = Describe("Status API Handler", func() {
 			Expect(result["app"]).NotTo(BeEquivalentTo(nil))
 			app := result["app"].(map[string]interface{})
 			Expect(app["errorRate"]).To(Equal(0.0))
+			Expect(result["queuesAvailable"]).To(BeTrue())
+
+			queues := result["queues"].(map[string]interface{})
+			Expect(queues).To(HaveKey("khan_webhooks"))
+			Expect(queues).To(HaveKey("khan_es_updater"))
+			Expect(queues).To(HaveKey("khan_mongo_updater"))
+			Expect(queues).To(HaveKey("khan_game_deleter"))
+			Expect(queues).To(HaveKey("khan_player_backfiller"))
+		})
+
+		It("Should respond with the queues unavailable if Redis times out", func() {
+			a := GetDefaultTestApp()
+			a.Config.Set("healthcheck.timeout", time.Nanosecond)
+			status, body := Get(a, "/status")
+
+			Expect(status).To(Equal(http.StatusOK))
+
+			var result map[string]interface{}
+			json.Unmarshal([]byte(body), &result)
+
+			Expect(result["app"]).NotTo(BeEquivalentTo(nil))
+			Expect(result["queuesAvailable"]).To(BeFalse())
+			Expect(result["queuesError"]).NotTo(BeEmpty())
+			Expect(result["queues"]).To(BeEmpty())
 		})
 
 		It("Should respond with 401 Unauthorized", func() {
@@ -0,0 +1,230 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo"
+	"github.com/mailru/easyjson/jwriter"
+	"github.com/topfreegames/khan/log"
+	"github.com/uber-go/zap"
+)
+
+// V2Prefix is the prefix of the routes of the v2 API
+const V2Prefix = "/v2"
+
+// v2Responses maps the routes served by the v2 API, by method and v1 path, to the types of their responses in
+// the OpenAPI document. The game, hook and API key routes are left out of v2 and only respond with v1 payloads
+var v2Responses = map[string]interface{}{
+	"POST /games/:gameID/players":                                             CreatePlayerResponse{},
+	"PUT /games/:gameID/players":                                              UpsertPlayersResponse{},
+	"PUT /games/:gameID/players/:playerPublicID":                              SuccessResponse{},
+	"PATCH /games/:gameID/players/:playerPublicID":                            PatchPlayerResponse{},
+	"GET /games/:gameID/players/:playerPublicID":                              PlayerResponse{},
+	"DELETE /games/:gameID/players/:playerPublicID":                           DeletePlayerResponse{},
+	"GET /games/:gameID/players/:playerPublicID/export":                       ExportPlayerResponse{},
+	"GET /games/:gameID/players/:playerPublicID/name-history":                 PlayerNameHistoryResponse{},
+	"GET /games/:gameID/clans/search":                                         ClansResponse{},
+	"GET /games/:gameID/clans/suggest":                                        ClanSuggestionsResponse{},
+	"GET /games/:gameID/clans":                                                ClansResponse{},
+	"POST /games/:gameID/clans":                                               PublicIDResponse{},
+	"GET /games/:gameID/clans-summary":                                        ClansSummariesResponse{},
+	"GET /games/:gameID/clans/:clanPublicID":                                  ClanResponse{},
+	"GET /games/:gameID/clans/:clanPublicID/members":                          ClanMembersResponse{},
+	"GET /games/:gameID/clans/:clanPublicID/summary":                          ClanSummaryResponse{},
+	"PUT /games/:gameID/clans/:clanPublicID":                                  SuccessResponse{},
+	"PATCH /games/:gameID/clans/:clanPublicID":                                PatchClanResponse{},
+	"POST /games/:gameID/clans/:clanPublicID/leave":                           LeaveClanResponse{},
+	"POST /games/:gameID/clans/:clanPublicID/transfer-ownership":              TransferClanOwnershipResponse{},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/application":         ApplyForMembershipResponse{},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/application/:action": SuccessResponse{},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/invitation":          SuccessResponse{},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/invitation/:action":  SuccessResponse{},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/delete":              SuccessResponse{},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/promote":             MembershipLevelResponse{},
+	"POST /games/:gameID/clans/:clanPublicID/memberships/demote":              MembershipLevelResponse{},
+}
+
+// routePath returns the path of the route of the request without the version prefix, so that v1 and v2
+// requests share the same API key scopes, player token rules and rate limits
+func routePath(c echo.Context) string {
+	return strings.TrimPrefix(c.Path(), V2Prefix)
+}
+
+// addV2Route adds the handler of a v1 route to the v2 group, responding with the type of the route in v2Responses
+func (app *App) addV2Route(v2 *echo.Group, method, path string, handler echo.HandlerFunc) {
+	if _, ok := v2Responses[fmt.Sprintf("%s %s", method, path)]; !ok {
+		panic(fmt.Sprintf("%s %s has no v2 response type", method, path))
+	}
+	v2.Match([]string{method}, path, NewTypedResponseHandler(app, handler))
+}
+
+// v2RouteKey is the context key the app serving a v2 route is stored at
+const v2RouteKey = "v2Route"
+
+// NewTypedResponseHandler returns a handler that serves a v2 route with the handler of its v1 route, which
+// responds with the typed response it builds for v2 routes instead of its v1 payload, see SucceedWithTyped
+func NewTypedResponseHandler(app *App, handler echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Set(v2RouteKey, app)
+		return handler(c)
+	}
+}
+
+// v2App returns the app serving the v2 route of the request, or nil if the request is not to a v2 route
+func v2App(c echo.Context) *App {
+	app, _ := c.Get(v2RouteKey).(*App)
+	return app
+}
+
+// typedResponse builds the typed response of a v2 route
+type typedResponse func() (EasyJSONMarshaler, error)
+
+// successResponse is the typed response of the v2 routes that only report success
+func successResponse() (EasyJSONMarshaler, error) {
+	return &SuccessResponse{Success: true}, nil
+}
+
+// SucceedWithTyped sends payload to user with status 200 or, for v2 routes, the response built by typed,
+// failing with status 500 if the response cannot be built
+func SucceedWithTyped(payload map[string]interface{}, typed typedResponse, c echo.Context) error {
+	app := v2App(c)
+	if app == nil {
+		return SucceedWith(payload, c)
+	}
+
+	response, err := typed()
+	if err != nil {
+		log.E(app.Logger, "Response does not match its v2 type.", func(cm log.CM) {
+			cm.Write(
+				zap.String("source", "v2"),
+				zap.String("route", c.Path()),
+				zap.Error(err),
+			)
+		})
+		return FailWith(http.StatusInternalServerError, fmt.Sprintf("Response does not match its v2 type: %s", err), c)
+	}
+
+	writer := jwriter.Writer{}
+	response.MarshalEasyJSON(&writer)
+	body, err := writer.BuildBytes()
+	if err != nil {
+		return FailWith(http.StatusInternalServerError, err.Error(), c)
+	}
+	return c.JSONBlob(http.StatusOK, body)
+}
+
+// payloadReader reads the payloads of the operations into typed responses. Payloads read back from the caches
+// hold JSON types, such as float64 numbers and []interface{} lists. The first value that does not have the type
+// of its field is kept in err, so that the response fails instead of being sent with a zero value. Missing and
+// null values are read as zero values
+type payloadReader struct {
+	err error
+}
+
+func (r *payloadReader) fail(key string, value interface{}, kind string) {
+	if r.err == nil {
+		r.err = fmt.Errorf("%s: %T is not a %s", key, value, kind)
+	}
+}
+
+func (r *payloadReader) str(payload map[string]interface{}, key string) string {
+	switch value := payload[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		r.fail(key, value, "string")
+		return ""
+	}
+}
+
+func (r *payloadReader) boolean(payload map[string]interface{}, key string) bool {
+	switch value := payload[key].(type) {
+	case nil:
+		return false
+	case bool:
+		return value
+	default:
+		r.fail(key, value, "bool")
+		return false
+	}
+}
+
+func (r *payloadReader) integer(payload map[string]interface{}, key string) int64 {
+	switch value := payload[key].(type) {
+	case nil:
+		return 0
+	case int:
+		return int64(value)
+	case int32:
+		return int64(value)
+	case int64:
+		return value
+	case float64:
+		if value == math.Trunc(value) {
+			return int64(value)
+		}
+	}
+	r.fail(key, payload[key], "integer")
+	return 0
+}
+
+func (r *payloadReader) object(payload map[string]interface{}, key string) map[string]interface{} {
+	switch value := payload[key].(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		return value
+	default:
+		r.fail(key, value, "object")
+		return nil
+	}
+}
+
+// objects reads a list of objects. Null items are read as nil
+func (r *payloadReader) objects(payload map[string]interface{}, key string) []map[string]interface{} {
+	switch value := payload[key].(type) {
+	case nil:
+		return nil
+	case []map[string]interface{}:
+		return value
+	case []interface{}:
+		items := make([]map[string]interface{}, len(value))
+		for i, item := range value {
+			items[i] = r.object(map[string]interface{}{key: item}, key)
+		}
+		return items
+	default:
+		r.fail(key, value, "list of objects")
+		return nil
+	}
+}
+
+func (r *payloadReader) strs(payload map[string]interface{}, key string) []string {
+	switch value := payload[key].(type) {
+	case nil:
+		return nil
+	case []string:
+		return value
+	case []interface{}:
+		items := make([]string, len(value))
+		for i, item := range value {
+			items[i] = r.str(map[string]interface{}{key: item}, key)
+		}
+		return items
+	default:
+		r.fail(key, value, "list of strings")
+		return nil
+	}
+}
@@ -0,0 +1,135 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/khan/api"
+	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+var _ = Describe("V2 API", func() {
+	var testDb models.DB
+	var a *api.App
+
+	BeforeEach(func() {
+		var err error
+		testDb, err = GetTestDB()
+		Expect(err).NotTo(HaveOccurred())
+
+		a = GetDefaultTestApp()
+		fixtures.ConfigureAndStartGoWorkers()
+	})
+
+	It("Should retrieve player with only the fields of its type", func() {
+		gameID := uuid.NewV4().String()
+		_, player, err := fixtures.GetTestPlayerWithMemberships(testDb, gameID, 1, 0, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		route := fmt.Sprintf("%s/games/%s/players/%s", api.V2Prefix, player.GameID, player.PublicID)
+		status, body := Get(a, route)
+		Expect(status).To(Equal(http.StatusOK))
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result).To(HaveLen(9))
+		Expect(result["success"]).To(BeTrue())
+		Expect(result["publicID"]).To(Equal(player.PublicID))
+		Expect(result["name"]).To(Equal(player.Name))
+		Expect(result["clans"]).To(HaveKey("approved"))
+		Expect(result["memberships"]).To(HaveLen(1))
+	})
+
+	It("Should retrieve clan summary with only the fields of its type", func() {
+		_, clan, _, _, _, err := fixtures.GetClanWithMemberships(testDb, 1, 0, 0, 0, "", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		route := fmt.Sprintf("%s/games/%s/clans/%s/summary", api.V2Prefix, clan.GameID, clan.PublicID)
+		status, body := Get(a, route)
+		Expect(status).To(Equal(http.StatusOK))
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result).To(HaveLen(7))
+		Expect(result["publicID"]).To(Equal(clan.PublicID))
+	})
+
+	It("Should create player with only the fields of its type", func() {
+		game := fixtures.GameFactory.MustCreate().(*models.Game)
+		err := testDb.Insert(game)
+		Expect(err).NotTo(HaveOccurred())
+
+		payload := map[string]interface{}{
+			"publicID": uuid.NewV4().String(),
+			"name":     "player",
+			"metadata": map[string]interface{}{"x": "a"},
+		}
+		route := fmt.Sprintf("%s/games/%s/players", api.V2Prefix, game.PublicID)
+		status, body := PostJSON(a, route, payload)
+		Expect(status).To(Equal(http.StatusOK))
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(body), &result)
+		Expect(result).To(HaveLen(5))
+		Expect(result["gameID"]).To(Equal(game.PublicID))
+		Expect(result["publicID"]).To(Equal(payload["publicID"]))
+		Expect(result["metadata"]).To(Equal(payload["metadata"]))
+	})
+
+	It("Should retrieve cached clan with only the fields of its type", func() {
+		_, clan, _, _, _, err := fixtures.GetClanWithMemberships(testDb, 1, 1, 0, 0, "", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		route := fmt.Sprintf("%s/games/%s/clans/%s", api.V2Prefix, clan.GameID, clan.PublicID)
+		status, body := Get(a, route)
+		Expect(status).To(Equal(http.StatusOK))
+		cachedStatus, cachedBody := Get(a, route)
+		Expect(cachedStatus).To(Equal(http.StatusOK))
+		Expect(cachedBody).To(Equal(body))
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(cachedBody), &result)
+		Expect(result).To(HaveLen(11))
+		Expect(result["publicID"]).To(Equal(clan.PublicID))
+		Expect(result["roster"]).To(HaveLen(1))
+		Expect(result["memberships"]).To(HaveKeyWithValue("denied", HaveLen(1)))
+	})
+
+	It("Should respond with the errors of the v1 routes", func() {
+		gameID := uuid.NewV4().String()
+		route := fmt.Sprintf("/games/%s/players/%s", gameID, uuid.NewV4().String())
+
+		status, body := Get(a, api.V2Prefix+route)
+		v1Status, v1Body := Get(a, route)
+		Expect(status).To(Equal(http.StatusNotFound))
+		Expect(status).To(Equal(v1Status))
+		Expect(body).To(Equal(v1Body))
+	})
+
+	It("Should deprecate v1 routes with a v2 successor", func() {
+		gameID := uuid.NewV4().String()
+		route := fmt.Sprintf("/games/%s/clans/%s", gameID, uuid.NewV4().String())
+
+		_, _, header := doRequestWithHeader(a, "GET", route, "", http.Header{})
+		Expect(header.Get("Deprecation")).To(Equal("true"))
+		Expect(header.Get("Link")).To(Equal(fmt.Sprintf("<%s%s>; rel=\"successor-version\"", api.V2Prefix, route)))
+
+		_, _, header = doRequestWithHeader(a, "GET", api.V2Prefix+route, "", http.Header{})
+		Expect(header.Get("Deprecation")).To(BeEmpty())
+
+		_, _, header = doRequestWithHeader(a, "GET", "/games/"+gameID, "", http.Header{})
+		Expect(header.Get("Deprecation")).To(BeEmpty())
+	})
+})
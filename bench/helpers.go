@@ -18,7 +18,6 @@ import (
 	"github.com/topfreegames/extensions/v9/mongo/interfaces"
 	"github.com/topfreegames/khan/models"
 	"github.com/topfreegames/khan/models/fixtures"
-	"github.com/topfreegames/khan/mongo"
 )
 
 func getRoute(url string) string {
@@ -105,7 +104,7 @@ func getGameAndPlayer(db models.DB, mongoDB interfaces.MongoDB) (*models.Game, *
 		return nil, nil, err
 	}
 
-	err = mongoDB.Run(mongo.GetClanNameTextIndexCommand(game.PublicID, false), nil)
+	err = mongoDB.Run(game.GetClanNameTextIndexCommand(game.PublicID, false), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,13 @@
+package caches
+
+// Cache is a key/value store for serialized payloads. Entries expire after the TTL the cache
+// was created with, and writes to the underlying models should call Delete explicitly so that
+// stale payloads are not served until then.
+type Cache interface {
+	// Get returns the value stored under key and whether it was found.
+	Get(key string) ([]byte, bool, error)
+	// Set stores value under key.
+	Set(key string, value []byte) error
+	// Delete removes the given keys.
+	Delete(keys ...string) error
+}
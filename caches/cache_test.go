@@ -0,0 +1,147 @@
+package caches_test
+
+import (
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/khan/caches"
+	"github.com/topfreegames/khan/testing"
+)
+
+var _ = Describe("Cache", func() {
+	assertCache := func(getCache func() caches.Cache) {
+		It("Should get a value after it is set", func() {
+			cache := getCache()
+			key := uuid.NewV4().String()
+
+			_, present, err := cache.Get(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(present).To(BeFalse())
+
+			Expect(cache.Set(key, []byte("value"))).To(Succeed())
+			value, present, err := cache.Get(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(present).To(BeTrue())
+			Expect(string(value)).To(Equal("value"))
+		})
+
+		It("Should not get a value after it is deleted", func() {
+			cache := getCache()
+			key := uuid.NewV4().String()
+
+			Expect(cache.Set(key, []byte("value"))).To(Succeed())
+			Expect(cache.Delete(key)).To(Succeed())
+			_, present, err := cache.Get(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(present).To(BeFalse())
+		})
+
+		It("Should not get a value after it expires", func() {
+			cache := getCache()
+			key := uuid.NewV4().String()
+
+			Expect(cache.Set(key, []byte("value"))).To(Succeed())
+			time.Sleep(time.Second / 2)
+			_, present, err := cache.Get(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(present).To(BeFalse())
+		})
+	}
+
+	var pool *redis.Pool
+
+	BeforeEach(func() {
+		var err error
+		pool, err = testing.GetTestRedisPool()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Memory", func() {
+		assertCache(func() caches.Cache {
+			return caches.NewMemory(time.Second/4, time.Minute)
+		})
+	})
+
+	Describe("Redis", func() {
+		assertCache(func() caches.Cache {
+			return caches.NewRedis(pool, "khan:test:", time.Second/4)
+		})
+	})
+
+	Describe("TwoTier", func() {
+		newTwoTier := func(channel string) *caches.TwoTier {
+			return caches.NewTwoTier(
+				caches.NewMemory(time.Second/4, time.Minute),
+				caches.NewRedis(pool, "khan:test:", time.Second/4),
+				pool,
+				channel,
+				time.Second/4,
+				testing.NewMockLogger(),
+			)
+		}
+
+		assertCache(func() caches.Cache {
+			return newTwoTier(uuid.NewV4().String())
+		})
+
+		It("Should read values set by another process", func() {
+			channel := uuid.NewV4().String()
+			first, second := newTwoTier(channel), newTwoTier(channel)
+			key := uuid.NewV4().String()
+
+			Expect(first.Set(key, []byte("value"))).To(Succeed())
+			value, present, err := second.Get(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(present).To(BeTrue())
+			Expect(string(value)).To(Equal("value"))
+		})
+
+		It("Should not set values deleted by another process until the tombstone expires", func() {
+			channel := uuid.NewV4().String()
+			first, second := newTwoTier(channel), newTwoTier(channel)
+			key := uuid.NewV4().String()
+
+			Expect(first.Set(key, []byte("value"))).To(Succeed())
+			Expect(first.Delete(key)).To(Succeed())
+
+			// a value loaded by second before the deletion
+			Expect(second.Set(key, []byte("stale"))).To(Succeed())
+			for _, cache := range []*caches.TwoTier{first, second} {
+				_, present, err := cache.Get(key)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(present).To(BeFalse())
+			}
+
+			time.Sleep(time.Second / 2)
+			Expect(second.Set(key, []byte("value"))).To(Succeed())
+			value, present, err := first.Get(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(present).To(BeTrue())
+			Expect(string(value)).To(Equal("value"))
+		})
+
+		It("Should drop local values deleted by another process", func() {
+			channel := uuid.NewV4().String()
+			first, second := newTwoTier(channel), newTwoTier(channel)
+			second.Start()
+			defer second.Stop()
+			key := uuid.NewV4().String()
+
+			Expect(first.Set(key, []byte("value"))).To(Succeed())
+			_, present, err := second.Get(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(present).To(BeTrue())
+
+			// wait for the subscription
+			time.Sleep(time.Second / 10)
+			Expect(first.Delete(key)).To(Succeed())
+			Eventually(func() bool {
+				_, present, _ := second.Get(key)
+				return present
+			}).Should(BeFalse())
+		})
+	})
+})
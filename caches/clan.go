@@ -2,21 +2,38 @@ package caches
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
-	gocache "github.com/patrickmn/go-cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/topfreegames/khan/log"
 	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/util"
+	"github.com/uber-go/zap"
 )
 
 // ClansSummaries represents a cache for the RetrieveClansSummaries operation.
+// Concurrent misses of the same clans share a single query.
 type ClansSummaries struct {
-	// Cache points to an instance of gocache.Cache used as the backend cache object.
-	Cache *gocache.Cache
+	// Cache points to the Cache used as the backend cache object.
+	Cache Cache
+	// Requests counts the hits and misses of each clan in Prometheus, labeled with "clansSummaries" and the
+	// result. It may be nil.
+	Requests *prometheus.CounterVec
+	// SoftTTL is the age after which cached summaries are refreshed in background while still being served.
+	// Zero disables background refreshes.
+	SoftTTL time.Duration
+	// Logger receives the errors of background refreshes. It may be nil.
+	Logger zap.Logger
+
+	group Group
 }
 
 // GetClansSummaries is a cache in front of models.GetClansSummaries() with the exact same interface.
 // Like models.GetClansSummaries(), this function may return partial results + CouldNotFindAllClansError.
 // The map[string]interface{} return type represents a summary of one clan with the following keys/values:
-// "membershipCount":  int
+// "membershipCount":  number
 // "publicID":         string
 // "metadata":         map[string]interface{} (user-defined arbitrary JSON object with clan metadata)
 // "name":             string
@@ -24,34 +41,41 @@ type ClansSummaries struct {
 // "autoJoin":         bool
 // TODO(matheuscscp): replace this map with a richer type
 func (c *ClansSummaries) GetClansSummaries(db models.DB, gameID string, publicIDs []string) ([]map[string]interface{}, error) {
-	// first, assemble a result map with cached payloads. also assemble a missingPublicIDs string slice
+	// first, assemble a result map with cached payloads. also assemble missingPublicIDs and stalePublicIDs string slices
 	idToPayload := make(map[string]map[string]interface{})
-	var missingPublicIDs []string
+	var missingPublicIDs, stalePublicIDs []string
 	for _, publicID := range publicIDs {
-		if clanPayload, present := c.Cache.Get(c.getClanSummaryCacheKey(gameID, publicID)); present {
-			idToPayload[publicID] = clanPayload.(map[string]interface{})
+		clanPayload, stale, err := c.getClanSummary(gameID, publicID)
+		if err != nil {
+			return nil, err
+		}
+		c.report(clanPayload != nil)
+		if clanPayload != nil {
+			idToPayload[publicID] = clanPayload
+			if stale {
+				stalePublicIDs = append(stalePublicIDs, publicID)
+			}
 		} else {
 			missingPublicIDs = append(missingPublicIDs, publicID)
 		}
 	}
 
+	if len(stalePublicIDs) > 0 {
+		go c.refresh(db, gameID, stalePublicIDs)
+	}
+
 	// fetch and cache missing clans
 	var err error
 	if len(missingPublicIDs) > 0 {
-		// fetch
 		var clans []map[string]interface{}
-		clans, err = models.GetClansSummaries(db, gameID, missingPublicIDs)
+		clans, err = c.load(db, gameID, missingPublicIDs)
 		if err != nil {
 			if _, ok := err.(*models.CouldNotFindAllClansError); !ok {
 				return nil, err
 			}
 		}
-
-		// cache
 		for _, clanPayload := range clans {
-			publicID := clanPayload["publicID"].(string)
-			idToPayload[publicID] = clanPayload
-			c.Cache.Set(c.getClanSummaryCacheKey(gameID, publicID), clanPayload, gocache.DefaultExpiration)
+			idToPayload[clanPayload["publicID"].(string)] = clanPayload
 		}
 	}
 
@@ -65,6 +89,101 @@ func (c *ClansSummaries) GetClansSummaries(db models.DB, gameID string, publicID
 	return result, err
 }
 
+type clansSummariesResult struct {
+	clans []map[string]interface{}
+	err   error
+}
+
+// load fetches and caches the summaries of the given clans once for all concurrent callers
+func (c *ClansSummaries) load(db models.DB, gameID string, publicIDs []string) ([]map[string]interface{}, error) {
+	sortedPublicIDs := append([]string{}, publicIDs...)
+	sort.Strings(sortedPublicIDs)
+	key := fmt.Sprintf("%s/%s", gameID, strings.Join(sortedPublicIDs, ","))
+
+	value, err := c.group.Do(key, func() (interface{}, error) {
+		// fetch
+		clans, err := models.GetClansSummaries(db, gameID, publicIDs)
+		if err != nil {
+			if _, ok := err.(*models.CouldNotFindAllClansError); !ok {
+				return nil, err
+			}
+		}
+
+		// cache
+		for _, clanPayload := range clans {
+			publicID := clanPayload["publicID"].(string)
+			if setErr := c.setClanSummary(gameID, publicID, clanPayload); setErr != nil {
+				return nil, setErr
+			}
+		}
+		return &clansSummariesResult{clans: clans, err: err}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the result is shared by concurrent callers, so each one gets its own copy of the payloads
+	result := value.(*clansSummariesResult)
+	clans := make([]map[string]interface{}, len(result.clans))
+	for i, clanPayload := range result.clans {
+		clans[i] = util.DeepCopyMap(clanPayload)
+	}
+	return clans, result.err
+}
+
+func (c *ClansSummaries) refresh(db models.DB, gameID string, publicIDs []string) {
+	_, err := c.load(db, gameID, publicIDs)
+	if _, ok := err.(*models.CouldNotFindAllClansError); err != nil && !ok && c.Logger != nil {
+		log.E(c.Logger, "Clans summaries cache refresh failed.", func(cm log.CM) {
+			cm.Write(zap.String("gameID", gameID), zap.Error(err))
+		})
+	}
+}
+
+// Invalidate removes the cached summaries of the given clans.
+func (c *ClansSummaries) Invalidate(gameID string, publicIDs ...string) error {
+	keys := make([]string, len(publicIDs))
+	for i, publicID := range publicIDs {
+		keys[i] = c.getClanSummaryCacheKey(gameID, publicID)
+	}
+	return c.Cache.Delete(keys...)
+}
+
+func (c *ClansSummaries) report(hit bool) {
+	if c.Requests == nil {
+		return
+	}
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	c.Requests.WithLabelValues("clansSummaries", result).Inc()
+}
+
+func (c *ClansSummaries) getClanSummary(gameID, publicID string) (map[string]interface{}, bool, error) {
+	data, present, err := c.Cache.Get(c.getClanSummaryCacheKey(gameID, publicID))
+	if err != nil || !present {
+		return nil, false, err
+	}
+	e := decodeEntry(data)
+	if e == nil {
+		return nil, false, nil
+	}
+	clanPayload, err := decodePayload(e.Payload)
+	if err != nil {
+		return nil, false, err
+	}
+	return clanPayload, e.stale(), nil
+}
+
+func (c *ClansSummaries) setClanSummary(gameID, publicID string, clanPayload map[string]interface{}) error {
+	data, _, err := newEntry(clanPayload, c.SoftTTL)
+	if err != nil {
+		return err
+	}
+	return c.Cache.Set(c.getClanSummaryCacheKey(gameID, publicID), data)
+}
+
 func (c *ClansSummaries) getClanSummaryCacheKey(gameID, publicID string) string {
 	return fmt.Sprintf("%s/%s", gameID, publicID)
 }
@@ -0,0 +1,69 @@
+package caches
+
+import (
+	"sync"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/topfreegames/extensions/v9/mongo/interfaces"
+	"github.com/topfreegames/khan/models"
+)
+
+// ClanNames represents a cache of the clan name tries used by clan name suggestion and fuzzy search.
+type ClanNames struct {
+	// Cache points to an instance of gocache.Cache used as the backend cache object.
+	Cache *gocache.Cache
+	// RefreshInterval is the time between the refreshes of a cached trie with the clans updated in MongoDB.
+	RefreshInterval time.Duration
+
+	mutex sync.Mutex
+}
+
+type clanNamesEntry struct {
+	trie        *models.ClanNameTrie
+	refreshedAt time.Time
+	mutex       sync.Mutex
+}
+
+// GetClanNameTrie returns the cached clan name trie of a game, building it from MongoDB if it is not cached.
+// Cached tries are refreshed with the clans updated since the last refresh every RefreshInterval, and built
+// again when they expire, so deleted clans are removed after at most one TTL.
+func (c *ClanNames) GetClanNameTrie(mongo interfaces.MongoDB, gameID string, settings *models.SearchSettings) (*models.ClanNameTrie, error) {
+	if entry, present := c.Cache.Get(gameID); present {
+		return c.refresh(mongo, gameID, entry.(*clanNamesEntry))
+	}
+
+	// avoid building the same trie concurrently
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if entry, present := c.Cache.Get(gameID); present {
+		return entry.(*clanNamesEntry).trie, nil
+	}
+
+	trie, err := models.GetClanNameTrieFromMongoDB(mongo, gameID, settings)
+	if err != nil {
+		return nil, err
+	}
+	c.Cache.Set(gameID, &clanNamesEntry{trie: trie, refreshedAt: time.Now()}, gocache.DefaultExpiration)
+	return trie, nil
+}
+
+func (c *ClanNames) refresh(mongo interfaces.MongoDB, gameID string, entry *clanNamesEntry) (*models.ClanNameTrie, error) {
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+	if time.Since(entry.refreshedAt) < c.RefreshInterval {
+		return entry.trie, nil
+	}
+
+	err := entry.trie.RefreshFromMongoDB(mongo, gameID)
+	if err != nil {
+		return nil, err
+	}
+	entry.refreshedAt = time.Now()
+	return entry.trie, nil
+}
+
+// Invalidate removes the clan name trie of a game from the cache.
+func (c *ClanNames) Invalidate(gameID string) {
+	c.Cache.Delete(gameID)
+}
@@ -0,0 +1,184 @@
+package caches
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	extnethttpmiddleware "github.com/topfreegames/extensions/v9/middleware"
+	"github.com/topfreegames/khan/log"
+	"github.com/uber-go/zap"
+)
+
+// CacheHitsMetric is the statsd counter of cache hits, tagged with the cache name and game.
+const CacheHitsMetric = "cache_hits"
+
+// CacheMissesMetric is the statsd counter of cache misses, tagged with the cache name and game.
+const CacheMissesMetric = "cache_misses"
+
+// Details represents a read-through cache of the details payloads of clans or players, keyed by game and public ID.
+// Concurrent misses of the same key share a single load.
+type Details struct {
+	// Cache points to the Cache used as the backend cache object.
+	Cache Cache
+	// Name identifies the cache in the hit and miss metrics.
+	Name string
+	// MetricsReporter receives the hit and miss counts. It may be nil.
+	MetricsReporter extnethttpmiddleware.MetricsReporter
+	// Requests counts the hits and misses in Prometheus, labeled with the cache name and the result. It may be nil.
+	Requests *prometheus.CounterVec
+	// SoftTTL is the age after which cached payloads are refreshed in background while still being served.
+	// Zero disables background refreshes.
+	SoftTTL time.Duration
+	// Logger receives the errors of background refreshes. It may be nil.
+	Logger zap.Logger
+
+	group Group
+
+	mutex sync.Mutex
+	loads map[string]*detailsLoads
+}
+
+// detailsLoads tracks the loads in flight of a key. generation is bumped by each invalidation of the key, so
+// that loads that started before it do not cache what they read
+type detailsLoads struct {
+	generation uint64
+	count      int
+}
+
+// Get returns the cached payload of the given game and public ID. On a miss, it calls load and caches its result.
+// Since load may also be called in background to refresh a stale payload, it must not depend on request state.
+func (d *Details) Get(gameID, publicID string, load func() (map[string]interface{}, error)) (map[string]interface{}, error) {
+	key := d.getDetailsCacheKey(gameID, publicID)
+	data, present, err := d.Cache.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if present {
+		if e := decodeEntry(data); e != nil {
+			d.report(CacheHitsMetric, gameID)
+			if e.stale() {
+				go d.refresh(key, load)
+			}
+			return decodePayload(e.Payload)
+		}
+	}
+	d.report(CacheMissesMetric, gameID)
+
+	raw, err := d.load(key, load)
+	if err != nil {
+		return nil, err
+	}
+	return decodePayload(raw)
+}
+
+// load calls load once for all concurrent callers of the same key and caches its result, unless the key was
+// invalidated while it was loading
+func (d *Details) load(key string, load func() (map[string]interface{}, error)) (json.RawMessage, error) {
+	raw, err := d.group.Do(key, func() (interface{}, error) {
+		generation := d.startLoad(key)
+		defer d.finishLoad(key)
+
+		payload, err := load()
+		if err != nil {
+			return nil, err
+		}
+		data, raw, err := newEntry(payload, d.SoftTTL)
+		if err != nil {
+			return nil, err
+		}
+		if d.invalidatedSince(key, generation) {
+			return raw, nil
+		}
+		if err := d.Cache.Set(key, data); err != nil {
+			return nil, err
+		}
+		// an invalidation between the check and the Set may have deleted the key before the Set
+		if d.invalidatedSince(key, generation) {
+			return raw, d.Cache.Delete(key)
+		}
+		return raw, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return raw.(json.RawMessage), nil
+}
+
+func (d *Details) refresh(key string, load func() (map[string]interface{}, error)) {
+	if _, err := d.load(key, load); err != nil && d.Logger != nil {
+		log.E(d.Logger, "Cache refresh failed.", func(cm log.CM) {
+			cm.Write(zap.String("cache", d.Name), zap.String("key", key), zap.Error(err))
+		})
+	}
+}
+
+// Invalidate removes the cached payloads of the given public IDs. Loads of them in flight are not cached, and
+// the next calls load them again instead of waiting for those loads.
+func (d *Details) Invalidate(gameID string, publicIDs ...string) error {
+	keys := make([]string, len(publicIDs))
+	d.mutex.Lock()
+	for i, publicID := range publicIDs {
+		keys[i] = d.getDetailsCacheKey(gameID, publicID)
+		if loads, ok := d.loads[keys[i]]; ok {
+			loads.generation++
+		}
+		d.group.Forget(keys[i])
+	}
+	d.mutex.Unlock()
+	return d.Cache.Delete(keys...)
+}
+
+// startLoad registers a load of key in flight and returns the generation of the key
+func (d *Details) startLoad(key string) uint64 {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.loads == nil {
+		d.loads = make(map[string]*detailsLoads)
+	}
+	loads, ok := d.loads[key]
+	if !ok {
+		loads = &detailsLoads{}
+		d.loads[key] = loads
+	}
+	loads.count++
+	return loads.generation
+}
+
+// finishLoad unregisters a load of key, forgetting the generation of the key after its last load
+func (d *Details) finishLoad(key string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	loads := d.loads[key]
+	loads.count--
+	if loads.count == 0 {
+		delete(d.loads, key)
+	}
+}
+
+// invalidatedSince returns whether key was invalidated since its generation was generation
+func (d *Details) invalidatedSince(key string, generation uint64) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.loads[key].generation != generation
+}
+
+func (d *Details) report(metric, gameID string) {
+	if d.Requests != nil {
+		result := "miss"
+		if metric == CacheHitsMetric {
+			result = "hit"
+		}
+		d.Requests.WithLabelValues(d.Name, result).Inc()
+	}
+	if d.MetricsReporter == nil {
+		return
+	}
+	d.MetricsReporter.Increment(metric, fmt.Sprintf("cache:%s", d.Name), fmt.Sprintf("game:%s", gameID))
+}
+
+func (d *Details) getDetailsCacheKey(gameID, publicID string) string {
+	return fmt.Sprintf("%s/%s", gameID, publicID)
+}
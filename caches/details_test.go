@@ -0,0 +1,195 @@
+package caches_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/topfreegames/khan/caches"
+)
+
+type countingMetricsReporter struct {
+	counts map[string]int
+}
+
+func (r *countingMetricsReporter) Timing(metric string, value time.Duration, tags ...string) error {
+	return nil
+}
+
+func (r *countingMetricsReporter) Gauge(metric string, value float64, tags ...string) error {
+	return nil
+}
+
+func (r *countingMetricsReporter) Increment(metric string, tags ...string) error {
+	r.counts[metric]++
+	return nil
+}
+
+var _ = Describe("Details Cache", func() {
+	var details *caches.Details
+	var reporter *countingMetricsReporter
+	var loads int
+
+	load := func() (map[string]interface{}, error) {
+		loads++
+		return map[string]interface{}{"publicID": "clan-id", "loads": loads}, nil
+	}
+
+	BeforeEach(func() {
+		loads = 0
+		reporter = &countingMetricsReporter{counts: map[string]int{}}
+		details = &caches.Details{
+			Cache:           caches.NewMemory(time.Minute, time.Minute),
+			Name:            "clanDetails",
+			MetricsReporter: reporter,
+		}
+	})
+
+	It("Should load the payload only on the first call", func() {
+		payload, err := details.Get("game-id", "clan-id", load)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payload["loads"]).To(BeEquivalentTo(1))
+
+		payload, err = details.Get("game-id", "clan-id", load)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payload["loads"]).To(BeEquivalentTo(1))
+
+		Expect(loads).To(Equal(1))
+		Expect(reporter.counts[caches.CacheMissesMetric]).To(Equal(1))
+		Expect(reporter.counts[caches.CacheHitsMetric]).To(Equal(1))
+	})
+
+	It("Should count hits and misses in Prometheus", func() {
+		requests := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "requests"}, []string{"cache", "result"})
+		details.Requests = requests
+
+		_, err := details.Get("game-id", "clan-id", load)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = details.Get("game-id", "clan-id", load)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = details.Get("game-id", "clan-id", load)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testutil.ToFloat64(requests.WithLabelValues("clanDetails", "miss"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(requests.WithLabelValues("clanDetails", "hit"))).To(Equal(2.0))
+	})
+
+	It("Should load the payload again after it is invalidated", func() {
+		_, err := details.Get("game-id", "clan-id", load)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(details.Invalidate("game-id", "clan-id")).To(Succeed())
+		payload, err := details.Get("game-id", "clan-id", load)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payload["loads"]).To(BeEquivalentTo(2))
+	})
+
+	It("Should keep payloads of different games apart", func() {
+		_, err := details.Get("game-id", "clan-id", load)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = details.Get("other-game-id", "clan-id", load)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loads).To(Equal(2))
+	})
+
+	It("Should not cache load errors", func() {
+		_, err := details.Get("game-id", "clan-id", func() (map[string]interface{}, error) {
+			return nil, errors.New("load failed")
+		})
+		Expect(err).To(MatchError("load failed"))
+
+		_, err = details.Get("game-id", "clan-id", load)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loads).To(Equal(1))
+	})
+
+	It("Should share a single load between concurrent calls", func() {
+		release := make(chan struct{})
+		slowLoad := func() (map[string]interface{}, error) {
+			<-release
+			return load()
+		}
+
+		results := make(chan map[string]interface{}, 5)
+		for i := 0; i < 5; i++ {
+			go func() {
+				defer GinkgoRecover()
+				payload, err := details.Get("game-id", "clan-id", slowLoad)
+				Expect(err).NotTo(HaveOccurred())
+				results <- payload
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+
+		for i := 0; i < 5; i++ {
+			Eventually(results).Should(Receive(HaveKeyWithValue("loads", BeEquivalentTo(1))))
+		}
+		Expect(loads).To(Equal(1))
+	})
+
+	It("Should not cache loads that were in flight when the payload was invalidated", func() {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		staleLoad := func() (map[string]interface{}, error) {
+			close(started)
+			<-release
+			return map[string]interface{}{"version": 1}, nil
+		}
+
+		results := make(chan map[string]interface{}, 1)
+		go func() {
+			defer GinkgoRecover()
+			payload, err := details.Get("game-id", "clan-id", staleLoad)
+			Expect(err).NotTo(HaveOccurred())
+			results <- payload
+		}()
+		<-started
+		Expect(details.Invalidate("game-id", "clan-id")).To(Succeed())
+
+		payload, err := details.Get("game-id", "clan-id", func() (map[string]interface{}, error) {
+			return map[string]interface{}{"version": 2}, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payload["version"]).To(BeEquivalentTo(2))
+
+		close(release)
+		Eventually(results).Should(Receive(HaveKeyWithValue("version", BeEquivalentTo(1))))
+
+		payload, err = details.Get("game-id", "clan-id", func() (map[string]interface{}, error) {
+			return nil, errors.New("should not load")
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payload["version"]).To(BeEquivalentTo(2))
+	})
+
+	It("Should return stale payloads and refresh them in background", func() {
+		var mutex sync.Mutex
+		lockedLoad := func() (map[string]interface{}, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return load()
+		}
+		details.SoftTTL = 10 * time.Millisecond
+
+		_, err := details.Get("game-id", "clan-id", lockedLoad)
+		Expect(err).NotTo(HaveOccurred())
+		time.Sleep(20 * time.Millisecond)
+
+		payload, err := details.Get("game-id", "clan-id", lockedLoad)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payload["loads"]).To(BeEquivalentTo(1))
+
+		Eventually(func() interface{} {
+			payload, err := details.Get("game-id", "clan-id", func() (map[string]interface{}, error) {
+				return nil, errors.New("should not load")
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return payload["loads"]
+		}).Should(BeEquivalentTo(2))
+	})
+})
@@ -0,0 +1,51 @@
+package caches
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// entry is the envelope of the payloads stored in a Cache. Entries past RefreshAt are still served,
+// but should be refreshed in background so that hot keys never expire.
+type entry struct {
+	RefreshAt int64           `json:"refreshAt"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// newEntry encodes payload into an entry to be refreshed after softTTL. A zero softTTL disables refreshing.
+func newEntry(payload interface{}, softTTL time.Duration) ([]byte, json.RawMessage, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	e := entry{Payload: raw}
+	if softTTL > 0 {
+		e.RefreshAt = time.Now().Add(softTTL).UnixNano()
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, raw, nil
+}
+
+// decodeEntry returns the entry encoded in data, or nil if data is not a valid entry.
+func decodeEntry(data []byte) *entry {
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil || e.Payload == nil {
+		return nil
+	}
+	return &e
+}
+
+func (e *entry) stale() bool {
+	return e.RefreshAt > 0 && time.Now().UnixNano() > e.RefreshAt
+}
+
+func decodePayload(raw json.RawMessage) (map[string]interface{}, error) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
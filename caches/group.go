@@ -0,0 +1,53 @@
+package caches
+
+import "sync"
+
+// Group coalesces concurrent calls with the same key into a single execution whose result is shared by all callers.
+// The zero value is ready to use.
+type Group struct {
+	mutex sync.Mutex
+	calls map[string]*groupCall
+}
+
+type groupCall struct {
+	wg    sync.WaitGroup
+	value interface{}
+	err   error
+}
+
+// Do executes fn and returns its result. If a call with the same key is in flight, it waits for that call
+// and returns its result instead. The returned value is shared, so callers must not modify it.
+func (g *Group) Do(key string, fn func() (interface{}, error)) (interface{}, error) {
+	g.mutex.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*groupCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mutex.Unlock()
+		call.wg.Wait()
+		return call.value, call.err
+	}
+	call := &groupCall{}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mutex.Unlock()
+
+	defer func() {
+		g.mutex.Lock()
+		if g.calls[key] == call {
+			delete(g.calls, key)
+		}
+		g.mutex.Unlock()
+		call.wg.Done()
+	}()
+	call.value, call.err = fn()
+	return call.value, call.err
+}
+
+// Forget makes the next calls with key execute again instead of waiting for the call in flight, e.g. because
+// the data it read was written since. Callers already waiting for that call still get its result.
+func (g *Group) Forget(key string) {
+	g.mutex.Lock()
+	delete(g.calls, key)
+	g.mutex.Unlock()
+}
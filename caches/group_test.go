@@ -0,0 +1,85 @@
+package caches_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/topfreegames/khan/caches"
+)
+
+var _ = Describe("Group", func() {
+	var group *caches.Group
+
+	BeforeEach(func() {
+		group = &caches.Group{}
+	})
+
+	It("Should execute concurrent calls with the same key once", func() {
+		var calls int32
+		release := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				value, err := group.Do("key", func() (interface{}, error) {
+					atomic.AddInt32(&calls, 1)
+					<-release
+					return "value", nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(value).To(Equal("value"))
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		Expect(atomic.LoadInt32(&calls)).To(BeEquivalentTo(1))
+	})
+
+	It("Should execute calls with different keys separately", func() {
+		first, err := group.Do("first", func() (interface{}, error) { return 1, nil })
+		Expect(err).NotTo(HaveOccurred())
+		second, err := group.Do("second", func() (interface{}, error) { return 2, nil })
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first).To(Equal(1))
+		Expect(second).To(Equal(2))
+	})
+
+	It("Should execute calls again after the key is forgotten", func() {
+		release := make(chan struct{})
+		first := make(chan interface{}, 1)
+		go func() {
+			defer GinkgoRecover()
+			value, err := group.Do("key", func() (interface{}, error) {
+				<-release
+				return "first", nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			first <- value
+		}()
+		time.Sleep(50 * time.Millisecond)
+
+		group.Forget("key")
+		value, err := group.Do("key", func() (interface{}, error) { return "second", nil })
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal("second"))
+
+		close(release)
+		Eventually(first).Should(Receive(Equal("first")))
+	})
+
+	It("Should not keep errors after the call returns", func() {
+		_, err := group.Do("key", func() (interface{}, error) { return nil, errors.New("failed") })
+		Expect(err).To(MatchError("failed"))
+
+		value, err := group.Do("key", func() (interface{}, error) { return "value", nil })
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal("value"))
+	})
+})
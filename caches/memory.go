@@ -0,0 +1,42 @@
+package caches
+
+import (
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+// Memory is a Cache kept in the memory of the current process.
+type Memory struct {
+	cache *gocache.Cache
+}
+
+// NewMemory returns an in-memory Cache whose entries expire after ttl.
+func NewMemory(ttl, cleanupInterval time.Duration) *Memory {
+	return &Memory{
+		cache: gocache.New(ttl, cleanupInterval),
+	}
+}
+
+// Get returns the value stored under key and whether it was found.
+func (m *Memory) Get(key string) ([]byte, bool, error) {
+	value, present := m.cache.Get(key)
+	if !present {
+		return nil, false, nil
+	}
+	return value.([]byte), true, nil
+}
+
+// Set stores value under key.
+func (m *Memory) Set(key string, value []byte) error {
+	m.cache.Set(key, value, gocache.DefaultExpiration)
+	return nil
+}
+
+// Delete removes the given keys.
+func (m *Memory) Delete(keys ...string) error {
+	for _, key := range keys {
+		m.cache.Delete(key)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package caches
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/spf13/viper"
+)
+
+// NewRedisPool returns a pool of connections to the Redis instance configured under the "redis" keys.
+func NewRedisPool(config *viper.Viper) *redis.Pool {
+	address := fmt.Sprintf("%s:%d", config.GetString("redis.host"), config.GetInt("redis.port"))
+	database := config.GetInt("redis.database")
+	password := config.GetString("redis.password")
+	poolSize := config.GetInt("redis.pool")
+	if poolSize == 0 {
+		poolSize = 30
+	}
+
+	return &redis.Pool{
+		MaxIdle:     poolSize,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			options := []redis.DialOption{redis.DialDatabase(database)}
+			if password != "" {
+				options = append(options, redis.DialPassword(password))
+			}
+			return redis.Dial("tcp", address, options...)
+		},
+		TestOnBorrow: func(c redis.Conn, t time.Time) error {
+			_, err := c.Do("PING")
+			return err
+		},
+	}
+}
+
+// tombstone is stored in place of deleted entries, so that values loaded before the deletion are not set again
+var tombstone = []byte("\x00khan:deleted")
+
+// setUnlessDeletedScript sets KEYS[1] to ARGV[1] for ARGV[2] milliseconds unless it holds the tombstone ARGV[3]
+var setUnlessDeletedScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[3] then
+	return 0
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+return 1
+`)
+
+// Redis is a Cache shared by every process connected to the same Redis instance.
+type Redis struct {
+	pool   *redis.Pool
+	prefix string
+	ttl    time.Duration
+}
+
+// NewRedis returns a Redis Cache whose keys are prefixed by prefix and expire after ttl.
+func NewRedis(pool *redis.Pool, prefix string, ttl time.Duration) *Redis {
+	return &Redis{
+		pool:   pool,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+func (r *Redis) key(key string) string {
+	return r.prefix + key
+}
+
+// Get returns the value stored under key and whether it was found.
+func (r *Redis) Get(key string) ([]byte, bool, error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	value, err := redis.Bytes(conn.Do("GET", r.key(key)))
+	if err == redis.ErrNil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if bytes.Equal(value, tombstone) {
+		return nil, false, nil
+	}
+	return value, true, nil
+}
+
+// Set stores value under key, unless it was deleted with a tombstone that did not expire yet.
+func (r *Redis) Set(key string, value []byte) error {
+	_, err := r.setUnlessDeleted(key, value)
+	return err
+}
+
+// setUnlessDeleted stores value under key and returns true, unless key holds a tombstone
+func (r *Redis) setUnlessDeleted(key string, value []byte) (bool, error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	set, err := redis.Bool(setUnlessDeletedScript.Do(
+		conn, r.key(key), value, int64(r.ttl/time.Millisecond), tombstone,
+	))
+	return set, err
+}
+
+// Delete removes the given keys.
+func (r *Redis) Delete(keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	args := make([]interface{}, len(keys))
+	for i, key := range keys {
+		args[i] = r.key(key)
+	}
+	_, err := conn.Do("DEL", args...)
+	return err
+}
+
+// deleteWithTombstones replaces the given keys with tombstones that expire after ttl, so that values loaded
+// before the deletion cannot be set again until then.
+func (r *Redis) deleteWithTombstones(ttl time.Duration, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	for _, key := range keys {
+		conn.Send("SET", r.key(key), tombstone, "PX", int64(ttl/time.Millisecond))
+	}
+	_, err := conn.Do("EXEC")
+	return err
+}
@@ -0,0 +1,177 @@
+package caches
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/topfreegames/khan/log"
+	"github.com/uber-go/zap"
+)
+
+// TwoTier is a Cache that keeps a local copy of the entries stored in Redis. Deletions are
+// published in a Redis channel so that every process drops its local copy of stale entries, and
+// leave tombstones in Redis so that no process sets values it loaded before the deletion.
+type TwoTier struct {
+	local        Cache
+	remote       *Redis
+	pool         *redis.Pool
+	channel      string
+	tombstoneTTL time.Duration
+	logger       zap.Logger
+
+	// generation is bumped by each deletion from the local tier, so that values read before it are
+	// not copied to the local tier after it
+	generation uint64
+
+	mutex   sync.Mutex
+	pubSub  *redis.PubSubConn
+	stopped bool
+}
+
+// NewTwoTier returns a Cache that reads from local before remote. Deleted keys cannot be set for
+// tombstoneTTL, which should be longer than loading a value takes. Call Start so that deletions
+// made by other processes are applied to local.
+func NewTwoTier(
+	local Cache, remote *Redis, pool *redis.Pool, channel string, tombstoneTTL time.Duration, logger zap.Logger,
+) *TwoTier {
+	return &TwoTier{
+		local:        local,
+		remote:       remote,
+		pool:         pool,
+		channel:      channel,
+		tombstoneTTL: tombstoneTTL,
+		logger:       logger,
+	}
+}
+
+// Get returns the value stored under key and whether it was found.
+func (t *TwoTier) Get(key string) ([]byte, bool, error) {
+	value, present, err := t.local.Get(key)
+	if err != nil || present {
+		return value, present, err
+	}
+
+	generation := atomic.LoadUint64(&t.generation)
+	value, present, err = t.remote.Get(key)
+	if err != nil || !present {
+		return nil, false, err
+	}
+	return value, true, t.setLocal(key, value, generation)
+}
+
+// Set stores value under key, unless key was deleted less than the tombstone TTL ago.
+func (t *TwoTier) Set(key string, value []byte) error {
+	generation := atomic.LoadUint64(&t.generation)
+	set, err := t.remote.setUnlessDeleted(key, value)
+	if err != nil || !set {
+		return err
+	}
+	return t.setLocal(key, value, generation)
+}
+
+// setLocal copies a value read from remote when the local tier was at generation to the local tier, unless
+// a deletion happened since
+func (t *TwoTier) setLocal(key string, value []byte, generation uint64) error {
+	if atomic.LoadUint64(&t.generation) != generation {
+		return nil
+	}
+	if err := t.local.Set(key, value); err != nil {
+		return err
+	}
+	// a deletion between the check and the Set may have deleted the key before the Set
+	if atomic.LoadUint64(&t.generation) != generation {
+		return t.local.Delete(key)
+	}
+	return nil
+}
+
+// deleteLocal removes the given keys from the local tier
+func (t *TwoTier) deleteLocal(keys ...string) error {
+	atomic.AddUint64(&t.generation, 1)
+	return t.local.Delete(keys...)
+}
+
+// Delete removes the given keys from both tiers and notifies the other processes.
+func (t *TwoTier) Delete(keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := t.deleteLocal(keys...); err != nil {
+		return err
+	}
+	if err := t.remote.deleteWithTombstones(t.tombstoneTTL, keys...); err != nil {
+		return err
+	}
+
+	message, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	conn := t.pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("PUBLISH", t.channel, message)
+	return err
+}
+
+// Start listens to deletions made by other processes until Stop is called.
+func (t *TwoTier) Start() {
+	go func() {
+		for {
+			err := t.listen()
+			t.mutex.Lock()
+			stopped := t.stopped
+			t.mutex.Unlock()
+			if stopped {
+				return
+			}
+			log.E(t.logger, "Cache invalidation subscription failed, retrying.", func(cm log.CM) {
+				cm.Write(zap.String("channel", t.channel), zap.Error(err))
+			})
+			time.Sleep(time.Second)
+		}
+	}()
+}
+
+func (t *TwoTier) listen() error {
+	t.mutex.Lock()
+	if t.stopped {
+		t.mutex.Unlock()
+		return nil
+	}
+	pubSub := &redis.PubSubConn{Conn: t.pool.Get()}
+	t.pubSub = pubSub
+	t.mutex.Unlock()
+	defer pubSub.Close()
+
+	if err := pubSub.Subscribe(t.channel); err != nil {
+		return err
+	}
+	for {
+		switch v := pubSub.Receive().(type) {
+		case redis.Message:
+			var keys []string
+			if err := json.Unmarshal(v.Data, &keys); err != nil {
+				log.W(t.logger, "Invalid cache invalidation message.", func(cm log.CM) {
+					cm.Write(zap.String("channel", t.channel), zap.Error(err))
+				})
+				continue
+			}
+			t.deleteLocal(keys...)
+		case error:
+			return v
+		}
+	}
+}
+
+// Stop stops listening to deletions made by other processes.
+func (t *TwoTier) Stop() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.stopped = true
+	if t.pubSub != nil {
+		t.pubSub.Close()
+	}
+}
@@ -14,7 +14,6 @@ import (
 	imongo "github.com/topfreegames/extensions/v9/mongo/interfaces"
 	"github.com/topfreegames/khan/log"
 	"github.com/topfreegames/khan/models"
-	"github.com/topfreegames/khan/mongo"
 )
 
 var gameID string
@@ -48,7 +47,7 @@ If the game do not exists in the main Postgres database, no actions take place.`
 				cm.Write(zap.String("error", err.Error()))
 			})
 		}
-		_, err = models.GetGameByPublicID(db, gameID)
+		game, err := models.GetGameByPublicID(db, gameID)
 		if err != nil {
 			log.F(logger, "Error fetching game from postgres.", func(cm log.CM) {
 				cm.Write(zap.String("error", err.Error()))
@@ -62,7 +61,7 @@ If the game do not exists in the main Postgres database, no actions take place.`
 				cm.Write(zap.String("error", err.Error()))
 			})
 		}
-		err = runMigrations(mongoDB, logger)
+		err = runMigrations(mongoDB, game, logger)
 		if err != nil {
 			log.F(logger, "Error running mongo migrations.", func(cm log.CM) {
 				cm.Write(zap.String("error", err.Error()))
@@ -113,7 +112,7 @@ func newMongo(config *viper.Viper) (imongo.MongoDB, error) {
 	return mongoDB.MongoDB, nil
 }
 
-func runMigrations(mongoDB imongo.MongoDB, logger zap.Logger) error {
+func runMigrations(mongoDB imongo.MongoDB, game *models.Game, logger zap.Logger) error {
 	logger = logger.With(
 		zap.String("source", "cmd/migrate_mongo.go"),
 		zap.String("operation", "runMigrations"),
@@ -123,12 +122,12 @@ func runMigrations(mongoDB imongo.MongoDB, logger zap.Logger) error {
 	log.I(logger, "Running mongo migrations for game...")
 
 	// migrations
-	type Migration func(imongo.MongoDB, zap.Logger) error
+	type Migration func(imongo.MongoDB, *models.Game, zap.Logger) error
 	migrations := []Migration{
 		createClanNameTextIndex,
 	}
 	for _, migration := range migrations {
-		if err := migration(mongoDB, logger); err != nil {
+		if err := migration(mongoDB, game, logger); err != nil {
 			return err
 		}
 	}
@@ -138,14 +137,14 @@ func runMigrations(mongoDB imongo.MongoDB, logger zap.Logger) error {
 	return nil
 }
 
-func createClanNameTextIndex(mongoDB imongo.MongoDB, logger zap.Logger) error {
+func createClanNameTextIndex(mongoDB imongo.MongoDB, game *models.Game, logger zap.Logger) error {
 	logger = logger.With(
 		zap.String("source", "cmd/migrate_mongo.go"),
 		zap.String("operation", "createClanNameTextIndex"),
 		zap.String("game", gameID),
 	)
 
-	cmd := mongo.GetClanNameTextIndexCommand(gameID, false)
+	cmd := game.GetClanNameTextIndexCommand(gameID, false)
 	var res struct {
 		OK               int `bson:"ok"`
 		NumIndexesBefore int `bson:"numIndexesBefore"`
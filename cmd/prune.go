@@ -9,6 +9,7 @@ package cmd
 
 import (
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -141,12 +142,30 @@ func executePruning(debug, quiet bool) (*models.PruneStats, error) {
 		totals.DeletedMembershipsPruned += stats.DeletedMembershipsPruned
 		totals.DeniedMembershipsPruned += stats.DeniedMembershipsPruned
 	}
+	log.D(cmdL, "Pruning expired idempotency keys...")
+	viper.SetDefault("idempotency.window", 24*time.Hour)
+	viper.SetDefault("idempotency.inProgressTTL", time.Minute)
+	viper.SetDefault("idempotency.pruneBatchSize", 1000)
+	totals.IdempotencyKeysPruned, err = models.PruneIdempotencyKeys(
+		db,
+		viper.GetDuration("idempotency.window"),
+		viper.GetDuration("idempotency.inProgressTTL"),
+		viper.GetInt("idempotency.pruneBatchSize"),
+	)
+	if err != nil {
+		log.E(cmdL, "Failed to prune expired idempotency keys.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return nil, err
+	}
+
 	log.I(cmdL, "Stale data pruned successfully.", func(cm log.CM) {
 		cm.Write(
 			zap.Int("PendingApplicationsPruned", totals.PendingApplicationsPruned),
 			zap.Int("PendingInvitesPruned", totals.PendingInvitesPruned),
 			zap.Int("DeniedMembershipsPruned", totals.DeniedMembershipsPruned),
 			zap.Int("DeletedMembershipsPruned", totals.DeletedMembershipsPruned),
+			zap.Int("IdempotencyKeysPruned", totals.IdempotencyKeysPruned),
 		)
 	})
 	return totals, nil
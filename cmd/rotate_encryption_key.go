@@ -0,0 +1,66 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/topfreegames/khan/log"
+	"github.com/topfreegames/khan/services"
+	"github.com/uber-go/zap"
+)
+
+var rotateEncryptionKeyDebug bool
+var rotateEncryptionKeyQuiet bool
+
+// rotateEncryptionKeyCmd represents the encryption key rotation script
+var rotateEncryptionKeyCmd = &cobra.Command{
+	Use:   "rotate-encryption-key",
+	Short: "re-encrypts player names and metadata with the current encryption key",
+	Long: `Starts khan encryption key rotation script that re-encrypts in batches the player names, then
+the previous names kept in the player name history and then the encrypted player metadata values,
+encrypted with a key other than security.currentEncryptionKeyID. Keep the previous keys in
+security.encryptionKeys until it finishes.
+You can use environment variables to override configuration keys.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ll := zap.InfoLevel
+		if rotateEncryptionKeyDebug {
+			ll = zap.DebugLevel
+		}
+		if rotateEncryptionKeyQuiet {
+			ll = zap.ErrorLevel
+		}
+		logger := zap.New(
+			zap.NewJSONEncoder(), // drop timestamps in tests
+			ll,
+		)
+
+		cmdL := logger.With(
+			zap.String("source", "rotateEncryptionKeyCmd"),
+			zap.String("operation", "Run"),
+			zap.Bool("debug", rotateEncryptionKeyDebug),
+		)
+
+		log.D(cmdL, "Creating application...")
+		script := services.GetEncryptionScript(
+			ConfigFile,
+			rotateEncryptionKeyDebug,
+			logger,
+		)
+		log.D(cmdL, "Application created successfully.")
+
+		log.D(cmdL, "Starting script...")
+		script.StartKeyRotation()
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(rotateEncryptionKeyCmd)
+
+	rotateEncryptionKeyCmd.Flags().BoolVarP(&rotateEncryptionKeyDebug, "debug", "d", false, "Debug mode")
+	rotateEncryptionKeyCmd.Flags().BoolVarP(&rotateEncryptionKeyQuiet, "quiet", "q", false, "Quiet mode (log level error)")
+}
@@ -205,6 +205,141 @@ func migrations_20210401151842_changeencryptedplayersidtype_sql() ([]byte, error
 	)
 }
 
+var _migrations_20261019100000_addgamesearchsettings_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa5\x91\xbb\x52\xc3\x30\x10\x45\x7b\x7d\xc5\x76\x29\x48\x66\xe8\x53\x19\x6c\x2a\x61\x87\x60\xd7\x1a\x45\xd9\xc8\x4a\xe4\x95\xc7\x12\x38\xc3\xd7\x23\x60\x78\x24\xd8\x09\x8f\x72\x57\x47\x57\xa3\x7b\xd8\x6c\x06\x17\xda\x39\x8f\x50\xb5\x2c\x0e\xf7\x77\x1c\x0c\x81\x47\x15\x8c\x23\x98\x54\xed\x04\x8c\x07\xdc\xa3\x7a\x08\xb8\x86\xbe\x46\x82\x50\xc7\x55\x63\x74\x27\x5f\xa1\x38\xc8\xb6\xb5\x06\xd7\x2c\xe1\x65\xb6\x84\x32\xb9\xe2\x19\x68\xd9\xa0\x67\x00\x49\x9a\xc2\x75\xc1\xab\xdb\x3c\xc6\xca\x4e\xd5\xa2\x31\x24\xda\x0e\x37\x66\x2f\x2c\x92\x0e\x75\x7c\x32\xa0\xc6\x0e\xf2\xa2\x84\xbc\xe2\x1c\xd2\xec\x26\xa9\x78\x09\x97\xd3\xc1\x04\xa9\x14\x52\x10\x1e\xc9\x9b\x60\x1e\x11\x56\xce\x59\x94\xf4\x3d\x60\x23\xad\xc7\xe1\x10\x25\x3d\xfe\x37\x62\xbb\x13\x14\x7b\x68\x84\x37\x4f\xf8\xeb\x6f\x50\xac\x48\xf4\x68\x74\x1d\xfe\x76\xf7\xad\x45\xf4\xe7\x43\xe6\x8c\xb1\x4f\xd9\xa9\xeb\xe9\x5d\xf7\x87\xeb\x97\xe5\x8f\x6c\x77\xce\xda\x78\xba\x92\x6a\x37\x68\x3c\x5d\x16\x8b\x73\xca\xa7\xc3\xdc\xb1\xd8\x11\xec\x50\xdd\x18\x74\x20\x67\x04\xfa\xa2\xe0\x14\x71\x54\xf4\x9c\x3d\x03\x78\x51\x25\x70\x3a\x03\x00\x00")
+
+func migrations_20261019100000_addgamesearchsettings_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019100000_addgamesearchsettings_sql,
+		"migrations/20261019100000_AddGameSearchSettings.sql",
+	)
+}
+
+var _migrations_20261019110000_addencryptedplayerskeyid_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x50\xc1\x4e\x83\x40\x14\xbc\xef\x57\xcc\x8d\x36\xa6\x17\x93\x9e\x38\x61\x77\x9b\x90\x6c\x41\x61\x49\x7a\x23\x2b\x3c\xcb\xa6\x74\xd9\x2c\xd4\xca\xdf\x8b\xb5\x6a\x8c\x36\x7a\x7c\x33\xef\xcd\x9b\x19\xb6\x58\xe0\x66\xd7\x75\x3d\xa1\x70\x6c\x1a\xf2\x07\x09\x63\xd1\x53\x35\x98\xce\x22\x28\x5c\x00\xd3\x83\x5e\xa8\x3a\x0e\x54\xe3\xd4\x90\xc5\xd0\x4c\xd0\xc1\xec\xbc\x3e\x2f\x4d\x83\x76\xae\x35\x54\xb3\x48\x2a\x91\x41\x45\x77\x52\x80\x6c\xe5\x47\x37\x1d\x95\xae\xd5\x23\xf9\x1e\x11\xe7\x58\xa5\xb2\xd8\x24\xd8\xd3\x58\x9a\x1a\xcf\xda\x57\x8d\xf6\xb3\xdb\xe5\x72\x8e\x24\x55\x48\x0a\x29\xc1\xc5\x3a\x2a\xa4\x42\x50\xd3\x93\x3e\xb6\x43\x10\xb2\x55\x26\x22\x25\x10\x27\x5c\x6c\x7f\x2a\x97\x17\xb9\x34\xf9\xe5\xeb\xec\x9d\x9c\x87\x8c\xb1\xaf\xb8\xbc\x3b\xd9\x8f\xc0\x9f\x69\xdf\xc0\x7f\xe5\xf5\x5d\xdb\x4e\xec\xa3\xae\xf6\x8c\x67\xe9\xfd\xc5\x58\xbc\x86\xd8\xc6\xb9\xca\xaf\x5a\x0c\xff\xa8\xe8\x2c\xf6\xad\xa3\x90\xbd\x02\x9a\x9a\x02\x4c\xa5\x01\x00\x00")
+
+func migrations_20261019110000_addencryptedplayerskeyid_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019110000_addencryptedplayerskeyid_sql,
+		"migrations/20261019110000_AddEncryptedPlayersKeyID.sql",
+	)
+}
+
+var _migrations_20261019120000_changeplayernametype_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x95\xce\x3f\x0f\x82\x30\x10\x87\xe1\xfd\x3e\xc5\x6d\x68\x0c\x8b\x09\x13\x13\x2a\x5b\xfd\x0f\x83\x63\xad\x17\x68\x2c\x6d\xd3\x56\xc1\x6f\x6f\xd5\xa8\xab\x8e\xbf\xf7\x6e\x78\x20\x4d\x71\xd2\x18\xe3\x09\x6b\x0b\x71\xec\xb7\x0c\xa5\x46\x4f\x22\x48\xa3\x31\xa9\x6d\x82\xd2\x23\x0d\x24\x2e\x81\x4e\xd8\xb7\xa4\x31\xb4\x31\x75\xb2\x71\xfc\xf9\x14\x07\xb7\x56\x49\x3a\x41\xc1\xaa\x72\x87\x55\x31\x63\x25\x5a\xc5\x6f\xe4\x3c\xbe\xda\x7c\xcd\xea\xe5\x0a\x35\xef\x08\xab\xc3\xa6\xc4\x40\x43\xc8\x01\xe0\x2b\x58\x98\x5e\xbf\x0d\x1f\xc0\x23\xfe\x44\x70\x46\xa9\x78\x3d\x72\x71\xfe\x87\x71\xe5\x4e\xb4\xdc\x8d\xa6\x59\x36\xce\xe1\x0e\xb1\x0c\x58\x2d\x10\x01\x00\x00")
+
+func migrations_20261019120000_changeplayernametype_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019120000_changeplayernametype_sql,
+		"migrations/20261019120000_ChangePlayerNameType.sql",
+	)
+}
+
+var _migrations_20261019130000_createplayerencryptedmetadatafields_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\xce\xbd\x4e\xc3\x30\x14\x05\xe0\x3d\x4f\x71\xb6\x14\x55\x91\xa2\xae\x9d\x42\x9d\x4e\xa6\x7f\x24\x73\x74\x71\x6e\x13\x0b\xc7\xb6\x6c\x97\xd2\xb7\x27\x45\xc0\xc4\xd0\xf1\x9e\x7b\x74\xf4\x15\x05\x96\x83\x73\x91\xd1\xfa\xac\x28\xf0\x7a\x94\xd0\x16\x91\x55\xd2\xce\x22\x6f\x7d\x0e\x1d\xc1\x9f\xac\x2e\x89\x7b\x5c\x47\xb6\x48\xe3\x1c\x4d\x7a\x08\xf4\x5d\x9a\x0f\xf2\xde\x68\xee\xb3\x4a\x36\xf5\x09\x4d\xf5\x2c\x6b\x0c\x34\x71\x44\x25\x04\x36\x7b\xd9\xbe\xec\xe0\x0d\xdd\x38\x74\x6c\x55\xb8\xf9\x79\xac\x9b\x38\x51\x4f\x89\xba\xb3\x66\xd3\x47\x7c\x50\x50\x23\x85\xc5\xaa\x2c\xcb\x27\x88\x7a\x5b\xb5\xb2\x41\x9e\xaf\xb3\x3b\xed\xc7\x29\xdc\xd5\xfe\x4a\xff\x98\xf7\xf0\x21\x68\x70\xc6\xcc\xdf\x37\x52\xef\xff\x60\xc5\x69\x7f\x78\x54\xbb\xce\xbe\x00\x6a\xd2\x73\x54\x3c\x01\x00\x00")
+
+func migrations_20261019130000_createplayerencryptedmetadatafields_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019130000_createplayerencryptedmetadatafields_sql,
+		"migrations/20261019130000_CreatePlayerEncryptedMetadataFields.sql",
+	)
+}
+
+var _migrations_20261019140000_createjsonbmergepatchfunction_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x54\xdd\x8f\x9a\x40\x10\x7f\xe7\xaf\x98\x07\x13\x24\xd5\x7b\x69\xd2\xa6\xd2\x5e\x83\xb8\xde\xd1\x20\x58\x3e\xd2\xbe\x19\xc4\x39\xe4\x0e\xd8\x2d\xac\x5e\x4d\xd3\xff\xbd\xbb\xec\x6a\x35\xe7\x35\xe5\x6d\x67\x7e\x1f\x33\xcc\xec\x8e\xc7\xf0\xa6\xa0\xb4\x43\x48\x99\x31\x1e\x43\xfc\xd5\x87\xb2\x81\x0e\x73\x5e\xd2\x06\xcc\x94\x99\x50\x76\x80\x3f\x31\xdf\x71\xdc\xc0\xf3\x16\x1b\xe0\x5b\x11\xaa\xcb\xa2\xcd\x7a\x90\x38\x64\x8c\x55\x25\x6e\x0c\x29\xf1\xd8\xd1\x66\xbd\xaa\xb1\x2d\x70\xc5\x32\x9e\x6f\x75\x56\xa0\x20\x9a\xbb\xf0\xfe\xed\x87\x77\xf0\x25\x0e\x03\x58\x48\x0c\x2c\x7b\x0c\xa7\xc0\x33\x71\xe4\x13\xa8\xb1\x5e\x63\xdb\x01\x7d\x00\xc5\x6f\x91\x55\x59\x8e\x52\x9c\x6f\xf1\x3c\xaf\x28\x23\x68\x76\x55\xd5\x09\x5c\x4d\xf7\x28\x31\x35\x64\xcd\x06\xe8\xfa\x51\xf4\x21\x7c\x5b\x49\x12\xc8\x8d\x80\xe4\xbb\xb6\x2b\xf7\x58\x1d\xa4\x9c\xee\x3d\xe6\x19\xc7\x1a\x1b\x3e\xc5\xa2\x6c\x0c\x37\x22\x4e\x42\x20\x8c\x20\x22\x4b\xdf\x71\x09\xcc\xd3\xc0\x4d\x3c\x51\xf2\x8b\xe6\x86\xaa\x04\x95\x18\xe9\x82\xfb\x83\x25\xd8\x49\x1a\x05\xb1\x3a\x82\x13\xc3\x60\x60\x4c\xc9\x9d\x17\x18\x20\x3e\x6f\xae\xd1\x5e\x0c\x41\xea\xfb\xd2\x4f\xc9\xf3\x03\x43\xfa\x30\xec\xb3\x16\x7c\xbc\x05\x53\x75\x62\x42\x72\x4f\x14\x59\x7e\x4a\x5e\x89\xd8\x7d\x94\x04\x33\x21\x6b\x1f\xe5\x75\x69\xaf\xe9\xab\xf4\x3f\x0c\x34\x7f\xf2\x09\xcc\x5f\xbf\xcd\xc9\xa4\x67\x5f\x3a\x19\x67\x85\x0c\x4f\xc4\x98\xf8\xc4\x4d\xc0\x0d\x1d\x9f\xc4\x2e\x19\x2a\x5b\xe5\xb1\xca\x8a\x62\xa8\xa6\x71\xf3\x84\x87\x91\x9e\xcc\xcd\x3e\xab\x76\x68\x8d\xce\xad\xac\x93\xe0\x3c\x0a\x17\x67\xfa\x67\x1e\x5c\x89\x70\xc5\x57\x40\x65\x87\xd9\x69\x3a\x16\xf0\x0b\xee\xb7\x7b\x12\x11\x08\xc2\x44\x4f\xe0\xb3\x92\xb9\xc0\xa4\x81\x9c\xb7\xe3\xfb\xd7\x5c\x99\x72\x7d\x75\x1b\xc6\xb7\x47\x08\xd3\x8d\xbd\xa8\x4c\x4f\x97\x5d\x29\xec\x72\x0b\x8e\x02\x72\x4c\x72\xcf\xcd\x13\xc3\xd2\xff\xae\x0f\x58\xb6\x21\x86\x62\x1b\x83\x01\xf8\x4e\x70\x97\x3a\x77\x04\x58\xc5\x8a\xee\x47\x05\xde\x62\x91\x26\xce\xd4\x27\xf6\xb5\xa5\x27\x8d\xba\xb8\x3a\x3e\xa3\xcf\xcd\xf1\x29\x38\xbd\x03\x32\xf8\x5f\x2f\x41\x4b\xab\x4a\x64\xd7\x59\xfe\x64\xcc\xa2\x70\xf9\xf7\xea\x88\x85\x24\xdf\xbd\x38\x89\xaf\xfc\x36\x7d\x7b\xd4\xd4\x6d\xe3\x0f\x99\xc2\x8e\x59\x96\x04\x00\x00")
+
+func migrations_20261019140000_createjsonbmergepatchfunction_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019140000_createjsonbmergepatchfunction_sql,
+		"migrations/20261019140000_CreateJSONBMergePatchFunction.sql",
+	)
+}
+
+var _migrations_20261019150000_addversioncolumns_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa5\x90\xcd\x0e\x82\x30\x10\x84\xef\x3e\xc5\xdc\x3c\x18\x0e\x9e\x3d\xa1\xc5\x53\x05\x45\xfa\x00\x15\x37\xd8\x58\xda\x86\xa2\xe8\xdb\x0b\xfe\x25\x1a\x12\x35\x1e\x77\x76\x66\x33\xfb\x05\x01\x46\x85\xb5\x9e\x20\xdc\x20\x08\xb0\x5e\x71\x28\x03\x4f\x79\xad\xac\xc1\x50\xb8\x21\x94\x07\x9d\x28\x3f\xd4\xb4\x45\xb3\x23\x83\x7a\xd7\x4a\xa5\x2a\x2a\x79\x35\xb5\x83\x74\x4e\x2b\xda\x0e\x42\x9e\x45\x29\xb2\x70\xca\x23\x14\xb2\x24\x8f\x90\x31\xcc\x12\x2e\x16\x31\x8e\x54\xf9\xce\xbf\x51\x85\x32\x35\xe2\x24\x43\x2c\x38\x07\x8b\xe6\xa1\xe0\x19\xc6\x93\x97\xbc\xd3\xf2\xdc\x26\xfe\xb8\x90\x6b\x69\x7e\xcd\x77\x10\xee\x44\x98\x6d\xcc\x83\xc9\x13\x48\x27\x7e\x85\xa4\xb2\x5a\xb7\xdb\x8d\xcc\xf7\x3d\xa5\x58\x9a\x2c\xdf\x5a\xf5\x7f\xff\xd1\x78\xc3\xdc\x6b\xbb\x00\x1d\xaf\x6d\x3c\xdd\x01\x00\x00")
+
+func migrations_20261019150000_addversioncolumns_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019150000_addversioncolumns_sql,
+		"migrations/20261019150000_AddVersionColumns.sql",
+	)
+}
+
+var _migrations_20261019160000_createplayernamehistory_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x93\xcb\x6e\x82\x40\x14\x86\xf7\x3c\xc5\xd9\x09\xa9\x6c\x9a\xda\x0d\x2b\x0a\x63\x4a\x8a\xa0\x5c\x52\x5d\x91\x11\xa6\x30\x11\x81\x02\x56\x7d\xfb\x0e\x17\xb9\x18\x52\xcb\x6e\xce\xe5\x3b\xff\x1c\xfe\x11\x45\x78\x0a\xd3\xb4\x20\xe0\x66\x9c\x28\x82\xbd\xd1\x81\x26\x50\x10\xbf\xa4\x69\x02\x33\x37\x9b\x01\x2d\x80\x5c\x88\x7f\x2a\x49\x00\xe7\x88\x24\x50\x46\x2c\x74\xa4\x61\x8e\xeb\x22\x76\xc0\x59\x16\x53\x12\x70\xb2\xee\x20\x0b\x1c\xf9\x4d\x47\x10\xe2\x23\x29\x40\x56\x55\x50\x4c\xdd\x5d\x19\x90\xc5\xf8\x4a\x72\x2f\x61\x71\x8f\x11\xca\x34\xbf\xc2\x3e\x4d\x63\x82\x13\x30\x4c\x07\x0c\x57\xd7\x41\x45\x4b\xd9\xd5\x1d\xf8\xc2\x71\x41\xa4\xbf\x81\xa7\x84\x7e\x9f\x88\x37\xe0\x16\x0f\x81\x23\x62\xd3\x39\x62\x36\xea\x70\x11\xc1\x0f\xce\xfd\x08\xe7\xfc\xeb\x8b\x50\x93\x24\x4e\xb1\x90\xec\x20\x70\x0d\x6d\xe3\x22\xd0\x0c\x15\x6d\x6f\x08\xaf\x12\xe7\xd1\xc0\xeb\xfb\x4d\xa3\xe3\xf3\x6d\x76\xde\xe3\x05\xf8\x7c\x47\x16\x1a\xcc\xd3\xec\x4e\x34\x93\xd9\xce\x1a\xea\x1c\x6f\x8e\xe7\x80\x7d\x34\x80\x3d\x0d\x0b\x92\x53\x1c\xc3\xda\xd2\x56\xb2\xb5\x83\x0f\xb4\x9b\xd7\xd9\xb6\xaf\x29\xa2\x49\xd9\x6f\xc5\x42\x4b\x36\xdd\x50\x90\xdd\x8b\xa4\x81\x50\x89\x56\x91\x8e\xd8\x64\x45\xb6\x15\x59\x45\x0d\xa8\x9a\x0c\x25\xb9\xf4\x84\x26\x7e\x20\xd7\x8a\x7e\x5b\xd5\xf3\x62\x21\x0c\xb2\x7e\x4e\x30\x33\x8d\x87\xcb\xfb\xf9\x9c\xd0\x6d\x73\xb8\xc6\xd1\x0d\xbd\x5e\xbd\x39\x6d\x1e\xbe\xab\x98\x0f\x66\x31\x74\xe5\xe4\xd6\xd6\x6a\x7a\x4e\x6e\xc6\xee\x5c\x5d\x05\xff\xe5\xeb\x3c\x8d\x63\x96\xdd\x63\xff\xc0\xa9\x96\xb9\x6e\xff\x87\xb6\x04\xb4\xd5\x6c\xc7\x9e\x92\x25\x35\x95\xcd\xbd\xee\x2b\x27\x8c\x22\x4d\x5a\xb2\x66\xdc\x7b\x72\xea\x3d\x0c\x0b\x27\x1e\xc4\xa3\x96\xc9\x0b\xfc\x02\xe0\x91\xee\x37\x16\x04\x00\x00")
+
+func migrations_20261019160000_createplayernamehistory_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019160000_createplayernamehistory_sql,
+		"migrations/20261019160000_CreatePlayerNameHistory.sql",
+	)
+}
+
+var _migrations_20261019170000_creategameversions_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x51\xc1\x6e\x83\x30\x0c\xbd\xe7\x2b\x7c\x6b\xd1\xca\x69\xd2\x2e\x3d\x31\x70\x25\x34\x16\xda\x00\xd2\x7a\xaa\x02\x44\x90\x8d\x26\x28\xa1\xed\x3e\x7f\x81\xd2\x4a\xdd\x2e\xf3\xcd\x7e\xcf\x7e\xf6\xb3\xef\xc3\x53\xa3\xb5\x15\x50\xf4\xc4\xf7\x21\xdb\x25\x20\x15\x58\x51\x0d\x52\x2b\x58\x14\xfd\x02\xa4\x05\xf1\x2d\xaa\xd3\x20\x6a\xb8\xb4\x42\xc1\xd0\xba\xd2\x51\x36\x86\x4f\x24\x97\xf0\xbe\xef\xa4\xa8\x49\xc8\x30\xc8\x11\xf2\xe0\x35\x41\x68\xf8\x51\x1c\xce\xc2\x58\x47\xb2\xb0\x24\xe0\x42\xd6\x50\xca\xc6\x0a\x23\x79\x07\x5b\x16\xbf\x07\x6c\x0f\x6f\xb8\x5f\x4d\xe8\xd4\xe1\x28\x67\x6e\xaa\x96\x9b\xe5\xf3\x8b\x07\x34\xcd\x81\x16\x49\x02\x0c\x37\xc8\x90\x86\x98\x4d\x3c\x37\xb1\x3f\x95\x9d\xac\x5c\x83\x07\x29\x85\x08\x13\x74\xda\x61\x90\x85\x41\x84\xd7\x81\xb3\xfa\xa8\x29\xd5\x70\x9f\x75\x05\xad\xe2\xbd\x6d\xf5\x00\x9f\x56\xab\xf2\x17\x58\x19\xc1\xdd\xc1\x07\x3e\xfc\x6d\x9e\x08\x61\x4a\xb3\x9c\x05\x31\xcd\x1f\x0f\x3d\x8c\x99\xac\x6f\x39\x14\x34\xde\x15\xb8\x9c\x4f\x5b\xdd\x56\xf2\x88\xb7\x26\xa3\xe3\xb3\xfd\x91\xbe\xa8\xdb\x03\xee\xee\x8f\xc5\x7f\xf9\x6f\x74\xd7\x39\xb4\xe4\xd5\x17\x89\x58\xba\x9d\x3f\x10\x6f\x00\x3f\xe2\x2c\xcf\x1e\x57\x5c\x93\x1f\x7d\xa5\xb8\xe2\xf7\x01\x00\x00")
+
+func migrations_20261019170000_creategameversions_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019170000_creategameversions_sql,
+		"migrations/20261019170000_CreateGameVersions.sql",
+	)
+}
+
+var _migrations_20261019180000_createapikeys_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x51\xcb\x6e\xc2\x30\x10\xbc\xe7\x2b\xf6\x06\xa8\xcd\xa9\x15\x17\x4e\x69\x62\xa4\xa8\x69\x80\x3c\x24\x50\x55\x45\xc6\xac\x12\x8b\x10\x47\xb6\x79\xf4\xef\xbb\x40\x48\x45\x25\xa4\xfa\xe6\x99\xf1\xec\x7a\xc6\x75\xe1\xa9\x54\xca\x20\xe4\xad\xe3\xba\x90\x2e\x22\x90\x0d\x18\x14\x56\xaa\x06\x06\x79\x3b\x00\x69\x00\x4f\x28\xf6\x16\x37\x70\xac\xb0\x01\x5b\x11\xb4\x93\xa5\xe6\x17\x11\x5d\x78\xdb\xd6\x12\x37\x8e\x9f\x30\x2f\x63\x90\x79\x6f\x11\x23\x50\x16\x5b\xfc\x36\x30\x74\x80\x8e\xdc\xc0\x5a\x96\x06\xb5\xe4\x35\xcc\x93\xf0\xc3\x4b\x56\xf0\xce\x56\xcf\x17\xb6\xdd\xaf\x6b\x29\x0a\x12\x1d\xb8\x16\x15\xd7\xc3\x97\xf1\x08\xe2\x59\x06\x71\x1e\x45\x90\xc7\xe1\x22\x67\x57\x69\xc9\x77\xf8\x50\x98\xb0\x29\x4b\x58\xec\xb3\xf4\xa2\xa3\xe1\xbd\xf3\x08\x66\x31\x04\x2c\x62\xb4\xa1\xef\xa5\xbe\x17\x74\x86\xb4\x64\x51\x71\x53\xf5\x8e\xe3\xd7\x07\xa3\x8d\x50\x2d\x79\x5a\x3c\xd9\xcf\xaf\x5e\x72\xe5\x84\x46\x4e\x11\x15\xdc\x9e\xff\x29\x1b\xfb\x87\xd7\x78\x50\xdb\x7b\x9e\x38\x67\x34\xb9\xa5\x16\xc6\x01\x5b\xf6\xa9\x15\xb7\x6f\xd2\xd2\xbf\x49\x76\x20\x3d\x3a\xb7\xd5\x55\x17\xa8\x63\x73\x2b\xaf\x6f\xee\x0c\xfe\xab\x3b\xad\xea\x9a\xd8\x35\x17\x5b\x27\x48\x66\xf3\xae\xbd\x70\x0a\x6c\x19\xa6\x59\xda\x4f\x9f\x38\x3f\x73\xb1\xf1\xed\x2e\x02\x00\x00")
+
+func migrations_20261019180000_createapikeys_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019180000_createapikeys_sql,
+		"migrations/20261019180000_CreateAPIKeys.sql",
+	)
+}
+
+var _migrations_20261019190000_addgameplayertokensettings_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x95\x90\x41\x8f\x82\x30\x10\x85\xef\xfc\x8a\x77\xe3\xb0\x21\xd9\x3b\x27\xd6\xe2\xa9\x82\xeb\xd2\x33\xa9\x38\xc1\x86\xd2\x36\x30\x46\xfc\xf7\xe2\x66\xd5\x83\x66\xa3\xc7\x79\xf3\xe6\xe5\x7d\x93\x24\xf8\x68\xbd\x1f\x09\x2a\x44\x49\x82\x9f\x6f\x09\xe3\x30\x52\xc3\xc6\x3b\xc4\x2a\xc4\x30\x23\x68\xa2\xe6\xc0\xb4\xc3\x71\x4f\x0e\xbc\x9f\xa5\xde\xb4\x83\xfe\x35\xcd\x83\x0e\xc1\x1a\xda\x45\x99\xac\xf2\x0d\xaa\xec\x4b\xe6\x68\x75\x4f\x23\x32\x21\xb0\x28\xa5\x5a\x15\x08\x56\x9f\x68\xa8\xd9\x77\xe4\xea\x70\xd8\x5a\xd3\xd4\x1d\x9d\xc0\x34\x31\x8a\xb2\x42\xa1\xa4\x84\xc8\x97\x99\x92\x15\xe2\x38\x7d\x23\xae\xd7\x53\xcd\x6c\xe7\xee\x4c\x2d\x0d\x8f\x71\x9f\x69\x74\xe1\xfb\x83\x15\xfe\xe8\xae\xb8\x37\xd6\x8b\xf8\x12\xed\xe0\xad\x9d\xb7\x5b\xdd\x74\x4f\x2a\x8a\x4d\xb9\xfe\xaf\x63\xfa\xce\xcd\xfd\x4d\x69\x74\x06\xee\xff\x39\xc9\xac\x01\x00\x00")
+
+func migrations_20261019190000_addgameplayertokensettings_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019190000_addgameplayertokensettings_sql,
+		"migrations/20261019190000_AddGamePlayerTokenSettings.sql",
+	)
+}
+
+var _migrations_20261019200000_createidempotencykeys_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x92\x4d\x6f\x82\x40\x10\x86\xef\xfc\x8a\xb9\xa1\xa9\x24\xb6\xa9\xbd\x78\xa2\xb2\x26\xa4\x14\x94\x8f\x44\x4f\x64\x85\x09\x6c\xe4\xab\xec\x52\xf5\xdf\x77\x51\xc4\x16\x9b\xa6\x7b\xdb\x99\x67\xdf\xd9\xbc\xf3\x6a\x1a\x3c\x24\x65\xc9\x11\x82\x4a\xd1\x34\xf0\xd6\x16\xb0\x02\x38\x46\x82\x95\x05\xa8\x41\xa5\x02\xe3\x80\x47\x8c\x1a\x81\x31\x1c\x52\x2c\x40\xa4\xb2\x94\xb3\xa4\xa6\x67\x48\x5e\x68\x55\x65\x0c\x63\x65\xe1\x12\xdd\x27\xe0\xeb\xaf\x16\x01\x16\x63\x5e\x95\x02\x8b\xe8\x14\xee\xf1\xc4\x61\xa4\x80\x3c\x2c\x86\x1d\x4b\x38\xd6\x8c\x66\xb0\x72\xcd\x77\xdd\xdd\xc2\x1b\xd9\x4e\xce\xdd\x84\xe6\x18\x4a\xe4\x93\xd6\x51\x4a\xeb\xd1\xd3\x6c\x36\x06\xdb\xf1\xc1\x0e\x2c\xeb\x82\x48\xad\xbf\xda\x39\x8a\xb4\xbc\x09\x3c\x4e\x87\x40\x45\x45\x0a\x02\x8f\x62\x50\xaf\xf1\xa3\x41\x2e\xc2\x94\xf2\xb4\x7f\xfe\xf2\x3c\x7c\xce\x05\x15\x0d\x97\x2e\x09\x4c\xb0\xee\x9b\x60\x90\xa5\x1e\x58\x3e\x4c\xaf\x6a\xbc\x2a\x0b\x69\xec\x8f\x49\x3d\xa5\xaa\x17\x2c\xaa\x91\x4a\x63\x43\x2a\x5a\x57\xa4\xe8\x60\xda\xc2\xb1\x3d\xdf\xd5\x4d\xdb\x87\x86\x1d\xc3\x81\xa7\x10\xd8\xe6\x3a\x20\x30\xea\x6c\x9b\xb4\xe6\x8c\x95\xf1\xfc\xba\x09\xd3\x36\xc8\xe6\x6e\x13\x61\x87\x87\xdf\xa6\x3b\xf6\x2f\x0b\xeb\x65\x6f\xa0\xd4\x6e\x83\xd2\xa5\xc6\x28\x0f\xc5\x35\x37\x7d\x68\xda\xe2\xbf\x62\x53\x97\x59\x26\xbb\x3b\x1a\xed\x15\xc3\x75\x56\x5d\x70\xcc\x25\x90\x8d\xe9\xf9\xde\xdd\x8f\xe6\xca\x17\x12\x1a\xb2\x96\xb1\x02\x00\x00")
+
+func migrations_20261019200000_createidempotencykeys_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019200000_createidempotencykeys_sql,
+		"migrations/20261019200000_CreateIdempotencyKeys.sql",
+	)
+}
+
+var _migrations_20261019210000_addgamedeleting_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\x8d\xce\x3d\x0e\x82\x30\x18\x06\xe0\x9d\x53\xbc\x1b\x83\xe1\x04\x4c\x68\x71\xaa\xa0\xd8\x1e\xa0\xc0\x27\x34\x96\x96\xd0\x1a\x3c\xbe\x60\xd4\xc1\x38\x38\xbe\x3f\xc3\x93\x24\xd8\x74\xce\x79\x82\x1c\xa3\x24\xc1\xf9\xc4\xa1\x2d\x3c\x35\x41\x3b\x8b\x58\x8e\x31\xb4\x07\xdd\xa9\xb9\x05\x6a\x31\xf7\x64\x11\xfa\xa5\x1a\x74\x37\xa9\xe7\x69\x09\x6a\x1c\x8d\xa6\x36\xca\xb8\xc8\x2b\x88\x6c\xcb\x73\x74\x6a\x20\x8f\x8c\x31\xec\x4a\x2e\x0f\x05\x5a\x32\x14\xb4\xed\x50\x3b\x67\x48\x59\x14\xa5\x40\x21\x39\x07\xcb\xf7\x99\xe4\x02\x17\x65\x3c\xa5\xd1\x0a\x79\xa9\x98\x9b\xed\xdb\xf5\x41\xad\xe5\x5f\xac\xc9\x19\xb3\xac\xb5\x6a\xae\x3f\x68\xac\x2a\x8f\xdf\xb6\x34\x7a\x00\x17\xcf\xfa\xe1\x12\x01\x00\x00")
+
+func migrations_20261019210000_addgamedeleting_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019210000_addgamedeleting_sql,
+		"migrations/20261019210000_AddGameDeleting.sql",
+	)
+}
+
+var _migrations_20261019220000_addidempotencykeyheaders_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\x8d\xce\xbb\x0e\x82\x30\x18\x05\xe0\x9d\xa7\x38\x1b\x83\xe1\x09\x98\xd0\xe2\x54\x41\x91\xce\x06\xe1\x8f\x34\x40\xdb\xd0\x1a\x20\xc6\x77\x17\x8c\x3a\x38\x18\xc7\x73\x19\xbe\x20\xc0\xea\xa2\xb5\x25\x08\xe3\x05\x01\x8e\x07\x0e\xa9\x60\xa9\x74\x52\x2b\xf8\xc2\xf8\x90\x16\x34\x52\x79\x75\x54\x61\xa8\x49\xc1\xd5\x73\xd5\xc9\x4b\x5f\x3c\x4f\x73\x28\x8c\x69\x25\x55\x5e\xc4\xf3\x38\x43\x1e\xad\x79\x0c\x59\x51\x67\xb4\x23\x55\x4e\xa7\x86\x26\x8b\x88\x31\x6c\x52\x2e\x76\x09\x6a\x2a\x2a\xea\x2d\x1c\x8d\x0e\x49\x9a\x23\x11\x9c\x83\xc5\xdb\x48\xf0\x1c\xfe\xed\xee\x87\xde\xc2\x79\xd9\x98\x1e\xd4\x5b\xf7\xa1\x2d\xe5\x5f\xb8\x5e\xb7\xed\xbc\x9e\x8b\xb2\xf9\x0d\x64\x59\xba\xff\x12\x86\xde\x03\x3d\x05\x29\x4d\x22\x01\x00\x00")
+
+func migrations_20261019220000_addidempotencykeyheaders_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019220000_addidempotencykeyheaders_sql,
+		"migrations/20261019220000_AddIdempotencyKeyHeaders.sql",
+	)
+}
+
+var _migrations_20261019230000_scopeidempotencykeysbycaller_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\x9d\x92\xcd\x6e\xc2\x30\x10\x84\xef\x79\x8a\xbd\x01\x2a\xb9\x54\xe2\xc4\x29\x25\x46\x8a\x64\x9c\x92\x1f\x89\x5b\xe4\x26\x2b\xb0\x08\xb6\x65\xbb\x85\xbc\x7d\x1d\xa0\xa5\x2a\x55\x41\x1c\xb3\xb3\x1e\x8f\xe7\x4b\x18\xc2\xd3\x5a\x29\x8b\x50\xea\x20\x0c\x21\x5f\x52\x10\x12\x2c\xd6\x4e\x28\x09\x83\x52\x0f\x40\x58\xc0\x03\xd6\xef\x0e\x1b\xd8\x6f\x50\x82\xdb\xf8\xd1\x4e\xac\x0d\x3f\x2e\xf9\x0f\xae\x75\x2b\xb0\xe9\x1d\xac\x53\xc6\x2f\x1a\xb4\x5a\x49\x8b\x16\xf6\x68\x10\xb6\xa8\x5d\x6f\xac\x5b\x2e\xa4\xc3\x83\x1b\x83\x55\xde\x08\x3b\xe0\x5e\x6e\x8c\xd2\xba\x3f\xc5\xfd\xc8\xf8\x39\x97\x80\xb2\x36\x9d\xf6\x97\x06\x31\xa1\xa4\x20\x30\xcf\xd2\x05\x88\x06\x77\x5a\x39\x2f\x76\xd5\x16\x3b\x3b\x0d\x22\x5a\x90\x0c\x8a\xe8\x85\x92\x2b\x15\xa2\x38\x86\x59\x4a\xcb\x05\x83\x9a\xb7\xad\xf7\xfe\xe0\xa6\xde\x70\x33\x7c\x9e\x4c\x46\xc0\xd2\x02\x58\x49\x29\xc4\x64\x1e\x95\xb4\x80\xc1\xe0\x86\x61\x9c\xa5\xaf\xde\x91\xe5\x45\x16\x25\xac\x80\x35\xdf\xa1\x68\x7a\xed\xae\x24\xbf\xcf\x9d\x42\xf5\x1b\x50\xb2\x64\x59\x92\x61\x2f\x54\xa2\x19\x9f\xf3\x8e\x7d\x75\xdd\x68\x1a\xcc\x32\x12\xf9\x0e\x12\x16\x93\xd5\x95\x79\x55\x1b\xe4\xbe\xa9\x8a\x3b\x48\xd9\xf5\xdd\xc3\x8b\xee\xad\x7a\x4a\x67\xe8\xb1\xda\xcb\x2f\xec\xdf\xcc\xfb\xe1\x5d\xd4\x8d\xf2\x09\x1b\x78\xe3\xf5\xf6\x06\xa3\x63\x6b\xa7\xec\xc9\x1c\xc8\x2a\xc9\x8b\xfc\xbf\x57\x3c\x06\xe1\x52\xe6\x43\x2c\xfe\x82\x70\x2a\xff\x9e\x30\x3f\xfe\xb1\x69\xf0\x09\xec\xef\xe0\x2a\x57\x03\x00\x00")
+
+func migrations_20261019230000_scopeidempotencykeysbycaller_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019230000_scopeidempotencykeysbycaller_sql,
+		"migrations/20261019230000_ScopeIdempotencyKeysByCaller.sql",
+	)
+}
+
+var _migrations_20261019240000_addidempotencykeypublicids_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\x8d\x90\xd1\x6a\x83\x40\x14\x44\xdf\xfd\x8a\x79\xb3\xa5\xf5\x0b\x7c\xb2\xd1\x04\xc1\x6a\x1b\x15\x02\xa5\x84\x55\x2f\x71\x89\xd9\xdd\xee\xae\x24\x52\xfa\xef\x55\x49\xdb\x40\x20\xf4\xf1\x0e\x73\x67\x86\xe3\x79\x78\xd8\x49\x69\x08\xa5\x72\x3c\x0f\xf9\x6b\x02\x2e\x60\xa8\xb6\x5c\x0a\xb8\xa5\x72\xc1\x0d\xe8\x44\x75\x6f\xa9\xc1\xb1\x25\x01\xdb\x8e\xd2\x81\xef\x34\x9b\x4d\xe3\xc1\x94\xea\x38\x35\x53\x82\x6d\x09\xaa\xaf\x3a\x5e\x23\x0e\x0d\x04\x3b\x8c\x6f\xd5\x30\xeb\x9a\x3e\x7a\x32\x16\x8a\xd9\x16\x4c\x34\xb3\x68\xac\xd4\xa3\x45\x93\x51\x52\x18\x7a\x84\x91\x68\xa8\xa3\xa9\x4e\x75\x6c\x20\x6d\x50\x33\x81\x6a\xb4\xd6\xba\xaf\xaa\xb1\x27\x48\x8a\x68\x8d\x22\x78\x4a\x22\xf0\x86\x0e\x4a\x5a\x12\xf5\xb0\xdd\xd3\x60\x10\x84\x21\x16\x59\x52\x3e\xa7\xe7\x21\x5b\xde\x18\x58\x3a\xd9\xb7\x77\xa4\x59\x81\xb4\x4c\x12\x84\xd1\x32\x28\x93\x02\xee\xe7\x97\xeb\x3b\x8b\x75\x14\x14\x11\xe2\x34\x8c\x36\x57\x89\xdb\x8b\x98\x2c\xbd\x2e\x2c\xf3\x38\x5d\x61\x15\xa7\xb8\xfb\x73\xde\xfb\xce\x84\xe3\x4c\x37\x94\x47\xf1\xc3\xf7\x17\xee\x24\xfe\x0b\xaf\x96\x5d\x37\x51\x64\xf5\xde\x09\xd7\xd9\xcb\x79\x67\xbc\x44\xb4\x89\xf3\x22\xbf\xb5\xd8\xbf\x0d\x6b\x8e\xbb\xa2\xe5\x3b\xdf\x26\x7d\x6b\xe5\x19\x02\x00\x00")
+
+func migrations_20261019240000_addidempotencykeypublicids_sql() ([]byte, error) {
+	return bindata_read(
+		_migrations_20261019240000_addidempotencykeypublicids_sql,
+		"migrations/20261019240000_AddIdempotencyKeyPublicIDs.sql",
+	)
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -247,6 +382,21 @@ var _bindata = map[string]func() ([]byte, error){
 	"migrations/20180517112014_ChangeIDSequenceType.sql": migrations_20180517112014_changeidsequencetype_sql,
 	"migrations/20210323185959_CreateEncryptionTable.sql": migrations_20210323185959_createencryptiontable_sql,
 	"migrations/20210401151842_ChangeEncryptedPlayersIDType.sql": migrations_20210401151842_changeencryptedplayersidtype_sql,
+	"migrations/20261019100000_AddGameSearchSettings.sql": migrations_20261019100000_addgamesearchsettings_sql,
+	"migrations/20261019110000_AddEncryptedPlayersKeyID.sql": migrations_20261019110000_addencryptedplayerskeyid_sql,
+	"migrations/20261019120000_ChangePlayerNameType.sql": migrations_20261019120000_changeplayernametype_sql,
+	"migrations/20261019130000_CreatePlayerEncryptedMetadataFields.sql": migrations_20261019130000_createplayerencryptedmetadatafields_sql,
+	"migrations/20261019140000_CreateJSONBMergePatchFunction.sql": migrations_20261019140000_createjsonbmergepatchfunction_sql,
+	"migrations/20261019150000_AddVersionColumns.sql": migrations_20261019150000_addversioncolumns_sql,
+	"migrations/20261019160000_CreatePlayerNameHistory.sql": migrations_20261019160000_createplayernamehistory_sql,
+	"migrations/20261019170000_CreateGameVersions.sql": migrations_20261019170000_creategameversions_sql,
+	"migrations/20261019180000_CreateAPIKeys.sql": migrations_20261019180000_createapikeys_sql,
+	"migrations/20261019190000_AddGamePlayerTokenSettings.sql": migrations_20261019190000_addgameplayertokensettings_sql,
+	"migrations/20261019200000_CreateIdempotencyKeys.sql": migrations_20261019200000_createidempotencykeys_sql,
+	"migrations/20261019210000_AddGameDeleting.sql": migrations_20261019210000_addgamedeleting_sql,
+	"migrations/20261019220000_AddIdempotencyKeyHeaders.sql": migrations_20261019220000_addidempotencykeyheaders_sql,
+	"migrations/20261019230000_ScopeIdempotencyKeysByCaller.sql": migrations_20261019230000_scopeidempotencykeysbycaller_sql,
+	"migrations/20261019240000_AddIdempotencyKeyPublicIDs.sql": migrations_20261019240000_addidempotencykeypublicids_sql,
 }
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
@@ -329,5 +479,35 @@ var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
 		}},
 		"20210401151842_ChangeEncryptedPlayersIDType.sql": &_bintree_t{migrations_20210401151842_changeencryptedplayersidtype_sql, map[string]*_bintree_t{
 		}},
+		"20261019100000_AddGameSearchSettings.sql": &_bintree_t{migrations_20261019100000_addgamesearchsettings_sql, map[string]*_bintree_t{
+		}},
+		"20261019110000_AddEncryptedPlayersKeyID.sql": &_bintree_t{migrations_20261019110000_addencryptedplayerskeyid_sql, map[string]*_bintree_t{
+		}},
+		"20261019120000_ChangePlayerNameType.sql": &_bintree_t{migrations_20261019120000_changeplayernametype_sql, map[string]*_bintree_t{
+		}},
+		"20261019130000_CreatePlayerEncryptedMetadataFields.sql": &_bintree_t{migrations_20261019130000_createplayerencryptedmetadatafields_sql, map[string]*_bintree_t{
+		}},
+		"20261019140000_CreateJSONBMergePatchFunction.sql": &_bintree_t{migrations_20261019140000_createjsonbmergepatchfunction_sql, map[string]*_bintree_t{
+		}},
+		"20261019150000_AddVersionColumns.sql": &_bintree_t{migrations_20261019150000_addversioncolumns_sql, map[string]*_bintree_t{
+		}},
+		"20261019160000_CreatePlayerNameHistory.sql": &_bintree_t{migrations_20261019160000_createplayernamehistory_sql, map[string]*_bintree_t{
+		}},
+		"20261019170000_CreateGameVersions.sql": &_bintree_t{migrations_20261019170000_creategameversions_sql, map[string]*_bintree_t{
+		}},
+		"20261019180000_CreateAPIKeys.sql": &_bintree_t{migrations_20261019180000_createapikeys_sql, map[string]*_bintree_t{
+		}},
+		"20261019190000_AddGamePlayerTokenSettings.sql": &_bintree_t{migrations_20261019190000_addgameplayertokensettings_sql, map[string]*_bintree_t{
+		}},
+		"20261019200000_CreateIdempotencyKeys.sql": &_bintree_t{migrations_20261019200000_createidempotencykeys_sql, map[string]*_bintree_t{
+		}},
+		"20261019210000_AddGameDeleting.sql": &_bintree_t{migrations_20261019210000_addgamedeleting_sql, map[string]*_bintree_t{
+		}},
+		"20261019220000_AddIdempotencyKeyHeaders.sql": &_bintree_t{migrations_20261019220000_addidempotencykeyheaders_sql, map[string]*_bintree_t{
+		}},
+		"20261019230000_ScopeIdempotencyKeysByCaller.sql": &_bintree_t{migrations_20261019230000_scopeidempotencykeysbycaller_sql, map[string]*_bintree_t{
+		}},
+		"20261019240000_AddIdempotencyKeyPublicIDs.sql": &_bintree_t{migrations_20261019240000_addidempotencykeypublicids_sql, map[string]*_bintree_t{
+		}},
 	}},
 }}
@@ -0,0 +1,21 @@
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE games
+  ADD COLUMN search_min_prefix_length integer NOT NULL DEFAULT 0,
+  ADD COLUMN search_accent_sensitive boolean NOT NULL DEFAULT false,
+  ADD COLUMN search_case_sensitive boolean NOT NULL DEFAULT false,
+  ADD COLUMN search_cjk_ngram_size integer NOT NULL DEFAULT 0,
+  ADD COLUMN search_name_weight integer NOT NULL DEFAULT 0,
+  ADD COLUMN search_name_prefixes_weight integer NOT NULL DEFAULT 0;
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE games
+  DROP COLUMN search_min_prefix_length,
+  DROP COLUMN search_accent_sensitive,
+  DROP COLUMN search_case_sensitive,
+  DROP COLUMN search_cjk_ngram_size,
+  DROP COLUMN search_name_weight,
+  DROP COLUMN search_name_prefixes_weight;
@@ -0,0 +1,11 @@
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE encrypted_players ADD COLUMN key_id varchar(255) NOT NULL DEFAULT 'default';
+CREATE INDEX encrypted_players_key_id ON encrypted_players (key_id);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP INDEX IF EXISTS encrypted_players_key_id;
+ALTER TABLE encrypted_players DROP COLUMN key_id;
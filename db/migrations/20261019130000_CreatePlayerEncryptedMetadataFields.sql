@@ -0,0 +1,7 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE games ADD COLUMN player_encrypted_metadata_fields varchar(2000) DEFAULT '';
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE games DROP COLUMN player_encrypted_metadata_fields;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- jsonb_merge_patch applies a RFC 7396 JSON Merge Patch to target: members of patch replace
+-- the members of target, nulls remove them and objects are merged recursively
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION jsonb_merge_patch(target jsonb, patch jsonb) RETURNS jsonb AS $$
+BEGIN
+    IF patch IS NULL OR jsonb_typeof(patch) <> 'object' THEN
+        RETURN patch;
+    END IF;
+    IF target IS NULL OR jsonb_typeof(target) <> 'object' THEN
+        target := '{}'::jsonb;
+    END IF;
+
+    RETURN (
+        SELECT COALESCE(jsonb_object_agg(merged.key, merged.value), '{}'::jsonb)
+        FROM (
+            SELECT t.key, t.value FROM jsonb_each(target) t
+            WHERE NOT patch ? t.key
+            UNION ALL
+            SELECT p.key, jsonb_merge_patch(target -> p.key, p.value) FROM jsonb_each(patch) p
+            WHERE jsonb_typeof(p.value) <> 'null'
+        ) merged
+    );
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP FUNCTION IF EXISTS jsonb_merge_patch(jsonb, jsonb);
@@ -0,0 +1,24 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE games ADD COLUMN player_name_history boolean NOT NULL DEFAULT false;
+ALTER TABLE games ADD COLUMN unique_player_names boolean NOT NULL DEFAULT false;
+
+ALTER TABLE players ADD COLUMN name_hash varchar(64) NULL;
+CREATE UNIQUE INDEX players_game_id_name_hash ON players (game_id, name_hash) WHERE name_hash IS NOT NULL;
+
+CREATE TABLE player_name_history (
+    id bigserial PRIMARY KEY,
+    player_id bigint NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    key_id varchar(255) NULL,
+    created_at bigint NOT NULL
+);
+CREATE INDEX player_name_history_player_id ON player_name_history (player_id, created_at);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE IF EXISTS player_name_history;
+DROP INDEX IF EXISTS players_game_id_name_hash;
+ALTER TABLE players DROP COLUMN name_hash;
+ALTER TABLE games DROP COLUMN unique_player_names;
+ALTER TABLE games DROP COLUMN player_name_history;
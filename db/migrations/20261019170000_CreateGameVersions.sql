@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE game_versions (
+    id bigserial PRIMARY KEY,
+    game_id varchar(36) NOT NULL REFERENCES games (public_id) ON DELETE CASCADE,
+    version bigint NOT NULL,
+    snapshot jsonb NOT NULL,
+    created_at bigint NOT NULL,
+
+    CONSTRAINT game_versions_gameid_version UNIQUE(game_id, version)
+);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE IF EXISTS game_versions;
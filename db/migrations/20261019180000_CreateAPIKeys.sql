@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE api_keys (
+    id bigserial PRIMARY KEY,
+    public_id varchar(36) NOT NULL UNIQUE,
+    game_id varchar(36) NOT NULL REFERENCES games (public_id) ON DELETE CASCADE,
+    key_hash varchar(64) NOT NULL UNIQUE,
+    scopes text[] NOT NULL,
+    created_at bigint NOT NULL,
+    revoked_at bigint NULL
+);
+CREATE INDEX api_keys_game_id ON api_keys (game_id);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE games ADD COLUMN player_token_public_key text NOT NULL DEFAULT '';
+ALTER TABLE games ADD COLUMN player_token_max_ttl integer NOT NULL DEFAULT 0;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE games DROP COLUMN player_token_max_ttl;
+ALTER TABLE games DROP COLUMN player_token_public_key;
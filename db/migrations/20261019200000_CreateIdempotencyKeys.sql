@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE idempotency_keys (
+    id bigserial PRIMARY KEY,
+    game_id varchar(255) NOT NULL,
+    key varchar(255) NOT NULL,
+    method varchar(10) NOT NULL,
+    path text NOT NULL,
+    request_hash varchar(64) NOT NULL,
+    status integer NOT NULL DEFAULT 0,
+    response text NOT NULL DEFAULT '',
+    created_at bigint NOT NULL,
+    CONSTRAINT gameid_key UNIQUE(game_id, key)
+);
+CREATE INDEX idempotency_keys_game_id_created_at ON idempotency_keys (game_id, created_at);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE idempotency_keys ADD COLUMN headers text NOT NULL DEFAULT '{}';
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE idempotency_keys DROP COLUMN headers;
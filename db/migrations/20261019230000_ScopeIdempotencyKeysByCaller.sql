@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- stored responses were kept in plaintext, so they are dropped rather than encrypted
+DELETE FROM idempotency_keys;
+ALTER TABLE idempotency_keys ADD COLUMN caller varchar(255) NOT NULL DEFAULT '';
+ALTER TABLE idempotency_keys DROP CONSTRAINT gameid_key;
+ALTER TABLE idempotency_keys ADD CONSTRAINT gameid_caller_key UNIQUE(game_id, caller, key);
+CREATE INDEX idempotency_keys_created_at ON idempotency_keys (created_at);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DELETE FROM idempotency_keys;
+DROP INDEX IF EXISTS idempotency_keys_created_at;
+ALTER TABLE idempotency_keys DROP CONSTRAINT gameid_caller_key;
+ALTER TABLE idempotency_keys ADD CONSTRAINT gameid_key UNIQUE(game_id, key);
+ALTER TABLE idempotency_keys DROP COLUMN caller;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the public IDs named by the request path and the stored response, so deleted players can be scrubbed
+ALTER TABLE idempotency_keys ADD COLUMN public_ids text[] NOT NULL DEFAULT '{}';
+CREATE INDEX idempotency_keys_public_ids ON idempotency_keys USING GIN (public_ids);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP INDEX IF EXISTS idempotency_keys_public_ids;
+ALTER TABLE idempotency_keys DROP COLUMN public_ids;
@@ -7,9 +7,12 @@ require (
 	github.com/Pallinder/go-randomdata v0.0.0-20160927131605-01563c9f5c2d
 	github.com/asaskevich/govalidator v0.0.0-20180315120708-ccb8e960c48f // indirect
 	github.com/bluele/factory-go v0.0.0-20160811033936-8a28e9752dbc
+	github.com/dgrijalva/jwt-go v3.2.0+incompatible
+	github.com/garyburd/redigo v1.6.0
 	github.com/globalsign/mgo v0.0.0-20180615134936-113d3961e731
 	github.com/go-gorp/gorp v2.2.0+incompatible
 	github.com/golang/mock v1.5.0
+	github.com/golang/protobuf v1.4.3
 	github.com/gosuri/uilive v0.0.0-20160202011846-efb88ccd0599 // indirect
 	github.com/gosuri/uiprogress v0.0.0-20160202012259-a9f819bfc744
 	github.com/jarcoal/httpmock v1.0.4
@@ -27,6 +30,8 @@ require (
 	github.com/onsi/gomega v1.11.0
 	github.com/opentracing/opentracing-go v1.2.0
 	github.com/patrickmn/go-cache v2.1.0+incompatible
+	github.com/prometheus/client_golang v1.5.1
+	github.com/prometheus/common v0.9.1
 	github.com/rcrowley/go-metrics v0.0.0-20180125231941-8732c616f529
 	github.com/satori/go.uuid v1.2.0
 	github.com/spf13/afero v1.5.1 // indirect
@@ -48,7 +53,10 @@ require (
 	golang.org/x/crypto v0.0.0-20210220033148-5ea612d1eb83 // indirect
 	golang.org/x/sys v0.0.0-20210616094352-59db8d763f22 // indirect
 	golang.org/x/term v0.0.0-20210220032956-6a3ed077a48d // indirect
+	golang.org/x/text v0.3.5
 	golang.org/x/tools v0.1.4 // indirect
+	google.golang.org/grpc v1.36.0
+	google.golang.org/protobuf v1.25.0
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 	gopkg.in/olivere/elastic.v5 v5.0.66
 	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b // indirect
@@ -21,5 +21,6 @@ type KhanInterface interface {
 	TransferOwnership(context.Context, string, string) (*TransferOwnershipResult, error)
 	UpdateClan(context.Context, *ClanPayload) (*Result, error)
 	UpdatePlayer(context.Context, string, string, interface{}) (*Result, error)
+	UpdatePlayerIfMatch(context.Context, string, string, interface{}, int64) (*Result, error)
 	SearchClans(context.Context, string) (*SearchClansResult, error)
 }
@@ -128,6 +128,11 @@ func NewKhanWithParams(params *KhanParams) KhanInterface {
 }
 
 func (k *Khan) sendTo(ctx context.Context, method, url string, payload interface{}) ([]byte, error) {
+	return k.sendToIfMatch(ctx, method, url, payload, 0)
+}
+
+// sendToIfMatch sends the request with an If-Match header holding version, unless version is zero
+func (k *Khan) sendToIfMatch(ctx context.Context, method, url string, payload interface{}, version int64) ([]byte, error) {
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
@@ -147,6 +152,9 @@ func (k *Khan) sendTo(ctx context.Context, method, url string, payload interface
 		}
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if version != 0 {
+		req.Header.Set("If-Match", fmt.Sprintf("\"%d\"", version))
+	}
 	req.SetBasicAuth(k.user, k.pass)
 	if ctx == nil {
 		ctx = context.Background()
@@ -290,10 +298,21 @@ func (k *Khan) UpdatePlayer(
 	ctx context.Context,
 	publicID, name string,
 	metadata interface{},
+) (*Result, error) {
+	return k.UpdatePlayerIfMatch(ctx, publicID, name, metadata, 0)
+}
+
+// UpdatePlayerIfMatch calls khan to update the player only if it is at the given version.
+// Khan answers with status 412 if the player was changed since that version
+func (k *Khan) UpdatePlayerIfMatch(
+	ctx context.Context,
+	publicID, name string,
+	metadata interface{},
+	version int64,
 ) (*Result, error) {
 	route := k.buildUpdatePlayerURL(publicID)
 	playerPayload := &Player{Name: name, Metadata: metadata}
-	body, err := k.sendTo(ctx, "PUT", route, playerPayload)
+	body, err := k.sendToIfMatch(ctx, "PUT", route, playerPayload, version)
 	if err != nil {
 		return nil, err
 	}
@@ -331,10 +350,11 @@ func (k *Khan) CreateClan(ctx context.Context, clan *ClanPayload) (string, error
 	return player.PublicID, err
 }
 
-// UpdateClan calls the update clan route from khan
+// UpdateClan calls the update clan route from khan. If clan.Version is set, the clan
+// is only updated if it is at that version
 func (k *Khan) UpdateClan(ctx context.Context, clan *ClanPayload) (*Result, error) {
 	route := k.buildUpdateClanURL(clan.PublicID)
-	body, err := k.sendTo(ctx, "PUT", route, clan)
+	body, err := k.sendToIfMatch(ctx, "PUT", route, clan, clan.Version)
 	if err != nil {
 		return nil, err
 	}
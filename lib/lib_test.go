@@ -1,6 +1,8 @@
 package lib_test
 
 import (
+	"net/http"
+
 	"github.com/jarcoal/httpmock"
 	"github.com/spf13/viper"
 	"github.com/topfreegames/khan/lib"
@@ -65,6 +67,37 @@ var _ = Describe("Lib", func() {
 		})
 	})
 
+	Describe("UpdatePlayerIfMatch", func() {
+		It("Should call khan API to update player with If-Match header", func() {
+			publicID := "testid"
+			url := "http://khan/games/" + gameID + "/players/" + publicID
+			var ifMatch string
+			httpmock.RegisterResponder("PUT", url,
+				func(req *http.Request) (*http.Response, error) {
+					ifMatch = req.Header.Get("If-Match")
+					return httpmock.NewStringResponse(200, `{ "success": true }`), nil
+				})
+
+			result, err := k.UpdatePlayerIfMatch(nil, publicID, "testname", nil, 3)
+
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(&lib.Result{Success: true}))
+			Expect(ifMatch).To(Equal(`"3"`))
+		})
+
+		It("Should return error with status 412 if player version does not match", func() {
+			publicID := "testid"
+			url := "http://khan/games/" + gameID + "/players/" + publicID
+			httpmock.RegisterResponder("PUT", url,
+				httpmock.NewStringResponder(412, `{ "success": false, "reason": "Player testid is not at version 3" }`))
+
+			_, err := k.UpdatePlayerIfMatch(nil, publicID, "testname", nil, 3)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*lib.RequestError).Status()).To(Equal(412))
+		})
+	})
+
 	Describe("RetrievePlayer", func() {
 		It("Should call khan API to retrieve player", func() {
 			publicID := "testid"
@@ -288,3 +288,18 @@ func (mr *MockKhanInterfaceMockRecorder) UpdatePlayer(arg0, arg1, arg2, arg3 int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlayer", reflect.TypeOf((*MockKhanInterface)(nil).UpdatePlayer), arg0, arg1, arg2, arg3)
 }
+
+// UpdatePlayerIfMatch mocks base method
+func (m *MockKhanInterface) UpdatePlayerIfMatch(arg0 context.Context, arg1, arg2 string, arg3 interface{}, arg4 int64) (*lib.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlayerIfMatch", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*lib.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePlayerIfMatch indicates an expected call of UpdatePlayerIfMatch
+func (mr *MockKhanInterfaceMockRecorder) UpdatePlayerIfMatch(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlayerIfMatch", reflect.TypeOf((*MockKhanInterface)(nil).UpdatePlayerIfMatch), arg0, arg1, arg2, arg3, arg4)
+}
@@ -32,6 +32,7 @@ type ClanPayload struct {
 	Metadata         interface{} `json:"metadata"`
 	AllowApplication bool        `json:"allowApplication"`
 	AutoJoin         bool        `json:"autoJoin"`
+	Version          int64       `json:"-"`
 }
 
 // Player defines the struct returned by the khan API for retrieve player
@@ -41,6 +42,7 @@ type Player struct {
 	Metadata    interface{}         `json:"metadata"`
 	Clans       *ClansRelationships `json:"clans,omitempty"`
 	Memberships []*PlayerMembership `json:"memberships,omitempty"`
+	Version     int64               `json:"version,omitempty"`
 }
 
 // ClansRelationships defines the struct returned inside player
@@ -146,6 +148,7 @@ type Clan struct {
 	Owner            *ShortPlayerInfo  `json:"owner"`
 	Roster           []*ClanMembership `json:"roster"`
 	Memberships      *ClanMemberships  `json:"memberships"`
+	Version          int64             `json:"version"`
 }
 
 // ApplicationPayload is the argument on apply for membership
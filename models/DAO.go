@@ -21,6 +21,7 @@ type clanDetailsDAO struct {
 	ClanAllowApplication bool
 	ClanAutoJoin         bool
 	ClanMembershipCount  int
+	ClanVersion          int64
 
 	//Membership Information
 	MembershipLevel      sql.NullString
@@ -103,6 +104,7 @@ type playerDetailsDAO struct {
 	PlayerPublicID  string
 	PlayerCreatedAt int64
 	PlayerUpdatedAt int64
+	PlayerVersion   int64
 
 	// Membership Details
 	MembershipLevel      sql.NullString
@@ -184,7 +186,7 @@ func (p *playerDetailsDAO) Serialize(encryptionKey []byte) map[string]interface{
 	} else {
 		p.RequestorMetadata = map[string]interface{}{}
 	}
-	result["requestor"].(map[string]interface{})["metadata"] = p.RequestorMetadata
+	result["requestor"].(map[string]interface{})["metadata"] = decryptMetadata(p.RequestorMetadata, encryptionKey)
 
 	if p.DeletedByPublicID.Valid {
 		deleter := &Player{
@@ -0,0 +1,164 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/satori/go.uuid"
+	"github.com/topfreegames/khan/util"
+)
+
+// API key scopes. Every scope allows reading the game but exporting players, and game-admin allows everything
+const (
+	APIKeyScopeReadOnly     = "read-only"
+	APIKeyScopePlayerWrite  = "player-write"
+	APIKeyScopePlayerExport = "player-export"
+	APIKeyScopeClanWrite    = "clan-write"
+	APIKeyScopeGameAdmin    = "game-admin"
+)
+
+// apiKeySize is the number of random bytes of an API key
+const apiKeySize = 32
+
+var apiKeyScopes = map[string]bool{
+	APIKeyScopeReadOnly:     true,
+	APIKeyScopePlayerWrite:  true,
+	APIKeyScopePlayerExport: true,
+	APIKeyScopeClanWrite:    true,
+	APIKeyScopeGameAdmin:    true,
+}
+
+// APIKey identifies a credential of a game. Only the hash of the key is stored
+type APIKey struct {
+	ID        int64          `db:"id"`
+	PublicID  string         `db:"public_id"`
+	GameID    string         `db:"game_id"`
+	KeyHash   string         `db:"key_hash"`
+	Scopes    pq.StringArray `db:"scopes"`
+	CreatedAt int64          `db:"created_at"`
+	RevokedAt sql.NullInt64  `db:"revoked_at"`
+}
+
+// Allows returns whether the key grants the given scope
+func (k *APIKey) Allows(scope string) bool {
+	if scope == APIKeyScopeReadOnly {
+		return true
+	}
+	for _, s := range k.Scopes {
+		if s == scope || s == APIKeyScopeGameAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// Serialize returns a JSON with the API key details, which do not include the key
+func (k *APIKey) Serialize() map[string]interface{} {
+	serialized := map[string]interface{}{
+		"publicID":  k.PublicID,
+		"gameID":    k.GameID,
+		"scopes":    []string(k.Scopes),
+		"createdAt": k.CreatedAt,
+	}
+	if k.RevokedAt.Valid {
+		serialized["revokedAt"] = k.RevokedAt.Int64
+	}
+	return serialized
+}
+
+// CreateAPIKey issues a new API key for the game with the given scopes. The key is only returned here,
+// since only its hash is stored
+func CreateAPIKey(db DB, gameID string, scopes []string) (*APIKey, string, error) {
+	if len(scopes) == 0 {
+		return nil, "", &InvalidAPIKeyScopeError{""}
+	}
+	for _, scope := range scopes {
+		if !apiKeyScopes[scope] {
+			return nil, "", &InvalidAPIKeyScopeError{scope}
+		}
+	}
+
+	if _, err := GetGameByPublicID(db, gameID); err != nil {
+		return nil, "", err
+	}
+
+	key, err := util.GenerateToken(apiKeySize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiKey := &APIKey{
+		PublicID:  uuid.NewV4().String(),
+		GameID:    gameID,
+		KeyHash:   util.HashToken(key),
+		Scopes:    pq.StringArray(scopes),
+		CreatedAt: util.NowMilli(),
+	}
+	_, err = db.Exec(`
+	INSERT INTO api_keys (public_id, game_id, key_hash, scopes, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	`, apiKey.PublicID, apiKey.GameID, apiKey.KeyHash, apiKey.Scopes, apiKey.CreatedAt)
+	if err != nil {
+		return nil, "", err
+	}
+	return apiKey, key, nil
+}
+
+// GetAPIKeyByKey returns the API key that was issued as key, unless it was revoked
+func GetAPIKeyByKey(db DB, key string) (*APIKey, error) {
+	var apiKeys []*APIKey
+	_, err := db.Select(
+		&apiKeys,
+		"SELECT * FROM api_keys WHERE key_hash=$1 AND revoked_at IS NULL",
+		util.HashToken(key),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(apiKeys) == 0 {
+		return nil, &ModelNotFoundError{"APIKey", "key"}
+	}
+	return apiKeys[0], nil
+}
+
+// GetAPIKeys returns the API keys of a game that were not revoked, oldest first
+func GetAPIKeys(db DB, gameID string) ([]*APIKey, error) {
+	if _, err := GetGameByPublicID(db, gameID); err != nil {
+		return nil, err
+	}
+
+	var apiKeys []*APIKey
+	_, err := db.Select(
+		&apiKeys,
+		"SELECT * FROM api_keys WHERE game_id=$1 AND revoked_at IS NULL ORDER BY id",
+		gameID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return apiKeys, nil
+}
+
+// RevokeAPIKey revokes an API key of the game, which can no longer be used, and returns it
+func RevokeAPIKey(db DB, gameID, publicID string) (*APIKey, error) {
+	var apiKeys []*APIKey
+	_, err := db.Select(
+		&apiKeys,
+		"UPDATE api_keys SET revoked_at=$3 WHERE game_id=$1 AND public_id=$2 AND revoked_at IS NULL RETURNING *",
+		gameID, publicID, util.NowMilli(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(apiKeys) == 0 {
+		return nil, &ModelNotFoundError{"APIKey", publicID}
+	}
+	return apiKeys[0], nil
+}
@@ -0,0 +1,118 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	. "github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+var _ = Describe("API Key Model", func() {
+	var testDb DB
+
+	BeforeEach(func() {
+		var err error
+		testDb, err = GetTestDB()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	createGame := func() *Game {
+		game := fixtures.GameFactory.MustCreate().(*Game)
+		err := testDb.Insert(game)
+		Expect(err).NotTo(HaveOccurred())
+		return game
+	}
+
+	Describe("Create API Key", func() {
+		It("Should create an API key storing only its hash", func() {
+			game := createGame()
+
+			apiKey, key, err := CreateAPIKey(testDb, game.PublicID, []string{APIKeyScopeClanWrite})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(key).To(HaveLen(64))
+			Expect(apiKey.KeyHash).NotTo(Equal(key))
+
+			dbKey, err := GetAPIKeyByKey(testDb, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbKey.PublicID).To(Equal(apiKey.PublicID))
+			Expect(dbKey.GameID).To(Equal(game.PublicID))
+			Expect([]string(dbKey.Scopes)).To(Equal([]string{APIKeyScopeClanWrite}))
+		})
+
+		It("Should not create an API key with invalid scopes", func() {
+			game := createGame()
+
+			_, _, err := CreateAPIKey(testDb, game.PublicID, []string{"invalid"})
+			Expect(err).To(BeAssignableToTypeOf(&InvalidAPIKeyScopeError{}))
+		})
+
+		It("Should not create an API key for a game that does not exist", func() {
+			_, _, err := CreateAPIKey(testDb, uuid.NewV4().String(), []string{APIKeyScopeReadOnly})
+			Expect(err).To(BeAssignableToTypeOf(&ModelNotFoundError{}))
+		})
+	})
+
+	Describe("Revoke API Key", func() {
+		It("Should not find revoked API keys", func() {
+			game := createGame()
+			apiKey, key, err := CreateAPIKey(testDb, game.PublicID, []string{APIKeyScopeReadOnly})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = RevokeAPIKey(testDb, game.PublicID, apiKey.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = GetAPIKeyByKey(testDb, key)
+			Expect(err).To(BeAssignableToTypeOf(&ModelNotFoundError{}))
+			apiKeys, err := GetAPIKeys(testDb, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apiKeys).To(BeEmpty())
+		})
+
+		It("Should not revoke API keys of other games", func() {
+			game := createGame()
+			otherGame := createGame()
+			apiKey, _, err := CreateAPIKey(testDb, game.PublicID, []string{APIKeyScopeReadOnly})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = RevokeAPIKey(testDb, otherGame.PublicID, apiKey.PublicID)
+			Expect(err).To(BeAssignableToTypeOf(&ModelNotFoundError{}))
+		})
+	})
+
+	Describe("API Key Scopes", func() {
+		It("Should allow reads with any scope", func() {
+			apiKey := &APIKey{Scopes: []string{APIKeyScopePlayerWrite}}
+			Expect(apiKey.Allows(APIKeyScopeReadOnly)).To(BeTrue())
+		})
+
+		It("Should only allow writes with the scope", func() {
+			apiKey := &APIKey{Scopes: []string{APIKeyScopePlayerWrite}}
+			Expect(apiKey.Allows(APIKeyScopePlayerWrite)).To(BeTrue())
+			Expect(apiKey.Allows(APIKeyScopeClanWrite)).To(BeFalse())
+			Expect(apiKey.Allows(APIKeyScopeGameAdmin)).To(BeFalse())
+		})
+
+		It("Should only allow exporting players with the scope", func() {
+			apiKey := &APIKey{Scopes: []string{APIKeyScopeReadOnly, APIKeyScopePlayerWrite}}
+			Expect(apiKey.Allows(APIKeyScopePlayerExport)).To(BeFalse())
+			apiKey = &APIKey{Scopes: []string{APIKeyScopePlayerExport}}
+			Expect(apiKey.Allows(APIKeyScopePlayerExport)).To(BeTrue())
+		})
+
+		It("Should allow everything with game-admin", func() {
+			apiKey := &APIKey{Scopes: []string{APIKeyScopeGameAdmin}}
+			Expect(apiKey.Allows(APIKeyScopePlayerExport)).To(BeTrue())
+			Expect(apiKey.Allows(APIKeyScopePlayerWrite)).To(BeTrue())
+			Expect(apiKey.Allows(APIKeyScopeClanWrite)).To(BeTrue())
+			Expect(apiKey.Allows(APIKeyScopeGameAdmin)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,199 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-gorp/gorp"
+	egorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
+)
+
+// CacheInvalidator removes the cached payloads made stale by writes to the models
+type CacheInvalidator interface {
+	InvalidateGame(gameID string)
+	InvalidateClans(gameID string, clanPublicIDs ...string)
+	InvalidatePlayers(gameID string, playerPublicIDs ...string)
+}
+
+var cacheInvalidator CacheInvalidator
+
+// SetCacheInvalidator configures the invalidator called after games, clans, players and memberships are written
+func SetCacheInvalidator(invalidator CacheInvalidator) {
+	cacheInvalidator = invalidator
+}
+
+// pendingInvalidations holds the invalidations of the models written in each open transaction
+var pendingInvalidations = struct {
+	sync.Mutex
+	byTransaction map[*gorp.Transaction][]func()
+}{byTransaction: make(map[*gorp.Transaction][]func())}
+
+func getTransaction(db interface{}) *gorp.Transaction {
+	switch tx := db.(type) {
+	case *gorp.Transaction:
+		return tx
+	case interface{ Inner() *gorp.Transaction }:
+		return tx.Inner()
+	}
+	return nil
+}
+
+// afterCommit runs invalidate once the transaction of db is committed, so concurrent readers cannot cache
+// rows that are about to change and rolled back writes keep their cache. It runs right away outside
+// transactions, since the writes are already committed
+func afterCommit(db DB, invalidate func()) {
+	tx := getTransaction(db)
+	if tx == nil {
+		invalidate()
+		return
+	}
+	pendingInvalidations.Lock()
+	defer pendingInvalidations.Unlock()
+	pendingInvalidations.byTransaction[tx] = append(pendingInvalidations.byTransaction[tx], invalidate)
+}
+
+func takePendingInvalidations(tx egorp.Transaction) []func() {
+	inner := getTransaction(tx)
+	if inner == nil {
+		return nil
+	}
+	pendingInvalidations.Lock()
+	defer pendingInvalidations.Unlock()
+	invalidations := pendingInvalidations.byTransaction[inner]
+	delete(pendingInvalidations.byTransaction, inner)
+	return invalidations
+}
+
+// Commit commits a transaction and then invalidates the cached payloads of the models written in it.
+// Transactions that write models must be finished with Commit or Rollback
+func Commit(tx egorp.Transaction) error {
+	err := tx.Commit()
+	invalidations := takePendingInvalidations(tx)
+	if err != nil {
+		return err
+	}
+	for _, invalidate := range invalidations {
+		invalidate()
+	}
+	return nil
+}
+
+// Rollback rolls back a transaction, keeping the cached payloads of the models written in it
+func Rollback(tx egorp.Transaction) error {
+	takePendingInvalidations(tx)
+	return tx.Rollback()
+}
+
+func invalidateGameCache(db DB, gameID string) {
+	if cacheInvalidator != nil {
+		afterCommit(db, func() {
+			cacheInvalidator.InvalidateGame(gameID)
+		})
+	}
+}
+
+// invalidateClanCache invalidates a clan and the players with memberships in it, since their details include the clan
+func invalidateClanCache(db DB, clan *Clan) error {
+	if cacheInvalidator == nil {
+		return nil
+	}
+	var playerPublicIDs []string
+	_, err := db.Select(&playerPublicIDs, `
+	SELECT p.public_id FROM players p
+	WHERE p.id=$2 OR p.id IN (SELECT m.player_id FROM memberships m WHERE m.clan_id=$1)
+	`, clan.ID, clan.OwnerID)
+	if err != nil {
+		return err
+	}
+	afterCommit(db, func() {
+		cacheInvalidator.InvalidateClans(clan.GameID, clan.PublicID)
+		if len(playerPublicIDs) > 0 {
+			cacheInvalidator.InvalidatePlayers(clan.GameID, playerPublicIDs...)
+		}
+	})
+	return nil
+}
+
+func invalidateClanCacheByID(db DB, id int64) error {
+	if cacheInvalidator == nil {
+		return nil
+	}
+	clan, err := GetClanByID(db, id)
+	if err != nil {
+		return err
+	}
+	return invalidateClanCache(db, clan)
+}
+
+// invalidatePlayerCacheByID invalidates a player and the clans it owns or has memberships in, since their details include the player
+func invalidatePlayerCacheByID(db DB, id int64) error {
+	if cacheInvalidator == nil {
+		return nil
+	}
+	var players []struct {
+		GameID   string `db:"game_id"`
+		PublicID string `db:"public_id"`
+	}
+	_, err := db.Select(&players, "SELECT game_id, public_id FROM players WHERE id=$1", id)
+	if err != nil {
+		return err
+	}
+	if len(players) == 0 {
+		return &ModelNotFoundError{"Player", id}
+	}
+	var clanPublicIDs []string
+	_, err = db.Select(&clanPublicIDs, `
+	SELECT c.public_id FROM clans c
+	WHERE c.owner_id=$1 OR c.id IN (SELECT m.clan_id FROM memberships m WHERE m.player_id=$1)
+	`, id)
+	if err != nil {
+		return err
+	}
+	afterCommit(db, func() {
+		cacheInvalidator.InvalidatePlayers(players[0].GameID, players[0].PublicID)
+		if len(clanPublicIDs) > 0 {
+			cacheInvalidator.InvalidateClans(players[0].GameID, clanPublicIDs...)
+		}
+	})
+	return nil
+}
+
+// invalidatePlayersCache invalidates players of a game and the clans they own or have memberships in
+func invalidatePlayersCache(db DB, gameID string, playerPublicIDs []string) error {
+	if cacheInvalidator == nil || len(playerPublicIDs) == 0 {
+		return nil
+	}
+	placeholders := make([]string, len(playerPublicIDs))
+	args := []interface{}{gameID}
+	for i, publicID := range playerPublicIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args = append(args, publicID)
+	}
+	var clanPublicIDs []string
+	_, err := db.Select(&clanPublicIDs, fmt.Sprintf(`
+	SELECT DISTINCT c.public_id FROM clans c
+	LEFT OUTER JOIN memberships m ON m.clan_id=c.id
+	WHERE c.game_id=$1 AND (
+		c.owner_id IN (SELECT id FROM players WHERE game_id=$1 AND public_id IN (%[1]s)) OR
+		m.player_id IN (SELECT id FROM players WHERE game_id=$1 AND public_id IN (%[1]s))
+	)
+	`, strings.Join(placeholders, ", ")), args...)
+	if err != nil {
+		return err
+	}
+	afterCommit(db, func() {
+		cacheInvalidator.InvalidatePlayers(gameID, playerPublicIDs...)
+		if len(clanPublicIDs) > 0 {
+			cacheInvalidator.InvalidateClans(gameID, clanPublicIDs...)
+		}
+	})
+	return nil
+}
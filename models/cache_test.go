@@ -0,0 +1,165 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models_test
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	egorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
+	. "github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+type recordingInvalidator struct {
+	mutex   sync.Mutex
+	games   []string
+	clans   []string
+	players []string
+}
+
+func (i *recordingInvalidator) InvalidateGame(gameID string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.games = append(i.games, gameID)
+}
+
+func (i *recordingInvalidator) InvalidateClans(gameID string, clanPublicIDs ...string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.clans = append(i.clans, clanPublicIDs...)
+}
+
+func (i *recordingInvalidator) InvalidatePlayers(gameID string, playerPublicIDs ...string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.players = append(i.players, playerPublicIDs...)
+}
+
+var _ = Describe("Cache Invalidation", func() {
+	var testDb egorp.Database
+	var invalidator *recordingInvalidator
+
+	BeforeEach(func() {
+		var err error
+		testDb, err = GetTestDB()
+		Expect(err).NotTo(HaveOccurred())
+
+		invalidator = &recordingInvalidator{}
+		SetCacheInvalidator(invalidator)
+	})
+
+	AfterEach(func() {
+		SetCacheInvalidator(nil)
+	})
+
+	updateGame := func(db DB, game *Game) error {
+		_, err := UpdateGame(
+			db,
+			game.PublicID,
+			"game-new-name",
+			map[string]interface{}{"Member": 1, "Elder": 2, "CoLeader": 3},
+			map[string]interface{}{"x": "a"},
+			5, 4, 7, 1, 1, 1, 100, 1, 5, 15, 8, 25, 20,
+			"", "", "", nil, nil, nil,
+			0,
+		)
+		return err
+	}
+
+	It("Should invalidate games written outside transactions right away", func() {
+		game := fixtures.GameFactory.MustCreate().(*Game)
+		Expect(testDb.Insert(game)).To(Succeed())
+
+		Expect(updateGame(testDb, game)).To(Succeed())
+		Expect(invalidator.games).To(ConsistOf(game.PublicID))
+	})
+
+	It("Should invalidate games written in a transaction after it is committed", func() {
+		game := fixtures.GameFactory.MustCreate().(*Game)
+		Expect(testDb.Insert(game)).To(Succeed())
+
+		tx, err := testDb.Begin()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updateGame(tx, game)).To(Succeed())
+		Expect(invalidator.games).To(BeEmpty())
+
+		Expect(Commit(tx)).To(Succeed())
+		Expect(invalidator.games).To(ConsistOf(game.PublicID))
+	})
+
+	It("Should not invalidate games written in a transaction that is rolled back", func() {
+		game := fixtures.GameFactory.MustCreate().(*Game)
+		Expect(testDb.Insert(game)).To(Succeed())
+
+		tx, err := testDb.Begin()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updateGame(tx, game)).To(Succeed())
+
+		Expect(Rollback(tx)).To(Succeed())
+		Expect(invalidator.games).To(BeEmpty())
+	})
+
+	It("Should invalidate clans and their members written in a transaction after it is committed", func() {
+		_, clan, owner, players, _, err := fixtures.GetClanWithMemberships(testDb, 1, 0, 0, 0, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		// ignore the invalidations of the fixtures
+		invalidator = &recordingInvalidator{}
+		SetCacheInvalidator(invalidator)
+
+		tx, err := testDb.Begin()
+		Expect(err).NotTo(HaveOccurred())
+		clan.Name = "clan-new-name"
+		_, err = tx.Update(clan)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(invalidator.clans).To(BeEmpty())
+		Expect(invalidator.players).To(BeEmpty())
+
+		Expect(Commit(tx)).To(Succeed())
+		Expect(invalidator.clans).To(ConsistOf(clan.PublicID))
+		Expect(invalidator.players).To(ConsistOf(owner.PublicID, players[0].PublicID))
+	})
+
+	It("Should invalidate the clans and player of a deleted player after the transaction is committed", func() {
+		_, clan, _, players, _, err := fixtures.GetClanWithMemberships(testDb, 1, 0, 0, 0, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		// ignore the invalidations of the fixtures
+		invalidator = &recordingInvalidator{}
+		SetCacheInvalidator(invalidator)
+
+		tx, err := testDb.Begin()
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = DeletePlayer(tx, fixtures.GetEncryptionKey(), clan.GameID, players[0].PublicID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(invalidator.clans).To(BeEmpty())
+		Expect(invalidator.players).To(BeEmpty())
+
+		Expect(Commit(tx)).To(Succeed())
+		Expect(invalidator.clans).To(ContainElement(clan.PublicID))
+		Expect(invalidator.players).To(ContainElement(players[0].PublicID))
+	})
+
+	It("Should not invalidate clans written in a transaction that is rolled back", func() {
+		_, clan, _, _, _, err := fixtures.GetClanWithMemberships(testDb, 1, 0, 0, 0, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		// ignore the invalidations of the fixtures
+		invalidator = &recordingInvalidator{}
+		SetCacheInvalidator(invalidator)
+
+		tx, err := testDb.Begin()
+		Expect(err).NotTo(HaveOccurred())
+		clan.Name = "clan-new-name"
+		_, err = tx.Update(clan)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(Rollback(tx)).To(Succeed())
+		Expect(invalidator.clans).To(BeEmpty())
+		Expect(invalidator.players).To(BeEmpty())
+	})
+})
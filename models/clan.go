@@ -53,6 +53,7 @@ type Clan struct {
 	CreatedAt        int64                  `db:"created_at" json:"createdAt" bson:"createdAt"`
 	UpdatedAt        int64                  `db:"updated_at" json:"updatedAt" bson:"updatedAt"`
 	DeletedAt        int64                  `db:"deleted_at" json:"deletedAt" bson:"deletedAt"`
+	Version          int64                  `db:"version" json:"version" bson:"version"`
 }
 
 // ClanWithNamePrefixes extends Clan with a field to help name indexation in MongoDB
@@ -130,6 +131,7 @@ func GetClanFromJSON(data []byte) (*Clan, error) {
 func (c *Clan) PreInsert(s gorp.SqlExecutor) error {
 	c.CreatedAt = util.NowMilli()
 	c.UpdatedAt = c.CreatedAt
+	c.Version = 1
 	return nil
 }
 
@@ -139,7 +141,7 @@ func (c *Clan) PostInsert(s gorp.SqlExecutor) error {
 	if err != nil {
 		return err
 	}
-	err = c.UpdateClanIntoMongoDB()
+	err = c.UpdateClanIntoMongoDB(s)
 	return err
 }
 
@@ -151,17 +153,25 @@ func (c *Clan) PreUpdate(s gorp.SqlExecutor) error {
 
 //PostUpdate indexes clan in ES after update in PG
 func (c *Clan) PostUpdate(s gorp.SqlExecutor) error {
-	err := c.UpdateClanIntoElasticSearch()
+	err := invalidateClanCache(s, c)
 	if err != nil {
 		return err
 	}
-	err = c.UpdateClanIntoMongoDB()
+	err = c.UpdateClanIntoElasticSearch()
+	if err != nil {
+		return err
+	}
+	err = c.UpdateClanIntoMongoDB(s)
 	return err
 }
 
 //PostDelete deletes clan from elasticsearch after deleting from PG
 func (c *Clan) PostDelete(s gorp.SqlExecutor) error {
-	err := c.DeleteClanFromElasticSearch()
+	err := invalidateClanCache(s, c)
+	if err != nil {
+		return err
+	}
+	err = c.DeleteClanFromElasticSearch()
 	if err != nil {
 		return err
 	}
@@ -196,48 +206,40 @@ func (c *Clan) IndexClanIntoElasticSearch() error {
 	return nil
 }
 
-// NewClanWithNamePrefixes returns a new extended Clan object with name  prefixes
-func (c *Clan) NewClanWithNamePrefixes() *ClanWithNamePrefixes {
-	minPrefixLength := 4 // TODO: how to bring the app Viper config here?
-	caseSensitiveWords := strings.Fields(c.Name)
-	foundPrefixes := make(map[string]bool)
-	var prefixes []string
-	for _, caseSensitiveWord := range caseSensitiveWords {
-		word := strings.ToLower(caseSensitiveWord)
-		wordLen := len(word)
-		firstPrefixIdx := minPrefixLength
-		if firstPrefixIdx > wordLen {
-			firstPrefixIdx = wordLen
-		}
-		for i := firstPrefixIdx; i <= wordLen; i++ {
-			prefix := word[:i]
-			if !foundPrefixes[prefix] {
-				foundPrefixes[prefix] = true
-				prefixes = append(prefixes, prefix)
-			}
-		}
+// NewClanWithNamePrefixes returns a new extended Clan object with name prefixes computed
+// according to the game search settings. A nil settings uses the default ones.
+func (c *Clan) NewClanWithNamePrefixes(settings *SearchSettings) *ClanWithNamePrefixes {
+	if settings == nil {
+		settings = &SearchSettings{}
 	}
-
 	return &ClanWithNamePrefixes{
 		Clan:         *c,
-		NamePrefixes: prefixes,
+		NamePrefixes: settings.NamePrefixes(c.Name),
 	}
 }
 
 // UpdateClanIntoMongoDB after operation in PG
-func (c *Clan) UpdateClanIntoMongoDB() error {
+func (c *Clan) UpdateClanIntoMongoDB(db DB) error {
 	mongo := mongo.GetConfiguredMongoClient()
 	if mongo != nil {
-		workers.Enqueue(queues.KhanMongoQueue, "Add", map[string]interface{}{
-			"game":   c.GameID,
-			"op":     "update",
-			"clan":   c.NewClanWithNamePrefixes(),
-			"clanID": c.PublicID,
-		})
+		settings, err := GetGameSearchSettings(db, c.GameID)
+		if err != nil {
+			return err
+		}
+		c.enqueueMongoUpdate(settings)
 	}
 	return nil
 }
 
+func (c *Clan) enqueueMongoUpdate(settings *SearchSettings) {
+	workers.Enqueue(queues.KhanMongoQueue, "Add", map[string]interface{}{
+		"game":   c.GameID,
+		"op":     "update",
+		"clan":   c.NewClanWithNamePrefixes(settings),
+		"clanID": c.PublicID,
+	})
+}
+
 //DeleteClanFromMongoDB after deletion in PG
 func (c *Clan) DeleteClanFromMongoDB() error {
 	mongo := mongo.GetConfiguredMongoClient()
@@ -301,7 +303,7 @@ func updateClanIntoMongo(db DB, id int64) error {
 	if clan == nil {
 		return &ModelNotFoundError{"Clan", id}
 	}
-	return clan.UpdateClanIntoMongoDB()
+	return clan.UpdateClanIntoMongoDB(db)
 }
 
 // Serialize returns a JSON with clan details
@@ -343,6 +345,12 @@ func UpdateClanMembershipCount(db DB, id int64) error {
 		return &ModelNotFoundError{"Clan", id}
 	}
 
+	err = invalidateClanCacheByID(db, id)
+
+	if err != nil {
+		return err
+	}
+
 	err = updateClanIntoES(db, id)
 
 	if err != nil {
@@ -360,14 +368,16 @@ func UpdateClanMembershipCount(db DB, id int64) error {
 
 // GetClanByID returns a clan by id
 func GetClanByID(db DB, id int64) (*Clan, error) {
-	obj, err := db.Get(Clan{}, id)
+	// db.Get does not read transient columns such as version
+	var clans []*Clan
+	_, err := db.Select(&clans, "SELECT * FROM clans WHERE id=$1", id)
 	if err != nil {
 		return nil, err
 	}
-	if obj == nil {
+	if len(clans) < 1 {
 		return nil, &ModelNotFoundError{"Clan", id}
 	}
-	return obj.(*Clan), nil
+	return clans[0], nil
 }
 
 // GetClanByPublicID returns a clan by its public id
@@ -383,6 +393,19 @@ func GetClanByPublicID(db DB, gameID, publicID string) (*Clan, error) {
 	return clans[0], nil
 }
 
+// GetClanByPublicIDForUpdate returns a clan by its public id, locking it until the transaction of db ends
+func GetClanByPublicIDForUpdate(db DB, gameID, publicID string) (*Clan, error) {
+	var clans []*Clan
+	_, err := db.Select(&clans, "SELECT * FROM clans WHERE game_id=$1 AND public_id=$2 FOR UPDATE", gameID, publicID)
+	if err != nil {
+		return nil, err
+	}
+	if len(clans) < 1 {
+		return nil, &ModelNotFoundError{"Clan", publicID}
+	}
+	return clans[0], nil
+}
+
 // GetClanByShortPublicID returns a clan by the beginning of its public id
 func GetClanByShortPublicID(db DB, gameID, publicID string) (*Clan, error) {
 	var clans []*Clan
@@ -539,6 +562,10 @@ func LeaveClan(db DB, encryptionKey []byte, gameID, publicID string) (*Clan, *Pl
 		noMembersError := &ClanHasNoMembersError{publicID}
 		if err.Error() == noMembersError.Error() {
 			// Clan has no approved members, delete all members and clan
+			err = invalidateClanCache(db, clan)
+			if err != nil {
+				return nil, nil, nil, err
+			}
 			_, err = db.Exec("DELETE FROM memberships where clan_id=$1", clan.ID)
 			if err != nil {
 				return nil, nil, nil, err
@@ -696,8 +723,8 @@ func getClanLevelByLevelInt(levelInt int, levels map[string]interface{}) string
 	return ""
 }
 
-// UpdateClan updates an existing clan
-func UpdateClan(db DB, gameID, publicID, name, ownerPublicID string, metadata map[string]interface{}, allowApplication, autoJoin bool) (*Clan, error) {
+// UpdateClan updates an existing clan. If expectedVersion is not zero, the clan is only updated if it is at that version
+func UpdateClan(db DB, gameID, publicID, name, ownerPublicID string, metadata map[string]interface{}, allowApplication, autoJoin bool, expectedVersion int64) (*Clan, error) {
 	clan, err := GetClanByPublicIDAndOwnerPublicID(db, gameID, publicID, ownerPublicID)
 	if err != nil {
 		return nil, err
@@ -716,13 +743,19 @@ func UpdateClan(db DB, gameID, publicID, name, ownerPublicID string, metadata ma
 	}
 
 	query := `
-		UPDATE clans SET name=$1, metadata=$2, allow_application=$3, auto_join=$4
-		WHERE clans.id=$5
+		UPDATE clans SET name=$1, metadata=$2, allow_application=$3, auto_join=$4, version=version+1
+		WHERE clans.id=$5 AND ($6::bigint=0 OR clans.version=$6)
+		RETURNING version
 	`
-	_, err = db.Exec(query, name, metadataBuffer.String(), allowApplication, autoJoin, clan.ID)
+	var versions []int64
+	_, err = db.Select(&versions, query, name, metadataBuffer.String(), allowApplication, autoJoin, clan.ID, expectedVersion)
 	if err != nil {
 		return nil, err
 	}
+	if len(versions) == 0 {
+		return nil, &VersionMismatchError{"Clan", publicID, expectedVersion}
+	}
+	clan.Version = versions[0]
 
 	// since this function should update only the 4 fields above,
 	// we cannot use db.Update(clan), so clan.PostUpdate() should
@@ -739,6 +772,77 @@ func UpdateClan(db DB, gameID, publicID, name, ownerPublicID string, metadata ma
 	return clan, nil
 }
 
+// ClanPatch holds the changes to a clan. Nil fields are not changed and Metadata is a JSON Merge Patch
+// (RFC 7396) of the clan metadata
+type ClanPatch struct {
+	Name             *string
+	AllowApplication *bool
+	AutoJoin         *bool
+	Metadata         map[string]interface{}
+	// ClearMetadata removes every metadata field before Metadata is merged
+	ClearMetadata bool
+	// ExpectedVersion, if not zero, is the version the clan must be at to be patched
+	ExpectedVersion int64
+}
+
+// PatchClan applies patch to the clan owned by ownerPublicID. The metadata patch is applied by the
+// database, so concurrent patches to different metadata fields do not overwrite each other
+func PatchClan(db DB, gameID, publicID, ownerPublicID string, patch *ClanPatch) (*Clan, error) {
+	clan, err := GetClanByPublicIDAndOwnerPublicID(db, gameID, publicID, ownerPublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataPatch := patch.Metadata
+	if metadataPatch == nil {
+		metadataPatch = map[string]interface{}{}
+	}
+	metadataJSON, err := json.Marshal(metadataPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		UPDATE clans SET
+			name=COALESCE($1, name),
+			allow_application=COALESCE($2, allow_application),
+			auto_join=COALESCE($3, auto_join),
+			metadata=jsonb_merge_patch(CASE WHEN $7 THEN '{}'::jsonb ELSE metadata END, $4),
+			updated_at=$5,
+			version=version+1
+		WHERE clans.id=$6 AND ($8::bigint=0 OR clans.version=$8)
+		RETURNING id
+	`
+	var ids []int64
+	_, err = db.Select(
+		&ids, query, patch.Name, patch.AllowApplication, patch.AutoJoin, string(metadataJSON), util.NowMilli(), clan.ID,
+		patch.ClearMetadata, patch.ExpectedVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, &VersionMismatchError{"Clan", publicID, patch.ExpectedVersion}
+	}
+
+	clan, err = GetClanByID(db, clan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// as in UpdateClan, clan.PostUpdate() is not called by db.Exec
+	gorpSQLExecutor, ok := db.(gorp.SqlExecutor)
+	if !ok {
+		return nil, &InvalidCastToGorpSQLExecutorError{}
+	}
+	err = clan.PostUpdate(gorpSQLExecutor)
+	if err != nil {
+		return nil, err
+	}
+
+	return clan, nil
+}
+
 // GetAllClans returns a list of all clans in a given game
 func GetAllClans(db DB, gameID string) ([]Clan, error) {
 	if gameID == "" {
@@ -801,7 +905,7 @@ func GetClanDetails(db DB, encryptionKey []byte, gameID string, clan *Clan, maxC
 		c.game_id GameID,
 		c.public_id ClanPublicID, c.name ClanName, c.metadata ClanMetadata,
 		c.allow_application ClanAllowApplication, c.auto_join ClanAutoJoin,
-		c.membership_count ClanMembershipCount,
+		c.membership_count ClanMembershipCount, c.version ClanVersion,
 		m.membership_level MembershipLevel, m.approved MembershipApproved, m.denied MembershipDenied,
 		m.banned MembershipBanned, m.message MembershipMessage,
 		m.created_at MembershipCreatedAt, m.updated_at MembershipUpdatedAt,
@@ -861,6 +965,7 @@ func GetClanDetails(db DB, encryptionKey []byte, gameID string, clan *Clan, maxC
 	result["allowApplication"] = details[0].ClanAllowApplication
 	result["autoJoin"] = details[0].ClanAutoJoin
 	result["membershipCount"] = details[0].ClanMembershipCount
+	result["version"] = details[0].ClanVersion
 
 	owner := &Player{
 		PublicID: details[0].OwnerPublicID,
@@ -990,7 +1095,7 @@ func searchClanByID(db DB, gameID, publicID string) []Clan {
 
 // SearchClan returns a list of clans for a given term (by name or publicID)
 func SearchClan(
-	db DB, mongo interfaces.MongoDB, gameID, term string, pageSize int64,
+	db DB, mongo interfaces.MongoDB, gameID, term string, pageSize int64, settings *SearchSettings,
 ) ([]Clan, error) {
 	if term == "" {
 		return nil, &EmptySearchTermError{}
@@ -1001,10 +1106,14 @@ func SearchClan(
 		return clans, nil
 	}
 
+	if settings == nil {
+		settings = &SearchSettings{}
+	}
+
 	projection := bson.M{"textSearchScore": bson.M{"$meta": "textScore"}}
 	cmd := bson.D{
 		{Name: "find", Value: fmt.Sprintf("clans_%s", gameID)},
-		{Name: "filter", Value: bson.M{"$text": bson.M{"$search": term}}},
+		{Name: "filter", Value: settings.TextSearchFilter(term)},
 		{Name: "projection", Value: projection},
 		{Name: "sort", Value: projection},
 		{Name: "limit", Value: pageSize},
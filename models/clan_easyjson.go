@@ -80,6 +80,8 @@ func easyjson91eb9988DecodeGithubComTopfreegamesKhanModels(in *jlexer.Lexer, out
 			out.UpdatedAt = int64(in.Int64())
 		case "deletedAt":
 			out.DeletedAt = int64(in.Int64())
+		case "version":
+			out.Version = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -176,6 +178,11 @@ func easyjson91eb9988EncodeGithubComTopfreegamesKhanModels(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.Int64(int64(in.DeletedAt))
 	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Version))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,297 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/topfreegames/extensions/v9/mongo/interfaces"
+)
+
+// ClanNameSuggestion is a clan returned by name suggestion or fuzzy lookup
+type ClanNameSuggestion struct {
+	PublicID string `json:"publicID"`
+	Name     string `json:"name"`
+}
+
+type clanNameTrieNode struct {
+	children map[rune]*clanNameTrieNode
+	clans    []ClanNameSuggestion
+}
+
+type clanNameTrieMatch struct {
+	clan     ClanNameSuggestion
+	distance int
+}
+
+// ClanNameTrie is an in-process index of the clan names of a game. Each clan is indexed by its
+// normalized name and by every suffix of it starting at a word, so lookups match any word start.
+// Clans with the same key are sorted by name, so lookups return the same clans in every process
+type ClanNameTrie struct {
+	settings *SearchSettings
+	root     *clanNameTrieNode
+	names    map[string]string
+	// updatedAt is the latest update time of the clans read from MongoDB
+	updatedAt int64
+	mutex     sync.RWMutex
+}
+
+// NewClanNameTrie returns an empty trie that normalizes names with the game search settings.
+// A nil settings uses the default ones.
+func NewClanNameTrie(settings *SearchSettings) *ClanNameTrie {
+	if settings == nil {
+		settings = &SearchSettings{}
+	}
+	return &ClanNameTrie{
+		settings: settings,
+		root:     &clanNameTrieNode{},
+		names:    make(map[string]string),
+	}
+}
+
+func (t *ClanNameTrie) normalize(text string) []string {
+	return strings.Fields(t.settings.normalize(text))
+}
+
+// Add indexes a clan name, replacing the name previously indexed for the clan
+func (t *ClanNameTrie) Add(publicID, name string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.remove(publicID)
+	t.names[publicID] = name
+
+	words := t.normalize(name)
+	clan := ClanNameSuggestion{PublicID: publicID, Name: name}
+	for i := range words {
+		node := t.root
+		for _, r := range strings.Join(words[i:], " ") {
+			if node.children == nil {
+				node.children = make(map[rune]*clanNameTrieNode)
+			}
+			child, ok := node.children[r]
+			if !ok {
+				child = &clanNameTrieNode{}
+				node.children[r] = child
+			}
+			node = child
+		}
+		idx := sort.Search(len(node.clans), func(j int) bool { return !node.clans[j].less(clan) })
+		node.clans = append(node.clans, ClanNameSuggestion{})
+		copy(node.clans[idx+1:], node.clans[idx:])
+		node.clans[idx] = clan
+	}
+}
+
+// Remove removes a clan from the index
+func (t *ClanNameTrie) Remove(publicID string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.remove(publicID)
+}
+
+func (t *ClanNameTrie) remove(publicID string) {
+	name, ok := t.names[publicID]
+	if !ok {
+		return
+	}
+	delete(t.names, publicID)
+
+	words := t.normalize(name)
+	for i := range words {
+		node := t.root
+		for _, r := range strings.Join(words[i:], " ") {
+			node = node.children[r]
+			if node == nil {
+				break
+			}
+		}
+		if node == nil {
+			continue
+		}
+		for j := range node.clans {
+			if node.clans[j].PublicID == publicID {
+				node.clans = append(node.clans[:j], node.clans[j+1:]...)
+				break
+			}
+		}
+	}
+}
+
+func (c ClanNameSuggestion) less(other ClanNameSuggestion) bool {
+	if c.Name != other.Name {
+		return c.Name < other.Name
+	}
+	return c.PublicID < other.PublicID
+}
+
+func (n *clanNameTrieNode) sortedChildren() []*clanNameTrieNode {
+	keys := make([]rune, 0, len(n.children))
+	for r := range n.children {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	children := make([]*clanNameTrieNode, len(keys))
+	for i, r := range keys {
+		children[i] = n.children[r]
+	}
+	return children
+}
+
+// collect appends the clans under node to result, shortest keys first, until limit clans are found
+func (n *clanNameTrieNode) collect(result []ClanNameSuggestion, found map[string]bool, limit int) []ClanNameSuggestion {
+	queue := []*clanNameTrieNode{n}
+	for len(queue) > 0 && len(result) < limit {
+		node := queue[0]
+		queue = queue[1:]
+		for _, clan := range node.clans {
+			if len(result) >= limit {
+				break
+			}
+			if !found[clan.PublicID] {
+				found[clan.PublicID] = true
+				result = append(result, clan)
+			}
+		}
+		queue = append(queue, node.sortedChildren()...)
+	}
+	return result
+}
+
+// Suggest returns up to limit clans with a name word starting with prefix
+func (t *ClanNameTrie) Suggest(prefix string, limit int) []ClanNameSuggestion {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	result := []ClanNameSuggestion{}
+	node := t.root
+	for _, r := range strings.Join(t.normalize(prefix), " ") {
+		node = node.children[r]
+		if node == nil {
+			return result
+		}
+	}
+	return node.collect(result, make(map[string]bool), limit)
+}
+
+// FuzzySearch returns up to limit clans with a name word starting with a string within
+// maxDistance edits (insertions, deletions or substitutions) of term, closest matches first
+// and then sorted by name
+func (t *ClanNameTrie) FuzzySearch(term string, maxDistance, limit int) []ClanNameSuggestion {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	result := []ClanNameSuggestion{}
+	termRunes := []rune(strings.Join(t.normalize(term), " "))
+	if len(termRunes) == 0 {
+		return result
+	}
+
+	row := make([]int, len(termRunes)+1)
+	for i := range row {
+		row[i] = i
+	}
+
+	// the closest distance of each matched clan
+	distances := make(map[string]*clanNameTrieMatch)
+	var walk func(node *clanNameTrieNode, r rune, previousRow []int)
+	walk = func(node *clanNameTrieNode, r rune, previousRow []int) {
+		currentRow := make([]int, len(previousRow))
+		currentRow[0] = previousRow[0] + 1
+		rowMin := currentRow[0]
+		for i := 1; i < len(currentRow); i++ {
+			cost := 1
+			if termRunes[i-1] == r {
+				cost = 0
+			}
+			currentRow[i] = min(min(currentRow[i-1]+1, previousRow[i]+1), previousRow[i-1]+cost)
+			rowMin = min(rowMin, currentRow[i])
+		}
+
+		if distance := currentRow[len(currentRow)-1]; distance <= maxDistance {
+			for _, clan := range node.collect(nil, make(map[string]bool), limit) {
+				if match, ok := distances[clan.PublicID]; !ok || distance < match.distance {
+					distances[clan.PublicID] = &clanNameTrieMatch{clan: clan, distance: distance}
+				}
+			}
+		}
+		if rowMin > maxDistance {
+			return
+		}
+		for childRune, child := range node.children {
+			walk(child, childRune, currentRow)
+		}
+	}
+	for r, child := range t.root.children {
+		walk(child, r, row)
+	}
+
+	matches := make([]*clanNameTrieMatch, 0, len(distances))
+	for _, match := range distances {
+		matches = append(matches, match)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].clan.less(matches[j].clan)
+	})
+	for _, match := range matches {
+		if len(result) >= limit {
+			break
+		}
+		result = append(result, match.clan)
+	}
+	return result
+}
+
+// FuzzyMaxDistance returns the edit distance allowed for a term: one edit every four
+// characters, up to maxDistance
+func FuzzyMaxDistance(term string, maxDistance int) int {
+	return min(maxDistance, len([]rune(term))/4)
+}
+
+// GetClanNameTrieFromMongoDB builds the clan name trie of a game from its MongoDB clans collection
+func GetClanNameTrieFromMongoDB(mongo interfaces.MongoDB, gameID string, settings *SearchSettings) (*ClanNameTrie, error) {
+	trie := NewClanNameTrie(settings)
+	err := trie.addFromMongoDB(mongo, gameID, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	return trie, nil
+}
+
+// RefreshFromMongoDB indexes the clans of the game updated in MongoDB since the trie was built or last
+// refreshed. Deleted clans are only removed when the trie is built again
+func (t *ClanNameTrie) RefreshFromMongoDB(mongo interfaces.MongoDB, gameID string) error {
+	t.mutex.RLock()
+	updatedAt := t.updatedAt
+	t.mutex.RUnlock()
+	return t.addFromMongoDB(mongo, gameID, bson.M{"updatedAt": bson.M{"$gte": updatedAt}})
+}
+
+func (t *ClanNameTrie) addFromMongoDB(mongo interfaces.MongoDB, gameID string, query bson.M) error {
+	col, sess := mongo.C(fmt.Sprintf("clans_%s", gameID))
+	defer sess.Close()
+
+	iter := col.Find(query).Iter()
+	var clan Clan
+	for iter.Next(&clan) {
+		t.Add(clan.PublicID, clan.Name)
+		t.mutex.Lock()
+		if clan.UpdatedAt > t.updatedAt {
+			t.updatedAt = clan.UpdatedAt
+		}
+		t.mutex.Unlock()
+		clan = Clan{}
+	}
+	return iter.Close()
+}
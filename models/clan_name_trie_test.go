@@ -0,0 +1,108 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/topfreegames/khan/models"
+)
+
+var _ = Describe("Clan Name Trie", func() {
+	var trie *ClanNameTrie
+
+	BeforeEach(func() {
+		trie = NewClanNameTrie(nil)
+		trie.Add("clan-1", "Brazilian Warriors")
+		trie.Add("clan-2", "Brazil")
+		trie.Add("clan-3", "Dark Wolves")
+		trie.Add("clan-4", "Clán Wolf")
+	})
+
+	getPublicIDs := func(suggestions []ClanNameSuggestion) []string {
+		publicIDs := []string{}
+		for _, suggestion := range suggestions {
+			publicIDs = append(publicIDs, suggestion.PublicID)
+		}
+		return publicIDs
+	}
+
+	Describe("Suggest", func() {
+		It("Should suggest clans by name prefix, shortest names first", func() {
+			Expect(getPublicIDs(trie.Suggest("bra", 10))).To(Equal([]string{"clan-2", "clan-1"}))
+		})
+
+		It("Should suggest clans by any word prefix", func() {
+			Expect(getPublicIDs(trie.Suggest("wol", 10))).To(Equal([]string{"clan-4", "clan-3"}))
+		})
+
+		It("Should fold case and accents", func() {
+			Expect(getPublicIDs(trie.Suggest("CLAN", 10))).To(Equal([]string{"clan-4"}))
+		})
+
+		It("Should limit the number of suggestions", func() {
+			Expect(trie.Suggest("bra", 1)).To(HaveLen(1))
+		})
+
+		It("Should return no suggestions for unknown prefixes", func() {
+			Expect(trie.Suggest("xyz", 10)).To(BeEmpty())
+		})
+
+		It("Should sort clans with the same normalized name by name", func() {
+			trie.Add("clan-5", "BRAZIL")
+			Expect(getPublicIDs(trie.Suggest("bra", 10))).To(Equal([]string{"clan-5", "clan-2", "clan-1"}))
+		})
+
+		It("Should replace the name of clans added again", func() {
+			trie.Add("clan-2", "Dark Knights")
+			Expect(getPublicIDs(trie.Suggest("bra", 10))).To(Equal([]string{"clan-1"}))
+			Expect(getPublicIDs(trie.Suggest("dark", 10))).To(Equal([]string{"clan-3", "clan-2"}))
+		})
+
+		It("Should not suggest removed clans", func() {
+			trie.Remove("clan-2")
+			Expect(getPublicIDs(trie.Suggest("bra", 10))).To(Equal([]string{"clan-1"}))
+		})
+
+		It("Should keep the original clan name", func() {
+			Expect(trie.Suggest("clan w", 10)).To(Equal([]ClanNameSuggestion{
+				{PublicID: "clan-4", Name: "Clán Wolf"},
+			}))
+		})
+	})
+
+	Describe("FuzzySearch", func() {
+		It("Should find clans within the edit distance", func() {
+			Expect(getPublicIDs(trie.FuzzySearch("brazlian", 1, 10))).To(Equal([]string{"clan-1"}))
+			Expect(getPublicIDs(trie.FuzzySearch("drak wolves", 2, 10))).To(Equal([]string{"clan-3"}))
+		})
+
+		It("Should not find clans beyond the edit distance", func() {
+			Expect(trie.FuzzySearch("brzlian", 1, 10)).To(BeEmpty())
+		})
+
+		It("Should return closest matches first", func() {
+			Expect(getPublicIDs(trie.FuzzySearch("wolve", 2, 10))).To(Equal([]string{"clan-3", "clan-4"}))
+		})
+
+		It("Should sort matches at the same distance by name", func() {
+			trie.Add("clan-5", "Brazilian Army")
+			for i := 0; i < 10; i++ {
+				Expect(getPublicIDs(trie.FuzzySearch("brazlian", 1, 10))).To(Equal([]string{"clan-5", "clan-1"}))
+			}
+		})
+	})
+
+	Describe("FuzzyMaxDistance", func() {
+		It("Should allow one edit every four characters up to the max distance", func() {
+			Expect(FuzzyMaxDistance("abc", 2)).To(Equal(0))
+			Expect(FuzzyMaxDistance("abcd", 2)).To(Equal(1))
+			Expect(FuzzyMaxDistance("abcdefghijklmnop", 2)).To(Equal(2))
+		})
+	})
+})
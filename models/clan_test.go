@@ -434,6 +434,50 @@ var _ = Describe("Clan Model", func() {
 			})
 		})
 
+		Describe("Patch Clan", func() {
+			It("Should merge the patch into the clan metadata", func() {
+				_, clan, owner, _, _, err := fixtures.GetClanWithMemberships(testDb, 0, 0, 0, 0, "", "")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = testDb.Exec(
+					"UPDATE clans SET metadata=$1 WHERE id=$2",
+					`{"x": "1", "y": "2", "nested": {"a": 1, "b": 2}}`, clan.ID,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				autoJoin := !clan.AutoJoin
+				updClan, err := PatchClan(testDb, clan.GameID, clan.PublicID, owner.PublicID, &ClanPatch{
+					AutoJoin: &autoJoin,
+					Metadata: map[string]interface{}{
+						"x":      nil,
+						"z":      "3",
+						"nested": map[string]interface{}{"b": nil, "c": 3},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updClan.ID).To(Equal(clan.ID))
+
+				dbClan, err := GetClanByPublicID(testDb, clan.GameID, clan.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbClan.Name).To(Equal(clan.Name))
+				Expect(dbClan.AutoJoin).To(Equal(autoJoin))
+				Expect(dbClan.AllowApplication).To(Equal(clan.AllowApplication))
+				Expect(dbClan.Metadata).NotTo(HaveKey("x"))
+				Expect(dbClan.Metadata["y"]).To(Equal("2"))
+				Expect(dbClan.Metadata["z"]).To(Equal("3"))
+				Expect(dbClan.Metadata["nested"]).To(BeEquivalentTo(map[string]interface{}{"a": float64(1), "c": float64(3)}))
+			})
+
+			It("Should not patch a Clan if player is not the clan owner", func() {
+				_, clan, _, players, _, err := fixtures.GetClanWithMemberships(testDb, 1, 0, 0, 0, "", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				name := "new-name"
+				_, err = PatchClan(testDb, clan.GameID, clan.PublicID, players[0].PublicID, &ClanPatch{Name: &name})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal(fmt.Sprintf("Clan was not found with id: %s", clan.PublicID)))
+			})
+		})
+
 		Describe("Update Clan", func() {
 			It("Should update a Clan with UpdateClan", func() {
 				mongoDB, err := testing.GetTestMongo()
@@ -455,6 +499,7 @@ var _ = Describe("Clan Model", func() {
 					metadata,
 					allowApplication,
 					autoJoin,
+					0,
 				)
 
 				Expect(err).NotTo(HaveOccurred())
@@ -469,6 +514,68 @@ var _ = Describe("Clan Model", func() {
 				Expect(dbClan.OwnerID).To(Equal(clan.OwnerID))
 			})
 
+			It("Should increment the version of a Clan with UpdateClan", func() {
+				mongoDB, err := testing.GetTestMongo()
+				Expect(err).NotTo(HaveOccurred())
+
+				player, clans, err := fixtures.CreateTestClans(testDb, mongoDB, "", "", 1, fixtures.EnqueueClanForMongoUpdate)
+				Expect(err).NotTo(HaveOccurred())
+				clan := clans[0]
+
+				dbClan, err := GetClanByPublicID(testDb, clan.GameID, clan.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+
+				updClan, err := UpdateClan(
+					testDb,
+					clan.GameID,
+					clan.PublicID,
+					clan.Name,
+					player.PublicID,
+					clan.Metadata,
+					clan.AllowApplication,
+					clan.AutoJoin,
+					dbClan.Version,
+				)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updClan.Version).To(Equal(dbClan.Version + 1))
+
+				dbClan, err = GetClanByPublicID(testDb, clan.GameID, clan.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbClan.Version).To(Equal(updClan.Version))
+			})
+
+			It("Should not update a Clan with UpdateClan if it is not at the expected version", func() {
+				mongoDB, err := testing.GetTestMongo()
+				Expect(err).NotTo(HaveOccurred())
+
+				player, clans, err := fixtures.CreateTestClans(testDb, mongoDB, "", "", 1, fixtures.EnqueueClanForMongoUpdate)
+				Expect(err).NotTo(HaveOccurred())
+				clan := clans[0]
+
+				dbClan, err := GetClanByPublicID(testDb, clan.GameID, clan.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = UpdateClan(
+					testDb,
+					clan.GameID,
+					clan.PublicID,
+					"new name",
+					player.PublicID,
+					clan.Metadata,
+					clan.AllowApplication,
+					clan.AutoJoin,
+					dbClan.Version+1,
+				)
+
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&VersionMismatchError{}))
+
+				dbClan, err = GetClanByPublicID(testDb, clan.GameID, clan.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbClan.Name).To(Equal(clan.Name))
+			})
+
 			It("Should not update a Clan if player is not the clan owner with UpdateClan", func() {
 				mongoDB, err := testing.GetTestMongo()
 				Expect(err).NotTo(HaveOccurred())
@@ -490,6 +597,7 @@ var _ = Describe("Clan Model", func() {
 					metadata,
 					clan.AllowApplication,
 					clan.AutoJoin,
+					0,
 				)
 
 				Expect(err).To(HaveOccurred())
@@ -519,6 +627,7 @@ var _ = Describe("Clan Model", func() {
 					metadata,
 					clan.AllowApplication,
 					clan.AutoJoin,
+					0,
 				)
 
 				Expect(err).To(HaveOccurred())
@@ -547,6 +656,7 @@ var _ = Describe("Clan Model", func() {
 						metadata,
 						allowApplication,
 						autoJoin,
+						0,
 					)
 				})
 
@@ -1224,31 +1334,31 @@ var _ = Describe("Clan Model", func() {
 			})
 
 			It("Should return clan by search term", func() {
-				Eventually(func() ([]Clan, error) { return SearchClan(testDb, testMongo, player.GameID, "SEARCH", 10) }).Should(HaveLen(10))
+				Eventually(func() ([]Clan, error) { return SearchClan(testDb, testMongo, player.GameID, "SEARCH", 10, nil) }).Should(HaveLen(10))
 			})
 
 			It("Should return clan by unicode search term", func() {
-				Eventually(func() ([]Clan, error) { return SearchClan(testDb, testMongo, player.GameID, "💩clán", 10) }).Should(HaveLen(10))
+				Eventually(func() ([]Clan, error) { return SearchClan(testDb, testMongo, player.GameID, "💩clán", 10, nil) }).Should(HaveLen(10))
 			})
 
 			It("Should return clan by full public ID as search term", func() {
 				searchClanID := realClans[0].PublicID
-				Eventually(func() ([]Clan, error) { return SearchClan(testDb, testMongo, player.GameID, searchClanID, 10) }).Should(HaveLen(1))
+				Eventually(func() ([]Clan, error) { return SearchClan(testDb, testMongo, player.GameID, searchClanID, 10, nil) }).Should(HaveLen(1))
 			})
 
 			It("Should return clan by short public ID as search term", func() {
 				dbClan, err := fixtures.GetTestClanWithRandomPublicIDAndName(testDb, player.GameID, player.ID)
 				Expect(err).NotTo(HaveOccurred())
 				searchClanID := dbClan.PublicID[:8]
-				Eventually(func() ([]Clan, error) { return SearchClan(testDb, testMongo, player.GameID, searchClanID, 10) }).Should(HaveLen(1))
+				Eventually(func() ([]Clan, error) { return SearchClan(testDb, testMongo, player.GameID, searchClanID, 10, nil) }).Should(HaveLen(1))
 			})
 
 			It("Should return empty list if search term is not found", func() {
-				Eventually(func() ([]Clan, error) { return SearchClan(testDb, testMongo, player.GameID, "qwfjur", 10) }).Should(HaveLen(0))
+				Eventually(func() ([]Clan, error) { return SearchClan(testDb, testMongo, player.GameID, "qwfjur", 10, nil) }).Should(HaveLen(0))
 			})
 
 			It("Should return invalid response if empty term", func() {
-				_, err := SearchClan(testDb, testMongo, "some-game-id", "", 10)
+				_, err := SearchClan(testDb, testMongo, "some-game-id", "", 10, nil)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("A search term was not provided to find a clan."))
 			})
@@ -1257,7 +1367,7 @@ var _ = Describe("Clan Model", func() {
 				dbClan, err := fixtures.GetTestClanWithName(testDb, player.GameID, "The Largest Clan Name For Prefix Test", player.ID)
 				Expect(err).NotTo(HaveOccurred())
 				Eventually(func() (string, error) {
-					clans, err := SearchClan(testDb, testMongo, player.GameID, "prefi large", 10)
+					clans, err := SearchClan(testDb, testMongo, player.GameID, "prefi large", 10, nil)
 					if err != nil {
 						return "", err
 					}
@@ -1297,7 +1407,7 @@ var _ = Describe("Clan Model", func() {
 
 			It("Should return the clan struct extension with name word prefixes", func() {
 				clan := &Clan{Name: "Brazilian Clan Name"}
-				clanWithNamePrefixes := clan.NewClanWithNamePrefixes()
+				clanWithNamePrefixes := clan.NewClanWithNamePrefixes(nil)
 				expectedPrefixes := []string{
 					"braz",
 					"brazi",
@@ -1313,7 +1423,7 @@ var _ = Describe("Clan Model", func() {
 
 			It("Should return the clan struct extension with name word prefixes without duplicates", func() {
 				clan := &Clan{Name: "Brazilian Brazilian"}
-				clanWithNamePrefixes := clan.NewClanWithNamePrefixes()
+				clanWithNamePrefixes := clan.NewClanWithNamePrefixes(nil)
 				expectedPrefixes := []string{
 					"braz",
 					"brazi",
@@ -1327,7 +1437,7 @@ var _ = Describe("Clan Model", func() {
 
 			It("Should return the clan struct extension with name word prefixes even for words shorter than 4 characters", func() {
 				clan := &Clan{Name: "The Big Brazilian"}
-				clanWithNamePrefixes := clan.NewClanWithNamePrefixes()
+				clanWithNamePrefixes := clan.NewClanWithNamePrefixes(nil)
 				expectedPrefixes := []string{
 					"the",
 					"big",
@@ -7,7 +7,396 @@
 
 package models
 
+import (
+	"encoding/json"
+	"strings"
+
+	egorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
+	"github.com/topfreegames/khan/util"
+)
+
 // EncryptedPlayer identifies uniquely one player in a given game
 type EncryptedPlayer struct {
-	PlayerID int64 `db:"player_id"`
+	PlayerID int64  `db:"player_id"`
+	KeyID    string `db:"key_id"`
+}
+
+// EncryptedPlayerName is the encrypted name of a player and the ID of the key it was encrypted with
+type EncryptedPlayerName struct {
+	PlayerID int64  `db:"player_id"`
+	Name     string `db:"name"`
+	KeyID    string `db:"key_id"`
+}
+
+var encryptionKeyring *util.Keyring
+
+// SetEncryptionKeyring configures the keys player names encrypted with previous keys are decrypted with
+func SetEncryptionKeyring(keyring *util.Keyring) {
+	encryptionKeyring = keyring
+}
+
+// encryptionKeyID returns the ID recorded for names encrypted with encryptionKey
+func encryptionKeyID(encryptionKey []byte) string {
+	if encryptionKeyring != nil {
+		if keyID, ok := encryptionKeyring.KeyID(encryptionKey); ok {
+			return keyID
+		}
+	}
+	return util.DefaultEncryptionKeyID
+}
+
+// decryptName deciphers a player name with encryptionKey, falling back to the keys identified by keyIDs
+// and then to every other key in the keyring
+func decryptName(name string, encryptionKey []byte, keyIDs ...string) (string, error) {
+	decrypted, err := util.DecryptData(name, encryptionKey)
+	if err == nil || encryptionKeyring == nil {
+		return decrypted, err
+	}
+	return encryptionKeyring.Decrypt(name, keyIDs...)
+}
+
+// decryptStoredPlayerName deciphers the name of a player read from the database. Names that do not
+// match encryptionKey are decrypted with the key recorded for the player
+func decryptStoredPlayerName(db DB, encryptionKey []byte, player *Player) (string, error) {
+	decrypted, err := util.DecryptData(player.Name, encryptionKey)
+	if err == nil || encryptionKeyring == nil {
+		return decrypted, err
+	}
+
+	var keyIDs []string
+	_, err = db.Select(&keyIDs, "SELECT key_id FROM encrypted_players WHERE player_id=$1", player.ID)
+	if err != nil {
+		return "", err
+	}
+	if len(keyIDs) == 0 {
+		return "", &ModelNotFoundError{"EncryptedPlayer", player.ID}
+	}
+	return encryptionKeyring.Decrypt(player.Name, keyIDs[0])
+}
+
+// GetPlayersToRotate returns up to amount players with ID greater than afterPlayerID whose names
+// were encrypted with a key other than currentKeyID, or directly with the key while a key provider
+// is set, ordered by ID
+func GetPlayersToRotate(db DB, currentKeyID string, afterPlayerID int64, amount int) ([]*EncryptedPlayerName, error) {
+	query := `SELECT ep.player_id, ep.key_id, p.name
+	FROM encrypted_players ep
+		INNER JOIN players p ON p.id = ep.player_id
+	WHERE (ep.key_id <> $1 OR ($4 AND p.name NOT LIKE $5)) AND ep.player_id > $2
+	ORDER BY ep.player_id
+	LIMIT $3`
+
+	var players []*EncryptedPlayerName
+	_, err := db.Select(
+		&players, query, currentKeyID, afterPlayerID, amount, util.UsesEnvelopes(), util.EnvelopePrefix+"%",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return players, nil
+}
+
+// RotatePlayersEncryptionKey re-encrypts the names of players with the current key of keyring, wrapped by the
+// key provider if one is set. Names that
+// cannot be decrypted with any key of the keyring are left untouched and their player IDs are returned.
+// Names updated since they were read are skipped as well, since they are encrypted with the current key
+func RotatePlayersEncryptionKey(db egorp.Database, keyring *util.Keyring, players []*EncryptedPlayerName) ([]int64, error) {
+	var failedPlayerIDs []int64
+
+	trx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, player := range players {
+		name, err := keyring.Decrypt(player.Name, player.KeyID)
+		if err != nil {
+			failedPlayerIDs = append(failedPlayerIDs, player.PlayerID)
+			continue
+		}
+
+		encryptedName, keyID, err := keyring.Encrypt(name)
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+
+		res, err := trx.Exec("UPDATE players SET name=$1 WHERE id=$2 AND name=$3", encryptedName, player.PlayerID, player.Name)
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+		if rows == 0 {
+			continue
+		}
+
+		_, err = trx.Exec("UPDATE encrypted_players SET key_id=$1 WHERE player_id=$2", keyID, player.PlayerID)
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = trx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return failedPlayerIDs, nil
+}
+
+// EncryptedMetadataPrefix starts the player metadata values encrypted at rest
+const EncryptedMetadataPrefix = "khan:encrypted:"
+
+// EncryptedPlayerMetadata is the metadata of a player that has values encrypted at rest
+type EncryptedPlayerMetadata struct {
+	PlayerID int64                  `db:"id"`
+	Metadata map[string]interface{} `db:"metadata"`
+}
+
+// GetPlayersMetadataToRotate returns up to amount players with ID greater than afterPlayerID whose
+// metadata has encrypted values, ordered by ID
+func GetPlayersMetadataToRotate(db DB, afterPlayerID int64, amount int) ([]*EncryptedPlayerMetadata, error) {
+	query := `SELECT id, metadata FROM players
+	WHERE id > $1 AND metadata::text LIKE $2
+	ORDER BY id
+	LIMIT $3`
+
+	var players []*EncryptedPlayerMetadata
+	_, err := db.Select(&players, query, afterPlayerID, "%\""+EncryptedMetadataPrefix+"%", amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return players, nil
+}
+
+// RotatePlayersMetadataEncryptionKey re-encrypts the encrypted metadata values of players with the current
+// key of keyring, wrapped by the key provider if one is set. Metadata with values that cannot be decrypted with any key of the keyring is left untouched
+// and its player IDs are returned. Metadata updated since it was read is skipped as well, since its values are
+// encrypted with the current key
+func RotatePlayersMetadataEncryptionKey(db egorp.Database, keyring *util.Keyring, players []*EncryptedPlayerMetadata) ([]int64, error) {
+	var failedPlayerIDs []int64
+
+	trx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, player := range players {
+		metadata, rotated, err := rotateMetadataEncryptionKey(player.Metadata, keyring)
+		if err != nil {
+			failedPlayerIDs = append(failedPlayerIDs, player.PlayerID)
+			continue
+		}
+		if !rotated {
+			continue
+		}
+
+		previousJSON, err := json.Marshal(player.Metadata)
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+		metadataJSON, err := json.Marshal(metadata)
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+
+		_, err = trx.Exec(
+			"UPDATE players SET metadata=$1::jsonb WHERE id=$2 AND metadata=$3::jsonb",
+			string(metadataJSON), player.PlayerID, string(previousJSON),
+		)
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = trx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return failedPlayerIDs, nil
+}
+
+// rotateMetadataEncryptionKey returns a copy of metadata with the values encrypted with a key other than
+// the current one of keyring, or directly with the key while a key provider is set, re-encrypted with it,
+// and whether any value was re-encrypted
+func rotateMetadataEncryptionKey(metadata map[string]interface{}, keyring *util.Keyring) (map[string]interface{}, bool, error) {
+	var rotated map[string]interface{}
+	for key, value := range metadata {
+		str, ok := value.(string)
+		if !ok || !strings.HasPrefix(str, EncryptedMetadataPrefix) {
+			continue
+		}
+		encryptedValue := strings.TrimPrefix(str, EncryptedMetadataPrefix)
+		if !util.NeedsEnvelope(encryptedValue) {
+			if _, err := util.DecryptData(encryptedValue, keyring.CurrentKey()); err == nil {
+				continue
+			}
+		}
+
+		valueJSON, err := keyring.Decrypt(encryptedValue)
+		if err != nil {
+			return nil, false, err
+		}
+		encryptedValue, _, err = keyring.Encrypt(valueJSON)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if rotated == nil {
+			rotated = make(map[string]interface{}, len(metadata))
+			for k, v := range metadata {
+				rotated[k] = v
+			}
+		}
+		rotated[key] = EncryptedMetadataPrefix + encryptedValue
+	}
+
+	if rotated == nil {
+		return metadata, false, nil
+	}
+	return rotated, true, nil
+}
+
+// getPlayerEncryptedMetadataFields returns the player metadata fields the game encrypts at rest
+func getPlayerEncryptedMetadataFields(db DB, gameID string) ([]string, error) {
+	var fields []string
+	_, err := db.Select(&fields, "SELECT player_encrypted_metadata_fields FROM games WHERE public_id=$1", gameID)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 || fields[0] == "" {
+		return nil, nil
+	}
+	return strings.Split(fields[0], ","), nil
+}
+
+// encryptPlayerMetadata returns a copy of metadata with the fields the game encrypts at rest encrypted
+func encryptPlayerMetadata(db DB, encryptionKey []byte, gameID string, metadata map[string]interface{}) (map[string]interface{}, error) {
+	if len(metadata) == 0 {
+		return metadata, nil
+	}
+	fields, err := getPlayerEncryptedMetadataFields(db, gameID)
+	if err != nil {
+		return nil, err
+	}
+	return encryptMetadata(metadata, fields, encryptionKey)
+}
+
+// encryptMetadata returns a copy of metadata sent by clients with the values of fields encrypted. Values
+// that already look encrypted are encrypted as well, so clients cannot store values that are decrypted on
+// reads. Null values are kept, so they still remove fields from metadata patches
+func encryptMetadata(metadata map[string]interface{}, fields []string, encryptionKey []byte) (map[string]interface{}, error) {
+	if len(fields) == 0 || len(metadata) == 0 {
+		return metadata, nil
+	}
+
+	encrypted := make(map[string]interface{}, len(metadata))
+	for key, value := range metadata {
+		encrypted[key] = value
+	}
+	for _, field := range fields {
+		value, ok := metadata[strings.TrimSpace(field)]
+		if !ok || value == nil {
+			continue
+		}
+
+		encryptedValue, err := encryptMetadataValue(value, encryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		encrypted[strings.TrimSpace(field)] = encryptedValue
+	}
+	return encrypted, nil
+}
+
+// encryptStoredMetadata returns a copy of stored metadata with the values of fields that are not
+// encrypted yet encrypted, and whether any value was encrypted
+func encryptStoredMetadata(metadata map[string]interface{}, fields []string, encryptionKey []byte) (map[string]interface{}, bool, error) {
+	var encrypted map[string]interface{}
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		value, ok := metadata[field]
+		if !ok || value == nil {
+			continue
+		}
+		if str, isString := value.(string); isString && strings.HasPrefix(str, EncryptedMetadataPrefix) {
+			if _, err := decryptName(strings.TrimPrefix(str, EncryptedMetadataPrefix), encryptionKey); err == nil {
+				continue
+			}
+		}
+
+		encryptedValue, err := encryptMetadataValue(value, encryptionKey)
+		if err != nil {
+			return nil, false, err
+		}
+		if encrypted == nil {
+			encrypted = make(map[string]interface{}, len(metadata))
+			for k, v := range metadata {
+				encrypted[k] = v
+			}
+		}
+		encrypted[field] = encryptedValue
+	}
+
+	if encrypted == nil {
+		return metadata, false, nil
+	}
+	return encrypted, true, nil
+}
+
+func encryptMetadataValue(value interface{}, encryptionKey []byte) (string, error) {
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	encryptedValue, err := util.EncryptData(string(valueJSON), encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	return EncryptedMetadataPrefix + encryptedValue, nil
+}
+
+// decryptMetadata returns metadata with the encrypted values deciphered. It returns metadata
+// itself when no value is encrypted, and keeps the values that cannot be deciphered
+func decryptMetadata(metadata map[string]interface{}, encryptionKey []byte) map[string]interface{} {
+	var decrypted map[string]interface{}
+	for key, value := range metadata {
+		str, ok := value.(string)
+		if !ok || !strings.HasPrefix(str, EncryptedMetadataPrefix) {
+			continue
+		}
+
+		valueJSON, err := decryptName(strings.TrimPrefix(str, EncryptedMetadataPrefix), encryptionKey)
+		if err != nil {
+			continue
+		}
+		var decryptedValue interface{}
+		if err := json.Unmarshal([]byte(valueJSON), &decryptedValue); err != nil {
+			continue
+		}
+
+		if decrypted == nil {
+			decrypted = make(map[string]interface{}, len(metadata))
+			for k, v := range metadata {
+				decrypted[k] = v
+			}
+		}
+		decrypted[key] = decryptedValue
+	}
+
+	if decrypted == nil {
+		return metadata
+	}
+	return decrypted
 }
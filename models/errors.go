@@ -202,3 +202,79 @@ type InvalidCastToGorpSQLExecutorError struct {
 func (e *InvalidCastToGorpSQLExecutorError) Error() string {
 	return "Invalid cast to gorp.SqlExecutor"
 }
+
+// VersionMismatchError identifies that a model was changed since the version a client read, or was not found
+type VersionMismatchError struct {
+	Type    string
+	ID      interface{}
+	Version int64
+}
+
+func (e *VersionMismatchError) Error() string {
+	return fmt.Sprintf("%s %v is not at version %d", e.Type, e.ID, e.Version)
+}
+
+// PlayerNameTakenError identifies that a game with unique player names already has a player with the given name
+type PlayerNameTakenError struct {
+	GameID string
+	Name   string
+}
+
+func (e *PlayerNameTakenError) Error() string {
+	return fmt.Sprintf("Player name %s is already taken. GameId: %s", e.Name, e.GameID)
+}
+
+// MembershipLevelsInUseError identifies that a game update would remove membership levels existing memberships still have
+type MembershipLevelsInUseError struct {
+	GameID string
+	Levels []string
+}
+
+func (e *MembershipLevelsInUseError) Error() string {
+	return fmt.Sprintf("Membership levels %s are still used by memberships of game %s", strings.Join(e.Levels, ", "), e.GameID)
+}
+
+// InvalidAPIKeyScopeError identifies that an API key was requested with an unknown scope
+type InvalidAPIKeyScopeError struct {
+	Scope string
+}
+
+func (e *InvalidAPIKeyScopeError) Error() string {
+	return fmt.Sprintf("Invalid API key scope '%s'. Valid scopes are read-only, player-write, player-export, clan-write and game-admin", e.Scope)
+}
+
+// InvalidPlayerTokenError identifies that a player token could not be verified
+type InvalidPlayerTokenError struct {
+	Reason string
+}
+
+func (e *InvalidPlayerTokenError) Error() string {
+	return fmt.Sprintf("Invalid player token: %s", e.Reason)
+}
+
+// IdempotencyKeyMismatchError identifies that an idempotency key was reused for a different request
+type IdempotencyKeyMismatchError struct {
+	Key string
+}
+
+func (e *IdempotencyKeyMismatchError) Error() string {
+	return fmt.Sprintf("Idempotency key %s was used for a different request", e.Key)
+}
+
+// IdempotencyKeyInProgressError identifies that the request of an idempotency key has not finished yet
+type IdempotencyKeyInProgressError struct {
+	Key string
+}
+
+func (e *IdempotencyKeyInProgressError) Error() string {
+	return fmt.Sprintf("Request with idempotency key %s is still in progress", e.Key)
+}
+
+// GameDeletingError identifies that a game is being deleted, so it cannot be written to
+type GameDeletingError struct {
+	GameID string
+}
+
+func (e *GameDeletingError) Error() string {
+	return fmt.Sprintf("Game %s is being deleted", e.GameID)
+}
@@ -15,13 +15,15 @@ import (
 // ESWorker is the worker that will update elasticsearch
 type ESWorker struct {
 	Logger zap.Logger
+	DB     DB
 	ES     *es.Client
 }
 
 // NewESWorker creates and returns a new elasticsearch worker
-func NewESWorker(logger zap.Logger) *ESWorker {
+func NewESWorker(logger zap.Logger, db DB) *ESWorker {
 	w := &ESWorker{
 		Logger: logger,
+		DB:     db,
 	}
 	w.configureESWorker()
 	return w
@@ -54,6 +56,18 @@ func (w *ESWorker) PerformUpdateES(m *workers.Msg) {
 		zap.String("source", "PerformUpdateES"),
 	)
 
+	// the clans of a deleted game are removed from the index, which writing to it would add again
+	if gameID, ok := clan["gameId"].(string); ok {
+		deleted, err := IsGameDeleted(w.DB, gameID)
+		if err != nil {
+			panic(err)
+		}
+		if deleted {
+			logger.Debug("Skipping update of clan of deleted game.", zap.String("game", gameID))
+			return
+		}
+	}
+
 	if w.ES != nil {
 		start := time.Now()
 		if op == "index" {
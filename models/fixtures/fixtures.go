@@ -8,19 +8,23 @@
 package fixtures
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"database/sql"
+	"encoding/pem"
 	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/Pallinder/go-randomdata"
 	"github.com/bluele/factory-go/factory"
+	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/jrallison/go-workers"
 	uuid "github.com/satori/go.uuid"
 	"github.com/spf13/viper"
 	"github.com/topfreegames/extensions/v9/mongo/interfaces"
 	"github.com/topfreegames/khan/models"
-	"github.com/topfreegames/khan/mongo"
 	"github.com/topfreegames/khan/queues"
 	kt "github.com/topfreegames/khan/testing"
 	"github.com/topfreegames/khan/util"
@@ -142,6 +146,31 @@ var ClanFactory = configureFactory(factory.NewFactory(
 	&models.Clan{},
 ))
 
+// GetPlayerTokenKey returns a new RSA key to sign player tokens with and its PEM encoded public key
+func GetPlayerTokenKey() (*rsa.PrivateKey, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, "", err
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})), nil
+}
+
+// SignPlayerToken returns a player token of the game for the player that expires after ttl
+func SignPlayerToken(key *rsa.PrivateKey, gameID, playerPublicID string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, &jwt.StandardClaims{
+		Subject:   playerPublicID,
+		Audience:  gameID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(ttl).Unix(),
+	})
+	return token.SignedString(key)
+}
+
 // CreatePlayerFactory is responsible for creating a test player instance with the associated game
 func CreatePlayerFactory(db models.DB, gameID string, skipCreateGame ...bool) (*models.Game, *models.Player, error) {
 	var game *models.Game
@@ -467,7 +496,7 @@ func GetTestClanWithRandomPublicIDAndName(db models.DB, gameID string, ownerID i
 	if err != nil {
 		return nil, err
 	}
-	err = clan.UpdateClanIntoMongoDB()
+	err = clan.UpdateClanIntoMongoDB(db)
 	if err != nil {
 		return nil, err
 	}
@@ -486,7 +515,7 @@ func GetTestClanWithName(db models.DB, gameID, name string, ownerID int64) (*mod
 	if err != nil {
 		return nil, err
 	}
-	err = clan.UpdateClanIntoMongoDB()
+	err = clan.UpdateClanIntoMongoDB(db)
 	if err != nil {
 		return nil, err
 	}
@@ -499,7 +528,11 @@ type AfterClanCreationHook func(player *models.Player, clan *models.Clan) error
 // EnqueueClanForMongoUpdate is a possible value for the type AfterClanCreationHook. This is will enqueue
 // the clan into Redis for the mongo worker to insert/update it on MongoDB
 func EnqueueClanForMongoUpdate(player *models.Player, clan *models.Clan) error {
-	return clan.UpdateClanIntoMongoDB()
+	db, err := kt.GetTestDB()
+	if err != nil {
+		return err
+	}
+	return clan.UpdateClanIntoMongoDB(db)
 }
 
 // CreateTestClans returns a list of clans for tests
@@ -550,7 +583,7 @@ func CreateTestClans(
 		clans = append(clans, clan)
 	}
 
-	err = mongoDB.Run(mongo.GetClanNameTextIndexCommand(gameID, false), nil)
+	err = mongoDB.Run(game.GetClanNameTextIndexCommand(gameID, false), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -859,8 +892,13 @@ func ConfigureAndStartGoWorkers() (*models.MongoWorker, error) {
 	}
 	workers.Configure(opts)
 
+	db, err := kt.GetTestDB()
+	if err != nil {
+		return nil, err
+	}
+
 	logger := kt.NewMockLogger()
-	mongoWorker := models.NewMongoWorker(logger, config)
+	mongoWorker := models.NewMongoWorker(logger, config, db)
 	workers.Process(queues.KhanMongoQueue, mongoWorker.PerformUpdateMongo, workerCount)
 	workers.Start()
 	return mongoWorker, nil
@@ -11,6 +11,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/lib/pq"
+	egorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
 	"github.com/topfreegames/khan/util"
 
 	"github.com/go-gorp/gorp"
@@ -42,6 +44,12 @@ type Game struct {
 	MaxPendingInvites                              int                    `db:"max_pending_invites"`
 	ClanUpdateMetadataFieldsHookTriggerWhitelist   string                 `db:"clan_metadata_fields_whitelist"`
 	PlayerUpdateMetadataFieldsHookTriggerWhitelist string                 `db:"player_metadata_fields_whitelist"`
+	PlayerEncryptedMetadataFields                  string                 `db:"player_encrypted_metadata_fields"`
+	Version                                        int64                  `db:"version"`
+	Deleting                                       bool                   `db:"deleting"`
+	SearchSettings
+	PlayerNameSettings
+	PlayerTokenSettings
 }
 
 // PreInsert populates fields before inserting a new game
@@ -52,6 +60,7 @@ func (g *Game) PreInsert(s gorp.SqlExecutor) error {
 	g.MaxMembershipLevel = sortedLevels[len(sortedLevels)-1].Value
 	g.CreatedAt = util.NowMilli()
 	g.UpdatedAt = g.CreatedAt
+	g.Version = 1
 	return nil
 }
 
@@ -66,16 +75,16 @@ func (g *Game) PreUpdate(s gorp.SqlExecutor) error {
 
 // GetGameByID returns a game by id
 func GetGameByID(db DB, id int) (*Game, error) {
-	obj, err := db.Get(Game{}, id)
+	// db.Get does not read transient columns such as version
+	var games []*Game
+	_, err := db.Select(&games, "SELECT * FROM games WHERE id=$1", id)
 	if err != nil {
 		return nil, err
 	}
-	if obj == nil {
+	if len(games) < 1 {
 		return nil, &ModelNotFoundError{"Game", id}
 	}
-
-	game := obj.(*Game)
-	return game, nil
+	return games[0], nil
 }
 
 // GetGameByPublicID returns a game by their public id
@@ -91,6 +100,29 @@ func GetGameByPublicID(db DB, publicID string) (*Game, error) {
 	return games[0], nil
 }
 
+// getGameByPublicIDForUpdate returns a game by their public id, locking it until the transaction ends
+func getGameByPublicIDForUpdate(db DB, publicID string) (*Game, error) {
+	var games []*Game
+	_, err := db.Select(&games, "SELECT * FROM games WHERE public_id=$1 FOR UPDATE", publicID)
+	if err != nil {
+		return nil, err
+	}
+	if len(games) < 1 {
+		return nil, &ModelNotFoundError{"Game", publicID}
+	}
+	return games[0], nil
+}
+
+// GetGames returns up to limit games in the DB, ordered by creation, skipping the first offset ones
+func GetGames(db DB, limit, offset int) ([]*Game, error) {
+	var games []*Game
+	_, err := db.Select(&games, "SELECT * FROM games ORDER BY id LIMIT $1 OFFSET $2", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
 // GetAllGames returns all games in the DB
 func GetAllGames(db DB) ([]*Game, error) {
 	var games []*Game
@@ -112,7 +144,54 @@ func CreateGame(
 	cooldownBeforeInvite, maxPendingInvites int, upsert bool,
 	clanUpdateMetadataFieldsHookTriggerWhitelist string,
 	playerUpdateMetadataFieldsHookTriggerWhitelist string,
+	playerEncryptedMetadataFields string,
+	searchSettings *SearchSettings,
+	playerNameSettings *PlayerNameSettings,
+	playerTokenSettings *PlayerTokenSettings,
 ) (*Game, error) {
+	return createGame(
+		db, publicID, name, levels, metadata, minLevelAccept, minLevelCreate,
+		minLevelRemove, minOffsetRemove, minOffsetPromote, minOffsetDemote,
+		maxMembers, maxClans, cooldownAfterDeny, cooldownAfterDelete, cooldownBeforeApply,
+		cooldownBeforeInvite, maxPendingInvites, upsert,
+		clanUpdateMetadataFieldsHookTriggerWhitelist,
+		playerUpdateMetadataFieldsHookTriggerWhitelist,
+		playerEncryptedMetadataFields,
+		searchSettings,
+		playerNameSettings,
+		playerTokenSettings,
+		0,
+	)
+}
+
+// createGame creates a new game or, if upsert is true, updates it. If expectedVersion is not zero,
+// an existing game is only updated if it is at that version
+func createGame(
+	db DB,
+	publicID, name string,
+	levels, metadata map[string]interface{},
+	minLevelAccept, minLevelCreate, minLevelRemove,
+	minOffsetRemove, minOffsetPromote, minOffsetDemote, maxMembers,
+	maxClans, cooldownAfterDeny, cooldownAfterDelete, cooldownBeforeApply,
+	cooldownBeforeInvite, maxPendingInvites int, upsert bool,
+	clanUpdateMetadataFieldsHookTriggerWhitelist string,
+	playerUpdateMetadataFieldsHookTriggerWhitelist string,
+	playerEncryptedMetadataFields string,
+	searchSettings *SearchSettings,
+	playerNameSettings *PlayerNameSettings,
+	playerTokenSettings *PlayerTokenSettings,
+	expectedVersion int64,
+) (*Game, error) {
+	if searchSettings == nil {
+		searchSettings = &SearchSettings{}
+	}
+	if playerNameSettings == nil {
+		playerNameSettings = &PlayerNameSettings{}
+	}
+	if playerTokenSettings == nil {
+		playerTokenSettings = &PlayerTokenSettings{}
+	}
+
 	levelsJSON, err := json.Marshal(levels)
 	if err != nil {
 		return nil, err
@@ -123,6 +202,26 @@ func CreateGame(
 		return nil, err
 	}
 
+	if upsert {
+		// locks the game so membership levels are validated against the levels being stored
+		previousGame, err := getGameByPublicIDForUpdate(db, publicID)
+		if err != nil {
+			if _, ok := err.(*ModelNotFoundError); !ok {
+				return nil, err
+			}
+		} else {
+			err = validateMembershipLevels(db, publicID, levels)
+			if err != nil {
+				return nil, err
+			}
+			// games updated before versions were stored have no snapshot of their current version
+			err = saveGameVersion(db, previousGame)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	sortedLevels := util.SortLevels(levels)
 	minMembershipLevel := sortedLevels[0].Value
 	maxMembershipLevel := sortedLevels[len(sortedLevels)-1].Value
@@ -150,10 +249,21 @@ func CreateGame(
 				max_pending_invites,
 				clan_metadata_fields_whitelist,
 				player_metadata_fields_whitelist,
+				search_min_prefix_length,
+				search_accent_sensitive,
+				search_case_sensitive,
+				search_cjk_ngram_size,
+				search_name_weight,
+				search_name_prefixes_weight,
+				player_encrypted_metadata_fields,
+				player_name_history,
+				unique_player_names,
+				player_token_public_key,
+				player_token_max_ttl,
 				created_at,
 				updated_at
 			)
-			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $22)%s`
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $22, $22)%s`
 	onConflict := ` ON CONFLICT (public_id)
 			DO UPDATE set
 				name=$2,
@@ -176,8 +286,20 @@ func CreateGame(
 				max_pending_invites=$19,
 				clan_metadata_fields_whitelist=$20,
 				player_metadata_fields_whitelist=$21,
-				updated_at=$22
-			WHERE games.public_id=$1`
+				search_min_prefix_length=$23,
+				search_accent_sensitive=$24,
+				search_case_sensitive=$25,
+				search_cjk_ngram_size=$26,
+				search_name_weight=$27,
+				search_name_prefixes_weight=$28,
+				player_encrypted_metadata_fields=$29,
+				player_name_history=$30,
+				unique_player_names=$31,
+				player_token_public_key=$32,
+				player_token_max_ttl=$33,
+				updated_at=$22,
+				version=games.version+1
+			WHERE games.public_id=$1 AND ($34::bigint=0 OR games.version=$34)`
 
 	if upsert {
 		query = fmt.Sprintf(query, onConflict)
@@ -185,7 +307,7 @@ func CreateGame(
 		query = fmt.Sprintf(query, "")
 	}
 
-	_, err = db.Exec(query,
+	args := []interface{}{
 		publicID,             // $1
 		name,                 // $2
 		minLevelAccept,       // $3
@@ -207,15 +329,49 @@ func CreateGame(
 		maxPendingInvites,    // $19
 		clanUpdateMetadataFieldsHookTriggerWhitelist,   // $20
 		playerUpdateMetadataFieldsHookTriggerWhitelist, // $21
-		util.NowMilli(), // $22
-	)
+		util.NowMilli(),                   // $22
+		searchSettings.MinPrefixLength,    // $23
+		searchSettings.AccentSensitive,    // $24
+		searchSettings.CaseSensitive,      // $25
+		searchSettings.CJKNGramSize,       // $26
+		searchSettings.NameWeight,         // $27
+		searchSettings.NamePrefixesWeight, // $28
+		playerEncryptedMetadataFields,     // $29
+		playerNameSettings.KeepHistory,    // $30
+		playerNameSettings.Unique,         // $31
+		playerTokenSettings.PublicKey,     // $32
+		playerTokenSettings.MaxTTL,        // $33
+	}
+	if upsert {
+		args = append(args, expectedVersion) // $34
+	}
+
+	res, err := db.Exec(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if expectedVersion != 0 {
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rows == 0 {
+			return nil, &VersionMismatchError{"Game", publicID, expectedVersion}
+		}
+	}
+	invalidateGameCache(db, publicID)
+	game, err := GetGameByPublicID(db, publicID)
+	if err != nil {
+		return nil, err
+	}
+	err = saveGameVersion(db, game)
 	if err != nil {
 		return nil, err
 	}
-	return GetGameByPublicID(db, publicID)
+	return game, nil
 }
 
-// UpdateGame updates an existing game
+// UpdateGame updates an existing game. If expectedVersion is not zero, the game is only updated if it is at that version
 func UpdateGame(
 	db DB, publicID, name string, levels, metadata map[string]interface{},
 	minLevelAccept, minLevelCreate, minLevelRemove, minOffsetRemove, minOffsetPromote,
@@ -223,13 +379,239 @@ func UpdateGame(
 	cooldownBeforeApply, cooldownBeforeInvite, maxPendingInvites int,
 	clanUpdateMetadataFieldsHookTriggerWhitelist string,
 	playerUpdateMetadataFieldsHookTriggerWhitelist string,
+	playerEncryptedMetadataFields string,
+	searchSettings *SearchSettings,
+	playerNameSettings *PlayerNameSettings,
+	playerTokenSettings *PlayerTokenSettings,
+	expectedVersion int64,
 ) (*Game, error) {
-	return CreateGame(
+	if expectedVersion != 0 {
+		// the upsert would create a missing game
+		if _, err := GetGameByPublicID(db, publicID); err != nil {
+			if _, ok := err.(*ModelNotFoundError); ok {
+				return nil, &VersionMismatchError{"Game", publicID, expectedVersion}
+			}
+			return nil, err
+		}
+	}
+	return createGame(
 		db, publicID, name, levels, metadata, minLevelAccept, minLevelCreate,
 		minLevelRemove, minOffsetRemove, minOffsetPromote, minOffsetDemote,
 		maxMembers, maxClans, cooldownAfterDeny, cooldownAfterDelete, cooldownBeforeApply,
 		cooldownBeforeInvite, maxPendingInvites, true,
 		clanUpdateMetadataFieldsHookTriggerWhitelist,
 		playerUpdateMetadataFieldsHookTriggerWhitelist,
+		playerEncryptedMetadataFields,
+		searchSettings,
+		playerNameSettings,
+		playerTokenSettings,
+		expectedVersion,
+	)
+}
+
+// Serialize returns a JSON with every configuration of the game
+func (g *Game) Serialize() map[string]interface{} {
+	return map[string]interface{}{
+		"publicID":                      g.PublicID,
+		"name":                          g.Name,
+		"membershipLevels":              g.MembershipLevels,
+		"minMembershipLevel":            g.MinMembershipLevel,
+		"maxMembershipLevel":            g.MaxMembershipLevel,
+		"metadata":                      g.Metadata,
+		"minLevelToAcceptApplication":   g.MinLevelToAcceptApplication,
+		"minLevelToCreateInvitation":    g.MinLevelToCreateInvitation,
+		"minLevelToRemoveMember":        g.MinLevelToRemoveMember,
+		"minLevelOffsetToRemoveMember":  g.MinLevelOffsetToRemoveMember,
+		"minLevelOffsetToPromoteMember": g.MinLevelOffsetToPromoteMember,
+		"minLevelOffsetToDemoteMember":  g.MinLevelOffsetToDemoteMember,
+		"maxMembers":                    g.MaxMembers,
+		"maxClansPerPlayer":             g.MaxClansPerPlayer,
+		"cooldownAfterDeny":             g.CooldownAfterDeny,
+		"cooldownAfterDelete":           g.CooldownAfterDelete,
+		"cooldownBeforeApply":           g.CooldownBeforeApply,
+		"cooldownBeforeInvite":          g.CooldownBeforeInvite,
+		"maxPendingInvites":             g.MaxPendingInvites,
+		"clanHookFieldsWhitelist":       g.ClanUpdateMetadataFieldsHookTriggerWhitelist,
+		"playerHookFieldsWhitelist":     g.PlayerUpdateMetadataFieldsHookTriggerWhitelist,
+		"playerEncryptedMetadataFields": g.PlayerEncryptedMetadataFields,
+		"searchSettings":                g.SearchSettings,
+		"playerNameSettings":            g.PlayerNameSettings,
+		"playerTokenSettings":           g.PlayerTokenSettings,
+		"version":                       g.Version,
+		"createdAt":                     g.CreatedAt,
+		"updatedAt":                     g.UpdatedAt,
+	}
+}
+
+// MarkGameAsDeleting flags a game as being deleted, so writes to it are rejected while it is deleted in batches
+func MarkGameAsDeleting(db DB, publicID string) error {
+	var ids []int64
+	_, err := db.Select(
+		&ids,
+		"UPDATE games SET deleting=true, version=version+1, updated_at=$2 WHERE public_id=$1 RETURNING id",
+		publicID, util.NowMilli(),
 	)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return &ModelNotFoundError{"Game", publicID}
+	}
+	invalidateGameCache(db, publicID)
+	return nil
+}
+
+// IsGameDeleted returns whether a game is being deleted or no longer exists
+func IsGameDeleted(db DB, publicID string) (bool, error) {
+	var deleting []bool
+	_, err := db.Select(&deleting, "SELECT deleting FROM games WHERE public_id=$1", publicID)
+	if err != nil {
+		return false, err
+	}
+	return len(deleting) == 0 || deleting[0], nil
+}
+
+// DeleteGame deletes a game with its hooks, versions, players, clans and memberships from the database.
+// The game is marked as deleting and its rows are deleted batchSize at a time, each batch in its own
+// transaction, so deleting a large game does not hold locks on all of its rows at once
+func DeleteGame(db egorp.Database, publicID string, batchSize int) error {
+	game, err := GetGameByPublicID(db, publicID)
+	if err != nil {
+		return err
+	}
+	if !game.Deleting {
+		if err := MarkGameAsDeleting(db, publicID); err != nil {
+			return err
+		}
+	}
+
+	err = deleteGameRowsInBatches(db, "memberships", publicID, batchSize)
+	if err != nil {
+		return err
+	}
+	err = deleteGameClansInBatches(db, publicID, batchSize)
+	if err != nil {
+		return err
+	}
+	err = deleteGamePlayersInBatches(db, publicID, batchSize)
+	if err != nil {
+		return err
+	}
+	for _, table := range []string{"hooks", "game_versions", "api_keys", "idempotency_keys"} {
+		err = deleteGameRowsInBatches(db, table, publicID, batchSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = db.Exec("DELETE FROM games WHERE public_id=$1", publicID)
+	if err != nil {
+		return err
+	}
+	invalidateGameCache(db, publicID)
+	return nil
+}
+
+// deleteGameRowsInBatches deletes the rows of table that belong to the game, batchSize at a time
+func deleteGameRowsInBatches(db DB, table, gameID string, batchSize int) error {
+	query := fmt.Sprintf(
+		"DELETE FROM %s WHERE id IN (SELECT id FROM %s WHERE game_id=$1 LIMIT $2)",
+		table, table,
+	)
+	for {
+		res, err := db.Exec(query, gameID, batchSize)
+		if err != nil {
+			return err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return nil
+		}
+	}
+}
+
+// gameRowID identifies a clan or player of a game being deleted
+type gameRowID struct {
+	ID       int64  `db:"id"`
+	PublicID string `db:"public_id"`
+}
+
+func selectGameRowIDs(db DB, table, gameID string, batchSize int) ([]int64, []string, error) {
+	var rows []*gameRowID
+	_, err := db.Select(
+		&rows,
+		fmt.Sprintf("SELECT id, public_id FROM %s WHERE game_id=$1 ORDER BY id LIMIT $2", table),
+		gameID, batchSize,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids := make([]int64, len(rows))
+	publicIDs := make([]string, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+		publicIDs[i] = row.PublicID
+	}
+	return ids, publicIDs, nil
+}
+
+// deleteGameClansInBatches deletes the clans of the game, batchSize at a time, after their memberships were deleted
+func deleteGameClansInBatches(db DB, gameID string, batchSize int) error {
+	for {
+		ids, publicIDs, err := selectGameRowIDs(db, "clans", gameID, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		_, err = db.Exec("DELETE FROM clans WHERE id=ANY($1)", pq.Int64Array(ids))
+		if err != nil {
+			return err
+		}
+		if cacheInvalidator != nil {
+			cacheInvalidator.InvalidateClans(gameID, publicIDs...)
+		}
+	}
+}
+
+// deleteGamePlayersInBatches deletes the players of the game with their encryption and name history records,
+// batchSize players in each transaction, after their clans and memberships were deleted
+func deleteGamePlayersInBatches(db egorp.Database, gameID string, batchSize int) error {
+	queries := []string{
+		"DELETE FROM encrypted_players WHERE player_id=ANY($1)",
+		"DELETE FROM player_name_history WHERE player_id=ANY($1)",
+		"DELETE FROM players WHERE id=ANY($1)",
+	}
+	for {
+		ids, publicIDs, err := selectGameRowIDs(db, "players", gameID, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		trx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+		for _, query := range queries {
+			if _, err := trx.Exec(query, pq.Int64Array(ids)); err != nil {
+				Rollback(trx)
+				return err
+			}
+		}
+		if cacheInvalidator != nil {
+			afterCommit(trx, func() {
+				cacheInvalidator.InvalidatePlayers(gameID, publicIDs...)
+			})
+		}
+		if err := Commit(trx); err != nil {
+			return err
+		}
+	}
 }
@@ -0,0 +1,133 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jrallison/go-workers"
+	opentracing "github.com/opentracing/opentracing-go"
+	egorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
+	"github.com/topfreegames/extensions/v9/mongo/interfaces"
+	"github.com/topfreegames/extensions/v9/tracing"
+	"github.com/topfreegames/khan/es"
+	"github.com/topfreegames/khan/mongo"
+	"github.com/topfreegames/khan/queues"
+	"github.com/uber-go/zap"
+	"gopkg.in/olivere/elastic.v5"
+)
+
+// defaultGameDeletionBatchSize is the amount of rows of each table deleted at a time when deleting a game
+const defaultGameDeletionBatchSize = 1000
+
+// GameDeletionWorker is the worker that will delete games
+type GameDeletionWorker struct {
+	Logger    zap.Logger
+	DB        egorp.Database
+	ES        *es.Client
+	MongoDB   interfaces.MongoDB
+	BatchSize int
+}
+
+// NewGameDeletionWorker creates and returns a new game deletion worker
+func NewGameDeletionWorker(logger zap.Logger, db egorp.Database) *GameDeletionWorker {
+	w := &GameDeletionWorker{
+		Logger:    logger,
+		DB:        db,
+		BatchSize: defaultGameDeletionBatchSize,
+	}
+	w.configureGameDeletionWorker()
+	return w
+}
+
+func (w *GameDeletionWorker) configureGameDeletionWorker() {
+	w.ES = es.GetConfiguredClient()
+	w.MongoDB = mongo.GetConfiguredMongoClient()
+}
+
+// EnqueueGameDeletion enqueues the deletion of a game and returns the id of the job.
+// Failed deletions are retried, so every step of the deletion must be idempotent
+func EnqueueGameDeletion(gameID string) (string, error) {
+	return workers.EnqueueWithOptions(queues.KhanGameDeletionQueue, "Delete", map[string]interface{}{
+		"game": gameID,
+	}, workers.EnqueueOptions{
+		Retry: true,
+		At:    float64(time.Now().UnixNano()) / float64(time.Second),
+	})
+}
+
+// PerformDeleteGame deletes the game from postgres, mongodb and elasticsearch
+func (w *GameDeletionWorker) PerformDeleteGame(m *workers.Msg) {
+	tags := opentracing.Tags{"component": "go-workers"}
+	span := opentracing.StartSpan("PerformDeleteGame", tags)
+	defer span.Finish()
+	defer tracing.LogPanic(span)
+	ctx := opentracing.ContextWithSpan(context.Background(), span)
+
+	item := m.Args()
+	data := item.MustMap()
+	gameID := data["game"].(string)
+
+	err := w.DeleteGame(ctx, gameID)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// DeleteGame deletes the game with everything that belongs to it
+func (w *GameDeletionWorker) DeleteGame(ctx context.Context, gameID string) error {
+	logger := w.Logger.With(
+		zap.String("game", gameID),
+		zap.String("source", "PerformDeleteGame"),
+	)
+	start := time.Now()
+
+	err := DeleteGame(w.DB.WithContext(ctx).(egorp.Database), gameID, w.BatchSize)
+	if err != nil {
+		if _, ok := err.(*ModelNotFoundError); !ok {
+			logger.Error("Failed to delete game from postgres.", zap.Error(err))
+			return err
+		}
+		// a retried deletion may have already removed the game
+		logger.Debug("Game not found in postgres.")
+	}
+
+	if w.MongoDB != nil {
+		err = w.MongoDB.WithContext(ctx).Run(mongo.GetDropClansCollectionCommand(gameID), nil)
+		if err != nil && !strings.Contains(err.Error(), "ns not found") {
+			logger.Error("Failed to drop game collection from mongodb.", zap.Error(err))
+			return err
+		}
+	}
+
+	if w.ES != nil {
+		err = w.deleteGameFromES(ctx, gameID)
+		if err != nil && !elastic.IsNotFound(err) {
+			logger.Error("Failed to delete game from Elastic Search.", zap.Error(err))
+			return err
+		}
+	}
+
+	logger.Info("Successfully deleted game.", zap.Duration("latency", time.Now().Sub(start)))
+	return nil
+}
+
+func (w *GameDeletionWorker) deleteGameFromES(ctx context.Context, gameID string) error {
+	if w.ES.Index == "" {
+		// every game shares the same index, so only the clans of the game are deleted
+		_, err := w.ES.Client.
+			DeleteByQuery(w.ES.GetIndexName(gameID)).
+			Query(elastic.NewTermQuery("gameId", gameID)).
+			Do(ctx)
+		return err
+	}
+	_, err := w.ES.Client.DeleteIndex(w.ES.GetIndexName(gameID)).Do(ctx)
+	return err
+}
@@ -14,12 +14,13 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	uuid "github.com/satori/go.uuid"
+	egorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
 	. "github.com/topfreegames/khan/models"
 	"github.com/topfreegames/khan/models/fixtures"
 )
 
 var _ = Describe("Game Model", func() {
-	var testDb DB
+	var testDb egorp.Database
 
 	BeforeEach(func() {
 		var err error
@@ -152,6 +153,14 @@ var _ = Describe("Game Model", func() {
 			maxPendingInvites := 20
 			clanUpdateMetadataFieldsHookTriggerWhitelist := "x"
 			playerUpdateMetadataFieldsHookTriggerWhitelist := "y,z"
+			playerEncryptedMetadataFields := "email,deviceID"
+			searchSettings := &SearchSettings{
+				MinPrefixLength:    2,
+				AccentSensitive:    true,
+				CJKNGramSize:       2,
+				NameWeight:         100,
+				NamePrefixesWeight: 10,
+			}
 
 			game, err := CreateGame(
 				testDb,
@@ -175,6 +184,10 @@ var _ = Describe("Game Model", func() {
 				false,
 				clanUpdateMetadataFieldsHookTriggerWhitelist,
 				playerUpdateMetadataFieldsHookTriggerWhitelist,
+				playerEncryptedMetadataFields,
+				searchSettings,
+				&PlayerNameSettings{KeepHistory: true, Unique: true},
+				nil,
 			)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(game.ID).NotTo(Equal(0))
@@ -199,6 +212,9 @@ var _ = Describe("Game Model", func() {
 			Expect(dbGame.MaxPendingInvites).To(Equal(maxPendingInvites))
 			Expect(dbGame.ClanUpdateMetadataFieldsHookTriggerWhitelist).To(Equal("x"))
 			Expect(dbGame.PlayerUpdateMetadataFieldsHookTriggerWhitelist).To(Equal("y,z"))
+			Expect(dbGame.PlayerEncryptedMetadataFields).To(Equal("email,deviceID"))
+			Expect(dbGame.SearchSettings).To(Equal(*searchSettings))
+			Expect(dbGame.PlayerNameSettings).To(Equal(PlayerNameSettings{KeepHistory: true, Unique: true}))
 
 			for k, v := range dbGame.MembershipLevels {
 				Expect(v.(float64)).To(BeEquivalentTo(game.MembershipLevels[k]))
@@ -220,7 +236,8 @@ var _ = Describe("Game Model", func() {
 				map[string]interface{}{"Member": 1, "Elder": 2, "CoLeader": 3},
 				map[string]interface{}{"x": "a"},
 				5, 4, 7, 1, 1, 1, 100, 1, 5, 15, 8, 25, 20,
-				"x", "y,z",
+				"x", "y,z", "", nil, nil, nil,
+				0,
 			)
 
 			Expect(err).NotTo(HaveOccurred())
@@ -262,7 +279,8 @@ var _ = Describe("Game Model", func() {
 				map[string]interface{}{"Member": 1, "Elder": 2, "CoLeader": 3},
 				map[string]interface{}{"x": "a"},
 				5, 4, 7, 1, 1, 1, 100, 1, 10, 30, 8, 25, 20,
-				"x", "y,z",
+				"x", "y,z", "", nil, nil, nil,
+				0,
 			)
 
 			Expect(err).NotTo(HaveOccurred())
@@ -305,7 +323,8 @@ var _ = Describe("Game Model", func() {
 				map[string]interface{}{"Member": 1, "Elder": 2, "CoLeader": 3},
 				map[string]interface{}{"x": "a"},
 				5, 4, 7, 1, 1, 0, 100, 1, 0, 0, 8, 25, 20,
-				"x", "y,z",
+				"x", "y,z", "", nil, nil, nil,
+				0,
 			)
 
 			Expect(err).To(HaveOccurred())
@@ -313,6 +332,25 @@ var _ = Describe("Game Model", func() {
 		})
 	})
 
+	Describe("Get Games", func() {
+		It("Should get a page of the games", func() {
+			for i := 0; i < 3; i++ {
+				game := fixtures.GameFactory.MustCreate().(*Game)
+				err := testDb.Insert(game)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			firstPage, err := GetGames(testDb, 2, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(firstPage).To(HaveLen(2))
+
+			secondPage, err := GetGames(testDb, 2, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secondPage).To(HaveLen(2))
+			Expect(secondPage[0].PublicID).To(Equal(firstPage[1].PublicID))
+		})
+	})
+
 	Describe("Get All Games", func() {
 		It("Should get all games", func() {
 			game := fixtures.GameFactory.MustCreate().(*Game)
@@ -327,4 +365,100 @@ var _ = Describe("Game Model", func() {
 			Expect(len(games)).To(BeNumerically(">", 1))
 		})
 	})
+
+	Describe("Serialize Game", func() {
+		It("Should serialize every configuration of the game", func() {
+			game := fixtures.GameFactory.MustCreate().(*Game)
+			err := testDb.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			dbGame, err := GetGameByPublicID(testDb, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+
+			serialized := dbGame.Serialize()
+			Expect(serialized["publicID"]).To(Equal(game.PublicID))
+			Expect(serialized["name"]).To(Equal(game.Name))
+			Expect(serialized["membershipLevels"]).To(HaveLen(len(game.MembershipLevels)))
+			Expect(serialized["minLevelToAcceptApplication"]).To(Equal(game.MinLevelToAcceptApplication))
+			Expect(serialized["maxMembers"]).To(Equal(game.MaxMembers))
+			Expect(serialized["cooldownAfterDeny"]).To(Equal(game.CooldownAfterDeny))
+			Expect(serialized["maxPendingInvites"]).To(Equal(game.MaxPendingInvites))
+			Expect(serialized["version"]).To(Equal(dbGame.Version))
+		})
+	})
+
+	Describe("Delete Game", func() {
+		It("Should delete the game with its players, clans, memberships and hooks", func() {
+			gameID := uuid.NewV4().String()
+			_, player, err := fixtures.GetTestPlayerWithMemberships(testDb, gameID, 5, 2, 3, 8)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = CreateHook(testDb, gameID, GameUpdatedHook, "http://test/update")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = DeleteGame(testDb, gameID, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = GetGameByPublicID(testDb, gameID)
+			Expect(err).To(BeAssignableToTypeOf(&ModelNotFoundError{}))
+
+			for _, table := range []string{"players", "clans", "memberships", "hooks"} {
+				count, err := testDb.SelectInt("SELECT COUNT(*) FROM "+table+" WHERE game_id=$1", gameID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(BeEquivalentTo(0), table)
+			}
+			count, err := testDb.SelectInt("SELECT COUNT(*) FROM encrypted_players WHERE player_id=$1", player.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeEquivalentTo(0))
+		})
+
+		It("Should not delete other games", func() {
+			game := fixtures.GameFactory.MustCreate().(*Game)
+			err := testDb.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+			otherGame := fixtures.GameFactory.MustCreate().(*Game)
+			err = testDb.Insert(otherGame)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = DeleteGame(testDb, game.PublicID, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = GetGameByPublicID(testDb, otherGame.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should return an error if the game does not exist", func() {
+			err := DeleteGame(testDb, uuid.NewV4().String(), 2)
+			Expect(err).To(BeAssignableToTypeOf(&ModelNotFoundError{}))
+		})
+	})
+
+	Describe("Mark Game As Deleting", func() {
+		It("Should mark the game as deleted and increment its version", func() {
+			game := fixtures.GameFactory.MustCreate().(*Game)
+			err := testDb.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			deleted, err := IsGameDeleted(testDb, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+
+			err = MarkGameAsDeleting(testDb, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+
+			dbGame, err := GetGameByPublicID(testDb, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbGame.Deleting).To(BeTrue())
+			Expect(dbGame.Version).To(Equal(game.Version + 1))
+
+			deleted, err = IsGameDeleted(testDb, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+		})
+
+		It("Should report games that do not exist as deleted", func() {
+			deleted, err := IsGameDeleted(testDb, uuid.NewV4().String())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+		})
+	})
 })
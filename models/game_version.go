@@ -0,0 +1,183 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/lib/pq"
+	"github.com/topfreegames/khan/util"
+)
+
+// GameVersion is a snapshot of the configuration of a game at one of its versions
+type GameVersion struct {
+	ID        int64  `db:"id"`
+	GameID    string `db:"game_id"`
+	Version   int64  `db:"version"`
+	Snapshot  string `db:"snapshot"`
+	CreatedAt int64  `db:"created_at"`
+}
+
+// GetGame returns the game as it was at this version
+func (v *GameVersion) GetGame() (*Game, error) {
+	var game Game
+	err := json.Unmarshal([]byte(v.Snapshot), &game)
+	if err != nil {
+		return nil, err
+	}
+	return &game, nil
+}
+
+// Serialize returns a JSON with the version and the game configuration at it
+func (v *GameVersion) Serialize() (map[string]interface{}, error) {
+	game, err := v.GetGame()
+	if err != nil {
+		return nil, err
+	}
+	serializedGame := game.Serialize()
+	for _, field := range securitySettingsFields {
+		delete(serializedGame, field)
+	}
+	return map[string]interface{}{
+		"version":   v.Version,
+		"createdAt": v.CreatedAt,
+		"game":      serializedGame,
+	}, nil
+}
+
+// securitySettingsFields are the serialized game settings that are not stored in versions,
+// so they can not be rolled back
+var securitySettingsFields = []string{"playerEncryptedMetadataFields", "playerNameSettings", "playerTokenSettings"}
+
+// saveGameVersion stores a snapshot of the game at its current version, unless there is one already.
+// Security settings are left out of the snapshot
+func saveGameVersion(db DB, game *Game) error {
+	g := *game
+	g.PlayerEncryptedMetadataFields = ""
+	g.PlayerNameSettings = PlayerNameSettings{}
+	g.PlayerTokenSettings = PlayerTokenSettings{}
+	snapshot, err := json.Marshal(&g)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(`
+	INSERT INTO game_versions (game_id, version, snapshot, created_at)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (game_id, version) DO NOTHING
+	`, game.PublicID, game.Version, string(snapshot), util.NowMilli())
+	return err
+}
+
+// validateMembershipLevels returns a MembershipLevelsInUseError if memberships of the game that were
+// not deleted, denied or banned have levels other than the given ones
+func validateMembershipLevels(db DB, gameID string, levels map[string]interface{}) error {
+	names := make([]string, 0, len(levels))
+	for name := range levels {
+		names = append(names, name)
+	}
+
+	var orphanedLevels []string
+	_, err := db.Select(&orphanedLevels, `
+	SELECT DISTINCT membership_level FROM memberships
+	WHERE
+		game_id=$1 AND deleted_at=0 AND denied=false AND banned=false AND
+		membership_level <> ALL($2)
+	ORDER BY membership_level
+	`, gameID, pq.StringArray(names))
+	if err != nil {
+		return err
+	}
+	if len(orphanedLevels) > 0 {
+		return &MembershipLevelsInUseError{GameID: gameID, Levels: orphanedLevels}
+	}
+	return nil
+}
+
+// DeleteOldGameVersions deletes the stored versions of a game but the newest retention ones.
+// Nothing is deleted if retention is not positive
+func DeleteOldGameVersions(db DB, gameID string, retention int) error {
+	if retention <= 0 {
+		return nil
+	}
+	_, err := db.Exec(`
+	DELETE FROM game_versions
+	WHERE game_id=$1 AND version < (
+		SELECT MIN(version) FROM (
+			SELECT version FROM game_versions WHERE game_id=$1 ORDER BY version DESC LIMIT $2
+		) newest
+	)
+	`, gameID, retention)
+	return err
+}
+
+// GetGameVersions returns the stored versions of a game, newest first
+func GetGameVersions(db DB, gameID string) ([]*GameVersion, error) {
+	if _, err := GetGameByPublicID(db, gameID); err != nil {
+		return nil, err
+	}
+
+	var versions []*GameVersion
+	_, err := db.Select(
+		&versions,
+		"SELECT * FROM game_versions WHERE game_id=$1 ORDER BY version DESC",
+		gameID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// GetGameVersion returns a stored version of a game
+func GetGameVersion(db DB, gameID string, version int64) (*GameVersion, error) {
+	var versions []*GameVersion
+	_, err := db.Select(
+		&versions,
+		"SELECT * FROM game_versions WHERE game_id=$1 AND version=$2",
+		gameID, version,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, &ModelNotFoundError{"GameVersion", version}
+	}
+	return versions[0], nil
+}
+
+// RollbackGame updates a game to the configuration it had at the given version, which creates a new version.
+// Security settings are kept as they are. If expectedVersion is not zero, the game is only updated if it is at that version
+func RollbackGame(db DB, gameID string, version, expectedVersion int64) (*Game, error) {
+	current, err := getGameByPublicIDForUpdate(db, gameID)
+	if err != nil {
+		return nil, err
+	}
+	gameVersion, err := GetGameVersion(db, gameID, version)
+	if err != nil {
+		return nil, err
+	}
+	g, err := gameVersion.GetGame()
+	if err != nil {
+		return nil, err
+	}
+
+	return UpdateGame(
+		db, gameID, g.Name, g.MembershipLevels, g.Metadata,
+		g.MinLevelToAcceptApplication, g.MinLevelToCreateInvitation, g.MinLevelToRemoveMember,
+		g.MinLevelOffsetToRemoveMember, g.MinLevelOffsetToPromoteMember, g.MinLevelOffsetToDemoteMember,
+		g.MaxMembers, g.MaxClansPerPlayer, g.CooldownAfterDeny, g.CooldownAfterDelete,
+		g.CooldownBeforeApply, g.CooldownBeforeInvite, g.MaxPendingInvites,
+		g.ClanUpdateMetadataFieldsHookTriggerWhitelist,
+		g.PlayerUpdateMetadataFieldsHookTriggerWhitelist,
+		current.PlayerEncryptedMetadataFields,
+		&g.SearchSettings,
+		&current.PlayerNameSettings,
+		&current.PlayerTokenSettings,
+		expectedVersion,
+	)
+}
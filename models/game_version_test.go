@@ -0,0 +1,181 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	. "github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+var _ = Describe("Game Version Model", func() {
+	var testDb DB
+
+	BeforeEach(func() {
+		var err error
+		testDb, err = GetTestDB()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	updateGame := func(gameID string, levels map[string]interface{}, maxMembers int, expectedVersion int64) (*Game, error) {
+		return UpdateGame(
+			testDb, gameID, "game-name", levels, map[string]interface{}{"x": "a"},
+			1, 1, 2, 1, 1, 1, maxMembers, 1, 0, 0, 3600, 0, 20,
+			"", "", "", nil, nil, nil,
+			expectedVersion,
+		)
+	}
+
+	Describe("Game Versions", func() {
+		It("Should store a version of the game each time it is updated", func() {
+			gameID := uuid.NewV4().String()
+			levels := map[string]interface{}{"Member": 1, "Elder": 2}
+			_, err := updateGame(gameID, levels, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = updateGame(gameID, levels, 20, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			versions, err := GetGameVersions(testDb, gameID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions).To(HaveLen(2))
+			Expect(versions[0].Version).To(BeEquivalentTo(2))
+			Expect(versions[1].Version).To(BeEquivalentTo(1))
+
+			game, err := versions[1].GetGame()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(game.MaxMembers).To(Equal(10))
+			Expect(game.MembershipLevels).To(HaveLen(2))
+		})
+
+		It("Should store the previous version of games updated before versions were stored", func() {
+			game := fixtures.GameFactory.MustCreate().(*Game)
+			err := testDb.Insert(game)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = updateGame(game.PublicID, game.MembershipLevels, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			versions, err := GetGameVersions(testDb, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions).To(HaveLen(2))
+			previous, err := versions[1].GetGame()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(previous.MaxMembers).To(Equal(game.MaxMembers))
+		})
+
+		It("Should delete all but the newest versions", func() {
+			gameID := uuid.NewV4().String()
+			levels := map[string]interface{}{"Member": 1}
+			for _, maxMembers := range []int{10, 20, 30} {
+				_, err := updateGame(gameID, levels, maxMembers, 0)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			err := DeleteOldGameVersions(testDb, gameID, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			versions, err := GetGameVersions(testDb, gameID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions).To(HaveLen(2))
+			Expect(versions[0].Version).To(BeEquivalentTo(3))
+			Expect(versions[1].Version).To(BeEquivalentTo(2))
+		})
+
+		It("Should return an error if the game does not exist", func() {
+			_, err := GetGameVersions(testDb, uuid.NewV4().String())
+			Expect(err).To(BeAssignableToTypeOf(&ModelNotFoundError{}))
+		})
+	})
+
+	Describe("Membership Levels Validation", func() {
+		It("Should not remove membership levels used by memberships", func() {
+			game, _, _, _, _, err := fixtures.GetClanWithMemberships(testDb, 2, 0, 0, 0, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = updateGame(game.PublicID, map[string]interface{}{"Elder": 2, "CoLeader": 3}, 10, 0)
+			Expect(err).To(HaveOccurred())
+			levelsErr, ok := err.(*MembershipLevelsInUseError)
+			Expect(ok).To(BeTrue())
+			Expect(levelsErr.Levels).To(Equal([]string{"Member"}))
+
+			dbGame, err := GetGameByPublicID(testDb, game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbGame.MembershipLevels).To(HaveKey("Member"))
+		})
+
+		It("Should remove membership levels not used by memberships", func() {
+			game, _, _, _, _, err := fixtures.GetClanWithMemberships(testDb, 2, 0, 0, 0, "", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = updateGame(game.PublicID, map[string]interface{}{"Member": 1, "Elder": 2}, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("Rollback Game", func() {
+		It("Should restore the configuration of a previous version", func() {
+			gameID := uuid.NewV4().String()
+			_, err := updateGame(gameID, map[string]interface{}{"Member": 1, "Elder": 2}, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = updateGame(gameID, map[string]interface{}{"Member": 1, "Elder": 2, "CoLeader": 3}, 20, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			game, err := RollbackGame(testDb, gameID, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(game.Version).To(BeEquivalentTo(3))
+			Expect(game.MaxMembers).To(Equal(10))
+			Expect(game.MembershipLevels).To(HaveLen(2))
+			Expect(game.MaxMembershipLevel).To(Equal(2))
+		})
+
+		It("Should keep the current security settings", func() {
+			gameID := uuid.NewV4().String()
+			_, err := updateGame(gameID, map[string]interface{}{"Member": 1}, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = testDb.Exec(
+				"UPDATE games SET player_encrypted_metadata_fields='email', unique_player_names=true WHERE public_id=$1",
+				gameID,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			game, err := RollbackGame(testDb, gameID, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(game.PlayerEncryptedMetadataFields).To(Equal("email"))
+			Expect(game.PlayerNameSettings.Unique).To(BeTrue())
+
+			versions, err := GetGameVersions(testDb, gameID)
+			Expect(err).NotTo(HaveOccurred())
+			snapshot, err := versions[0].GetGame()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.PlayerEncryptedMetadataFields).To(BeEmpty())
+			Expect(snapshot.PlayerNameSettings.Unique).To(BeFalse())
+		})
+
+		It("Should not restore a version if the game is not at the expected version", func() {
+			gameID := uuid.NewV4().String()
+			_, err := updateGame(gameID, map[string]interface{}{"Member": 1}, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = updateGame(gameID, map[string]interface{}{"Member": 1}, 20, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = RollbackGame(testDb, gameID, 1, 1)
+			Expect(err).To(BeAssignableToTypeOf(&VersionMismatchError{}))
+		})
+
+		It("Should return an error if the version does not exist", func() {
+			gameID := uuid.NewV4().String()
+			_, err := updateGame(gameID, map[string]interface{}{"Member": 1}, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = RollbackGame(testDb, gameID, 5, 0)
+			Expect(err).To(BeAssignableToTypeOf(&ModelNotFoundError{}))
+		})
+	})
+})
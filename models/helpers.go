@@ -80,10 +80,13 @@ func InitDb(host string, user string, port int, sslmode string, dbName string, p
 		TypeConverter: util.TypeConverter{},
 	}
 
-	dbmap.AddTableWithName(Game{}, "games").SetKeys(true, "ID")
-	dbmap.AddTableWithName(Player{}, "players").SetKeys(true, "ID")
+	// gorp increments versions in the UPDATE itself, failing with gorp.OptimisticLockError if the row is no
+	// longer at the version that was read, so concurrent writes never set them back
+	dbmap.AddTableWithName(Game{}, "games").SetKeys(true, "ID").SetVersionCol("Version")
+	dbmap.AddTableWithName(Player{}, "players").SetKeys(true, "ID").SetVersionCol("Version")
 	dbmap.AddTableWithName(EncryptedPlayer{}, "encrypted_players")
-	dbmap.AddTableWithName(Clan{}, "clans").SetKeys(true, "ID")
+	dbmap.AddTableWithName(PlayerNameChange{}, "player_name_history").SetKeys(true, "ID")
+	dbmap.AddTableWithName(Clan{}, "clans").SetKeys(true, "ID").SetVersionCol("Version")
 	dbmap.AddTableWithName(Membership{}, "memberships").SetKeys(true, "ID")
 	dbmap.AddTableWithName(Hook{}, "hooks").SetKeys(true, "ID")
 
@@ -123,3 +126,4 @@ func nullOrBool(value sql.NullBool) bool {
 	}
 	return false
 }
+
@@ -53,6 +53,9 @@ const (
 
 	//MembershipLeftHook happens when a player leaves a clan
 	MembershipLeftHook = 12
+
+	//PlayerDeletedHook happens when a player and their personal data are deleted
+	PlayerDeletedHook = 13
 )
 
 // Hook identifies a webhook for a given event
@@ -0,0 +1,230 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/topfreegames/khan/util"
+)
+
+// IdempotencyKey stores the response of the first request sent with a key by a caller, which is replayed to
+// retries of it. The response is encrypted, since it may hold decrypted player data, so the public IDs named by the
+// request path and the response are kept in PublicIDs to find the keys of deleted players
+type IdempotencyKey struct {
+	ID          int64          `db:"id"`
+	GameID      string         `db:"game_id"`
+	Caller      string         `db:"caller"`
+	Key         string         `db:"key"`
+	Method      string         `db:"method"`
+	Path        string         `db:"path"`
+	RequestHash string         `db:"request_hash"`
+	Status      int            `db:"status"`
+	Response    string         `db:"response"`
+	Headers     string         `db:"headers"`
+	PublicIDs   pq.StringArray `db:"public_ids"`
+	CreatedAt   int64          `db:"created_at"`
+}
+
+// IdempotencyCaller returns the authenticated identity idempotency keys are scoped by: the player of the player
+// token, the API key or the basic auth user. Requests without credentials share their keys
+func IdempotencyCaller(playerTokenSubject, apiKeyPublicID, basicAuthUser string) string {
+	switch {
+	case playerTokenSubject != "":
+		return fmt.Sprintf("player:%s", playerTokenSubject)
+	case apiKeyPublicID != "":
+		return fmt.Sprintf("key:%s", apiKeyPublicID)
+	case basicAuthUser != "":
+		return fmt.Sprintf("basic:%s", basicAuthUser)
+	}
+	return ""
+}
+
+// Completed returns whether the response of the request was stored
+func (k *IdempotencyKey) Completed() bool {
+	return k.Status != 0
+}
+
+// GetResponse returns the stored response decrypted with encryptionKey or, if it was encrypted with a
+// previous key, with the keyring
+func (k *IdempotencyKey) GetResponse(encryptionKey []byte) (string, error) {
+	return decryptName(k.Response, encryptionKey)
+}
+
+// GetHeaders returns the stored response headers
+func (k *IdempotencyKey) GetHeaders() (map[string]string, error) {
+	headers := map[string]string{}
+	if k.Headers == "" {
+		return headers, nil
+	}
+	err := json.Unmarshal([]byte(k.Headers), &headers)
+	if err != nil {
+		return nil, err
+	}
+	return headers, nil
+}
+
+// ReserveIdempotencyKey reserves a key of the caller in the game for the request with the given method, path and
+// body hash and returns nil. If the key was already used within window, it returns the stored key instead, an
+// IdempotencyKeyInProgressError if its request has not finished or an IdempotencyKeyMismatchError if it was used
+// for another request. Reservations whose request did not finish within inProgressTTL, e.g. because the process
+// stopped, are taken over so the request can be retried. Expired keys are deleted by PruneIdempotencyKeys
+func ReserveIdempotencyKey(
+	db DB, gameID, caller, key, method, path, requestHash string, window, inProgressTTL time.Duration,
+) (*IdempotencyKey, error) {
+	now := util.NowMilli()
+	res, err := db.Exec(`
+	INSERT INTO idempotency_keys (game_id, caller, key, method, path, request_hash, public_ids, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $10, $7)
+	ON CONFLICT (game_id, caller, key) DO UPDATE SET
+		method=EXCLUDED.method, path=EXCLUDED.path, request_hash=EXCLUDED.request_hash,
+		status=0, response='', headers='{}', public_ids=EXCLUDED.public_ids, created_at=EXCLUDED.created_at
+	WHERE idempotency_keys.created_at<$8 OR (idempotency_keys.status=0 AND idempotency_keys.created_at<$9)
+	`,
+		gameID, caller, key, method, path, requestHash, now,
+		now-int64(window/time.Millisecond), now-int64(inProgressTTL/time.Millisecond),
+		pq.StringArray(pathSegments(path)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 1 {
+		return nil, nil
+	}
+
+	var keys []*IdempotencyKey
+	_, err = db.Select(
+		&keys, "SELECT * FROM idempotency_keys WHERE game_id=$1 AND caller=$2 AND key=$3", gameID, caller, key,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, &IdempotencyKeyInProgressError{key}
+	}
+	stored := keys[0]
+	if stored.Method != method || stored.Path != path || stored.RequestHash != requestHash {
+		return nil, &IdempotencyKeyMismatchError{key}
+	}
+	if !stored.Completed() {
+		return nil, &IdempotencyKeyInProgressError{key}
+	}
+	return stored, nil
+}
+
+// CompleteIdempotencyKey stores the response of the request of a reserved key, encrypted with encryptionKey,
+// with the response headers to replay
+func CompleteIdempotencyKey(
+	db DB, encryptionKey []byte, gameID, caller, key string, status int, response string, headers map[string]string,
+) error {
+	encryptedResponse, err := util.EncryptData(response, encryptionKey)
+	if err != nil {
+		return err
+	}
+	headersJSON, err := json.Marshal(headers)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(`
+	UPDATE idempotency_keys SET status=$4, response=$5, headers=$6, public_ids=public_ids || $7
+	WHERE game_id=$1 AND caller=$2 AND key=$3
+	`,
+		gameID, caller, key, status, encryptedResponse, string(headersJSON),
+		pq.StringArray(responsePublicIDs(response)),
+	)
+	return err
+}
+
+// DeletePlayerIdempotencyKeys deletes the keys of a game used by the player or whose request path or response
+// names it, so that their responses are not replayed with the data of a deleted player
+func DeletePlayerIdempotencyKeys(db DB, gameID, playerPublicID string) error {
+	_, err := db.Exec(`
+	DELETE FROM idempotency_keys
+	WHERE game_id=$1 AND (caller=$2 OR public_ids @> $3)
+	`, gameID, IdempotencyCaller(playerPublicID, "", ""), pq.StringArray([]string{playerPublicID}))
+	return err
+}
+
+func pathSegments(path string) []string {
+	segments := []string{}
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// responsePublicIDs returns the values of the publicID fields of a JSON response, e.g. publicID and
+// playerPublicID, at any depth
+func responsePublicIDs(response string) []string {
+	var payload interface{}
+	if err := json.Unmarshal([]byte(response), &payload); err != nil {
+		return []string{}
+	}
+	publicIDs := []string{}
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for field, fieldValue := range v {
+				if publicID, ok := fieldValue.(string); ok {
+					if field == "publicID" || strings.HasSuffix(field, "PublicID") {
+						publicIDs = append(publicIDs, publicID)
+					}
+					continue
+				}
+				walk(fieldValue)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(payload)
+	return publicIDs
+}
+
+// ReleaseIdempotencyKey removes a reserved key, so that the request can be retried with it
+func ReleaseIdempotencyKey(db DB, gameID, caller, key string) error {
+	_, err := db.Exec("DELETE FROM idempotency_keys WHERE game_id=$1 AND caller=$2 AND key=$3", gameID, caller, key)
+	return err
+}
+
+// PruneIdempotencyKeys deletes the keys used longer than window ago and the reservations of requests that did not
+// finish within inProgressTTL, batchSize at a time so that pruning does not lock every expired key at once, and
+// returns how many keys were deleted
+func PruneIdempotencyKeys(db DB, window, inProgressTTL time.Duration, batchSize int) (int, error) {
+	now := util.NowMilli()
+	query := `DELETE FROM idempotency_keys WHERE id IN (
+		SELECT id FROM idempotency_keys WHERE created_at<$1 OR (status=0 AND created_at<$2) LIMIT $3
+	)`
+
+	pruned := 0
+	for {
+		rows, err := runAndReturnRowsAffected(
+			query, db, now-int64(window/time.Millisecond), now-int64(inProgressTTL/time.Millisecond), batchSize,
+		)
+		if err != nil {
+			return pruned, err
+		}
+		pruned += rows
+		if rows < batchSize {
+			return pruned, nil
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	. "github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+var _ = Describe("Idempotency Key Model", func() {
+	var testDb DB
+	var gameID, key string
+
+	BeforeEach(func() {
+		var err error
+		testDb, err = GetTestDB()
+		Expect(err).NotTo(HaveOccurred())
+		gameID = uuid.NewV4().String()
+		key = uuid.NewV4().String()
+	})
+
+	reserveWithTTL := func(requestHash string, window, inProgressTTL time.Duration) (*IdempotencyKey, error) {
+		return ReserveIdempotencyKey(
+			testDb, gameID, "caller", key, "POST", "/games/game/players", requestHash, window, inProgressTTL,
+		)
+	}
+
+	complete := func(status int, response string, headers map[string]string) error {
+		return CompleteIdempotencyKey(
+			testDb, fixtures.GetEncryptionKey(), gameID, "caller", key, status, response, headers,
+		)
+	}
+
+	reserve := func(requestHash string, window time.Duration) (*IdempotencyKey, error) {
+		return reserveWithTTL(requestHash, window, time.Hour)
+	}
+
+	It("Should return the stored response of a completed key", func() {
+		stored, err := reserve("hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored).To(BeNil())
+		headers := map[string]string{"ETag": `"2"`}
+		Expect(complete(200, `{"success":true}`, headers)).To(Succeed())
+
+		stored, err = reserve("hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.Status).To(Equal(200))
+		Expect(stored.Response).NotTo(ContainSubstring("success"))
+		response, err := stored.GetResponse(fixtures.GetEncryptionKey())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(Equal(`{"success":true}`))
+		storedHeaders, err := stored.GetHeaders()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(storedHeaders).To(Equal(headers))
+	})
+
+	It("Should return an error if the request of the key is in progress", func() {
+		_, err := reserve("hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = reserve("hash", time.Hour)
+		Expect(err).To(BeAssignableToTypeOf(&IdempotencyKeyInProgressError{}))
+	})
+
+	It("Should reserve a key again if its request did not finish in time", func() {
+		_, err := reserve("hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		time.Sleep(10 * time.Millisecond)
+
+		stored, err := reserveWithTTL("hash", time.Hour, time.Millisecond)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored).To(BeNil())
+	})
+
+	It("Should return an error if the key was used for another request", func() {
+		_, err := reserve("hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(complete(200, "{}", nil)).To(Succeed())
+
+		_, err = reserve("other-hash", time.Hour)
+		Expect(err).To(BeAssignableToTypeOf(&IdempotencyKeyMismatchError{}))
+	})
+
+	It("Should reserve a released key again", func() {
+		_, err := reserve("hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ReleaseIdempotencyKey(testDb, gameID, "caller", key)).To(Succeed())
+
+		stored, err := reserve("other-hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored).To(BeNil())
+	})
+
+	It("Should reserve an expired key again", func() {
+		_, err := reserve("hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(complete(200, "{}", nil)).To(Succeed())
+		time.Sleep(10 * time.Millisecond)
+
+		stored, err := reserve("other-hash", time.Millisecond)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored).To(BeNil())
+	})
+
+	It("Should keep the keys of each caller apart", func() {
+		_, err := reserve("hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(complete(200, "{}", nil)).To(Succeed())
+
+		stored, err := ReserveIdempotencyKey(
+			testDb, gameID, "other-caller", key, "POST", "/games/game/players", "other-hash", time.Hour, time.Hour,
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored).To(BeNil())
+	})
+
+	It("Should delete the keys whose path or response names a player", func() {
+		countKeys := func() int64 {
+			count, err := testDb.SelectInt("SELECT COUNT(*) FROM idempotency_keys WHERE game_id=$1", gameID)
+			Expect(err).NotTo(HaveOccurred())
+			return count
+		}
+		_, err := reserve("hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(complete(200, `{"clan":{"owner":{"publicID":"player-1"}},"playerPublicID":"player-2"}`, nil)).To(Succeed())
+		_, err = ReserveIdempotencyKey(
+			testDb, gameID, "caller", "path-key", "PUT", "/games/game/players/player-3", "hash", time.Hour, time.Hour,
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(DeletePlayerIdempotencyKeys(testDb, gameID, "player-4")).To(Succeed())
+		Expect(countKeys()).To(BeEquivalentTo(2))
+		Expect(DeletePlayerIdempotencyKeys(testDb, gameID, "player-2")).To(Succeed())
+		Expect(countKeys()).To(BeEquivalentTo(1))
+		Expect(DeletePlayerIdempotencyKeys(testDb, gameID, "player-3")).To(Succeed())
+		Expect(countKeys()).To(BeEquivalentTo(0))
+	})
+
+	It("Should prune expired keys and stale reservations", func() {
+		_, err := reserve("hash", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(complete(200, "{}", nil)).To(Succeed())
+		time.Sleep(10 * time.Millisecond)
+
+		pruned, err := PruneIdempotencyKeys(testDb, time.Millisecond, time.Hour, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pruned).To(BeNumerically(">=", 1))
+
+		count, err := testDb.SelectInt(
+			"SELECT COUNT(*) FROM idempotency_keys WHERE game_id=$1 AND key=$2", gameID, key,
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(BeEquivalentTo(0))
+	})
+})
@@ -48,12 +48,22 @@ func (m *Membership) PreInsert(s gorp.SqlExecutor) error {
 	return nil
 }
 
+// PostInsert invalidates the cached details of the clan and its members after creation in PG
+func (m *Membership) PostInsert(s gorp.SqlExecutor) error {
+	return invalidateClanCacheByID(s, m.ClanID)
+}
+
 // PreUpdate populates fields before updating a clan
 func (m *Membership) PreUpdate(s gorp.SqlExecutor) error {
 	m.UpdatedAt = util.NowMilli()
 	return nil
 }
 
+// PostUpdate invalidates the cached details of the clan and its members after update in PG
+func (m *Membership) PostUpdate(s gorp.SqlExecutor) error {
+	return invalidateClanCacheByID(s, m.ClanID)
+}
+
 // GetMembershipByID returns a membership by id
 func GetMembershipByID(db DB, id int64) (*Membership, error) {
 	obj, err := db.Get(Membership{}, id)
@@ -5,33 +5,43 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/globalsign/mgo"
 	"github.com/jrallison/go-workers"
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/spf13/viper"
 	"github.com/topfreegames/extensions/v9/mongo/interfaces"
 	"github.com/topfreegames/extensions/v9/tracing"
 	"github.com/topfreegames/khan/mongo"
+	"github.com/topfreegames/khan/util"
 	"github.com/uber-go/zap"
 )
 
+// reindexBatchSize is the number of clans inserted at once when rebuilding the clans collection of a game
+const reindexBatchSize = 1000
+
 // MongoWorker is the worker that will update mongo
 type MongoWorker struct {
 	Logger                  zap.Logger
+	DB                      DB
 	MongoDB                 interfaces.MongoDB
+	MongoDatabase           string
 	MongoCollectionTemplate string
 }
 
 // NewMongoWorker creates and returns a new mongo worker
-func NewMongoWorker(logger zap.Logger, config *viper.Viper) *MongoWorker {
+func NewMongoWorker(logger zap.Logger, config *viper.Viper, db DB) *MongoWorker {
 	w := &MongoWorker{
 		Logger: logger,
+		DB:     db,
 	}
 	w.configureMongoWorker(config)
 	return w
 }
 
 func (w *MongoWorker) configureMongoWorker(config *viper.Viper) {
+	w.MongoDatabase = config.GetString("mongodb.databaseName")
 	w.MongoCollectionTemplate = config.GetString("mongodb.collectionTemplate")
 	w.MongoDB = mongo.GetConfiguredMongoClient()
 }
@@ -48,28 +58,188 @@ func (w *MongoWorker) PerformUpdateMongo(m *workers.Msg) {
 	data := item.MustMap()
 	game := data["game"].(string)
 	op := data["op"].(string)
+
+	// a deleted game has its collection dropped, which writing to it would create again
+	deleted, err := IsGameDeleted(w.DB, game)
+	if err != nil {
+		panic(err)
+	}
+	if deleted {
+		w.Logger.Debug("Skipping update of deleted game.", zap.String("game", game), zap.String("operation", op))
+		return
+	}
+
+	if op == "reindex" {
+		err := w.ReindexClans(ctx, game, data["rebuildIndex"].(bool))
+		if err != nil {
+			panic(err)
+		}
+		return
+	}
 	clan := data["clan"].(map[string]interface{})
 	clanID := data["clanID"].(string)
 
 	w.updateClanIntoMongoDB(ctx, game, op, clan, clanID)
 }
 
-// InsertGame creates a game inside Mongo
+// InsertGame creates a game inside Mongo, indexing the clan name with the search settings of the game
 func (w *MongoWorker) InsertGame(ctx context.Context, gameID string, clan *Clan) error {
-	clanWithNamePrefixes := clan.NewClanWithNamePrefixes()
-	clanJSON, err := json.Marshal(clanWithNamePrefixes)
+	settings, err := GetGameSearchSettings(w.DB, gameID)
 	if err != nil {
-		return errors.New("Could not serialize clan")
+		return err
+	}
+	clanMap, err := getClanMongoDocument(clan, settings)
+	if err != nil {
+		return err
 	}
-
-	var clanMap map[string]interface{}
-	json.Unmarshal(clanJSON, &clanMap)
 
 	w.updateClanIntoMongoDB(ctx, gameID, "update", clanMap, clan.PublicID)
 
 	return nil
 }
 
+// ReindexClans recomputes the name prefixes of the clans of a game with its current search settings.
+// As a collection holds a single text index, rebuilding the index writes the clans to a new collection
+// that replaces the current one once indexed, so clans can be searched during the whole reindex
+func (w *MongoWorker) ReindexClans(ctx context.Context, gameID string, rebuildIndex bool) error {
+	if w.MongoDB == nil {
+		return nil
+	}
+
+	logger := w.Logger.With(
+		zap.String("game", gameID),
+		zap.Bool("rebuildIndex", rebuildIndex),
+		zap.String("source", "ReindexClans"),
+	)
+
+	start := util.NowMilli()
+	settings, err := GetGameSearchSettings(w.DB, gameID)
+	if err != nil {
+		if _, ok := err.(*ModelNotFoundError); ok {
+			logger.Info("Game not found, skipping reindex.")
+			return nil
+		}
+		return err
+	}
+	clans, err := GetAllClans(w.DB, gameID)
+	if err != nil {
+		return err
+	}
+
+	if !rebuildIndex {
+		for i := range clans {
+			clans[i].enqueueMongoUpdate(settings)
+		}
+		logger.Info("Enqueued clans reindex.", zap.Int("clans", len(clans)))
+		return nil
+	}
+
+	err = w.rebuildClansCollection(ctx, gameID, settings, clans)
+	if err != nil {
+		return err
+	}
+	logger.Info("Rebuilt clans collection.", zap.Int("clans", len(clans)))
+
+	// clans written while the new collection was built were written to the replaced one
+	return w.reconcileClans(ctx, gameID, settings, start)
+}
+
+func (w *MongoWorker) rebuildClansCollection(
+	ctx context.Context, gameID string, settings *SearchSettings, clans []Clan,
+) error {
+	mongoDB := w.MongoDB.WithContext(ctx)
+	collection := fmt.Sprintf(w.MongoCollectionTemplate, gameID)
+	newCollection := collection + "_reindex"
+
+	// a previous attempt may have left the new collection behind
+	err := mongoDB.Run(mongo.GetDropCollectionCommand(newCollection), nil)
+	if err != nil && !strings.Contains(err.Error(), "ns not found") {
+		return err
+	}
+
+	mongoCol, mongoSess := mongoDB.C(newCollection)
+	defer mongoSess.Close()
+
+	docs := make([]interface{}, 0, reindexBatchSize)
+	for i := range clans {
+		doc, err := getClanMongoDocument(&clans[i], settings)
+		if err != nil {
+			return err
+		}
+		doc["_id"] = clans[i].PublicID
+		docs = append(docs, doc)
+		if len(docs) == reindexBatchSize || i == len(clans)-1 {
+			err = mongoCol.Insert(docs...)
+			if err != nil {
+				return err
+			}
+			docs = docs[:0]
+		}
+	}
+
+	err = mongoDB.Run(mongo.GetClanNameTextIndexCommandForCollection(
+		newCollection, gameID, false, settings.NameWeight, settings.NamePrefixesWeight,
+	), nil)
+	if err != nil {
+		return err
+	}
+
+	adminSess := mongoSess.Copy()
+	defer adminSess.Close()
+	return adminSess.Run(mongo.GetRenameCollectionCommand(w.MongoDatabase, newCollection, collection), nil)
+}
+
+// reconcileClans enqueues the clans updated since the given time and removes the clans deleted from postgres
+func (w *MongoWorker) reconcileClans(ctx context.Context, gameID string, settings *SearchSettings, since int64) error {
+	clans, err := GetAllClans(w.DB, gameID)
+	if err != nil {
+		return err
+	}
+	clanIDs := make(map[string]bool, len(clans))
+	for i := range clans {
+		clanIDs[clans[i].PublicID] = true
+		if clans[i].UpdatedAt >= since {
+			clans[i].enqueueMongoUpdate(settings)
+		}
+	}
+
+	mongoCol, mongoSess := w.MongoDB.WithContext(ctx).C(fmt.Sprintf(w.MongoCollectionTemplate, gameID))
+	defer mongoSess.Close()
+
+	var doc struct {
+		ID string `bson:"_id"`
+	}
+	var deletedIDs []string
+	iter := mongoCol.Find(nil).Iter()
+	for iter.Next(&doc) {
+		if !clanIDs[doc.ID] {
+			deletedIDs = append(deletedIDs, doc.ID)
+		}
+	}
+	err = iter.Close()
+	if err != nil {
+		return err
+	}
+	for _, clanID := range deletedIDs {
+		err = mongoCol.RemoveId(clanID)
+		if err != nil && err != mgo.ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+func getClanMongoDocument(clan *Clan, settings *SearchSettings) (map[string]interface{}, error) {
+	clanJSON, err := json.Marshal(clan.NewClanWithNamePrefixes(settings))
+	if err != nil {
+		return nil, errors.New("Could not serialize clan")
+	}
+
+	var clanMap map[string]interface{}
+	err = json.Unmarshal(clanJSON, &clanMap)
+	return clanMap, err
+}
+
 func (w *MongoWorker) updateClanIntoMongoDB(
 	ctx context.Context, gameID string, op string, clan map[string]interface{}, clanID string,
 ) {
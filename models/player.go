@@ -8,6 +8,7 @@
 package models
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 
@@ -29,12 +30,15 @@ type Player struct {
 	OwnershipCount  int                    `db:"ownership_count"`
 	CreatedAt       int64                  `db:"created_at"`
 	UpdatedAt       int64                  `db:"updated_at"`
+	Version         int64                  `db:"version"`
+	NameHash        sql.NullString         `db:"name_hash"`
 }
 
 // PreInsert populates fields before inserting a new player
 func (p *Player) PreInsert(s gorp.SqlExecutor) error {
 	p.CreatedAt = util.NowMilli()
 	p.UpdatedAt = p.CreatedAt
+	p.Version = 1
 	return nil
 }
 
@@ -44,9 +48,14 @@ func (p *Player) PreUpdate(s gorp.SqlExecutor) error {
 	return nil
 }
 
+// PostUpdate invalidates the cached details of the player and its clans after update in PG
+func (p *Player) PostUpdate(s gorp.SqlExecutor) error {
+	return invalidatePlayerCacheByID(s, p.ID)
+}
+
 //Serialize the player information to JSON
 func (p *Player) Serialize(encryptionKey []byte) map[string]interface{} {
-	return decryptPlayerName(map[string]interface{}{
+	return decryptPlayerPayload(map[string]interface{}{
 		"gameID":          p.GameID,
 		"publicID":        p.PublicID,
 		"name":            p.Name,
@@ -58,7 +67,7 @@ func (p *Player) Serialize(encryptionKey []byte) map[string]interface{} {
 
 //SerializeClanParticipant the player information to JSON
 func (p *Player) SerializeClanParticipant(encryptionKey []byte) map[string]interface{} {
-	return decryptPlayerName(map[string]interface{}{
+	return decryptPlayerPayload(map[string]interface{}{
 		"publicID": p.PublicID,
 		"name":     p.Name,
 		"metadata": p.Metadata,
@@ -67,7 +76,7 @@ func (p *Player) SerializeClanParticipant(encryptionKey []byte) map[string]inter
 
 //SerializeClanActor the player information to JSON
 func (p *Player) SerializeClanActor(encryptionKey []byte) map[string]interface{} {
-	return decryptPlayerName(map[string]interface{}{
+	return decryptPlayerPayload(map[string]interface{}{
 		"publicID": p.PublicID,
 		"name":     p.Name,
 	}, encryptionKey)
@@ -75,7 +84,7 @@ func (p *Player) SerializeClanActor(encryptionKey []byte) map[string]interface{}
 
 //SerializeWithLevel serialize player fields: PublicID and Name with MembershipCount passed by param
 func (p *Player) SerializeWithLevel(encryptionKey []byte, level string) map[string]interface{} {
-	return decryptPlayerName(map[string]interface{}{
+	return decryptPlayerPayload(map[string]interface{}{
 		"publicID": p.PublicID,
 		"name":     p.Name,
 		"level":    level,
@@ -107,7 +116,7 @@ func UpdatePlayerMembershipCount(db DB, id int64) error {
 	if rows != 1 {
 		return &ModelNotFoundError{"Player", id}
 	}
-	return nil
+	return invalidatePlayerCacheByID(db, id)
 }
 
 // UpdatePlayerOwnershipCount updates the player ownership count
@@ -133,21 +142,24 @@ func UpdatePlayerOwnershipCount(db DB, id int64) error {
 	if rows != 1 {
 		return &ModelNotFoundError{"Player", id}
 	}
-	return nil
+	return invalidatePlayerCacheByID(db, id)
 }
 
 // GetPlayerByID returns a player by id
 func GetPlayerByID(db DB, encryptionKey []byte, id int64) (*Player, error) {
-	playerInterface, err := db.Get(Player{}, id)
+	// db.Get does not read transient columns such as version
+	var players []*Player
+	_, err := db.Select(&players, "SELECT * FROM players WHERE id=$1", id)
 	if err != nil {
 		return nil, err
 	}
-	if playerInterface == nil {
+	if len(players) < 1 {
 		return nil, &ModelNotFoundError{"Player", id}
 	}
 
-	player := playerInterface.(*Player)
-	name, err := util.DecryptData(player.Name, encryptionKey)
+	player := players[0]
+	player.Metadata = decryptMetadata(player.Metadata, encryptionKey)
+	name, err := decryptStoredPlayerName(db, encryptionKey, player)
 	if err != nil {
 		return player, nil
 	}
@@ -169,7 +181,8 @@ func GetPlayerByPublicID(db DB, encryptionKey []byte, gameID string, publicID st
 	}
 
 	player := players[0]
-	name, err := util.DecryptData(player.Name, encryptionKey)
+	player.Metadata = decryptMetadata(player.Metadata, encryptionKey)
+	name, err := decryptStoredPlayerName(db, encryptionKey, player)
 	if err != nil {
 		return player, nil
 	}
@@ -180,6 +193,15 @@ func GetPlayerByPublicID(db DB, encryptionKey []byte, gameID string, publicID st
 
 // CreatePlayer creates a new player
 func CreatePlayer(db DB, logger zap.Logger, encryptionKey []byte, gameID, publicID, name string, metadata map[string]interface{}) (*Player, error) {
+	metadata, err := encryptPlayerMetadata(db, encryptionKey, gameID, metadata)
+	if err != nil {
+		return nil, err
+	}
+	nameSettings, err := getPlayerNameSettings(db, gameID)
+	if err != nil {
+		return nil, err
+	}
+
 	markAsEncrypted := true
 	encryptedName, err := util.EncryptData(name, encryptionKey)
 	if err != nil {
@@ -192,14 +214,15 @@ func CreatePlayer(db DB, logger zap.Logger, encryptionKey []byte, gameID, public
 		PublicID: publicID,
 		Name:     encryptedName,
 		Metadata: metadata,
+		NameHash: playerNameHash(nameSettings, name),
 	}
 	err = db.Insert(player)
 	if err != nil {
-		return nil, err
+		return nil, playerNameError(err, gameID, name)
 	}
 
 	if markAsEncrypted {
-		err = db.Insert(&EncryptedPlayer{PlayerID: player.ID})
+		err = db.Insert(&EncryptedPlayer{PlayerID: player.ID, KeyID: encryptionKeyID(encryptionKey)})
 		if err != nil {
 			logger.Error("Error on insert EncryptedPlayer", zap.Error(err))
 		}
@@ -208,8 +231,23 @@ func CreatePlayer(db DB, logger zap.Logger, encryptionKey []byte, gameID, public
 	return GetPlayerByID(db, encryptionKey, player.ID)
 }
 
-// UpdatePlayer updates an existing player
-func UpdatePlayer(db DB, logger zap.Logger, encryptionKey []byte, gameID, publicID, name string, metadata map[string]interface{}) (*Player, error) {
+// UpdatePlayer updates an existing player or creates it. If expectedVersion is not zero, the player is
+// only updated if it exists and is at that version
+func UpdatePlayer(db DB, logger zap.Logger, encryptionKey []byte, gameID, publicID, name string, metadata map[string]interface{}, expectedVersion int64) (*Player, error) {
+	metadata, err := encryptPlayerMetadata(db, encryptionKey, gameID, metadata)
+	if err != nil {
+		return nil, err
+	}
+	nameSettings, err := getPlayerNameSettings(db, gameID)
+	if err != nil {
+		return nil, err
+	}
+	previousNames, err := getStoredPlayerNames(db, nameSettings, gameID, publicID)
+	if err != nil {
+		return nil, err
+	}
+	nameHash := playerNameHash(nameSettings, name)
+
 	markAsEncrypted := true
 	encryptedName, err := util.EncryptData(name, encryptionKey)
 	if err != nil {
@@ -222,22 +260,49 @@ func UpdatePlayer(db DB, logger zap.Logger, encryptionKey []byte, gameID, public
 		return nil, err
 	}
 
-	query := `INSERT INTO players(game_id, public_id, name, metadata, created_at, updated_at)
-						VALUES($1, $2, $3, $4, $5, $5) ON CONFLICT (game_id, public_id)
-						DO UPDATE set name=$3, metadata=$4, updated_at=$5
+	query := `INSERT INTO players(game_id, public_id, name, metadata, name_hash, created_at, updated_at)
+						VALUES($1, $2, $3, $4, $6, $5, $5) ON CONFLICT (game_id, public_id)
+						DO UPDATE set name=$3, metadata=$4, name_hash=$6, updated_at=$5, version=players.version+1
 						WHERE players.game_id=$1 and players.public_id=$2
 						RETURNING id`
 
 	var lastID int64
-	lastID, err = db.SelectInt(query,
-		gameID, publicID, encryptedName, metadataJSON, util.NowMilli())
+	if expectedVersion != 0 {
+		versionQuery := `UPDATE players SET name=$3, metadata=$4, name_hash=$6, updated_at=$5, version=version+1
+						WHERE game_id=$1 AND public_id=$2 AND version=$7
+						RETURNING id`
+		var ids []int64
+		_, err = db.Select(&ids, versionQuery,
+			gameID, publicID, encryptedName, metadataJSON, util.NowMilli(), nameHash, expectedVersion)
+		if err != nil {
+			return nil, playerNameError(err, gameID, name)
+		}
+		if len(ids) == 0 {
+			return nil, &VersionMismatchError{"Player", publicID, expectedVersion}
+		}
+		lastID = ids[0]
+	} else {
+		lastID, err = db.SelectInt(query,
+			gameID, publicID, encryptedName, metadataJSON, util.NowMilli(), nameHash)
+		if err != nil {
+			return nil, playerNameError(err, gameID, name)
+		}
+	}
+
+	err = recordPlayerNameChange(db, encryptionKey, previousNames[publicID], name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = invalidatePlayerCacheByID(db, lastID)
 	if err != nil {
 		return nil, err
 	}
 
 	if markAsEncrypted {
-		queryEncrypt := `INSERT INTO encrypted_players (player_id) VALUES ($1) ON CONFLICT DO NOTHING`
-		_, err = db.Exec(queryEncrypt, lastID)
+		queryEncrypt := `INSERT INTO encrypted_players (player_id, key_id) VALUES ($1, $2)
+						ON CONFLICT (player_id) DO UPDATE set key_id=$2`
+		_, err = db.Exec(queryEncrypt, lastID, encryptionKeyID(encryptionKey))
 		if err != nil {
 			logger.Error("Error on insert EncryptedPlayer", zap.Error(err))
 		}
@@ -246,6 +311,88 @@ func UpdatePlayer(db DB, logger zap.Logger, encryptionKey []byte, gameID, public
 	return GetPlayerByID(db, encryptionKey, lastID)
 }
 
+// PatchPlayer applies a JSON Merge Patch (RFC 7396) to the metadata of a player. The patch is applied
+// by the database, so concurrent patches to different metadata fields do not overwrite each other.
+// The name of the player is replaced unless name is empty. If expectedVersion is not zero, the player is
+// only patched if it is at that version
+func PatchPlayer(db DB, logger zap.Logger, encryptionKey []byte, gameID, publicID, name string, metadataPatch map[string]interface{}, expectedVersion int64) (*Player, error) {
+	if metadataPatch == nil {
+		metadataPatch = map[string]interface{}{}
+	}
+	metadataPatch, err := encryptPlayerMetadata(db, encryptionKey, gameID, metadataPatch)
+	if err != nil {
+		return nil, err
+	}
+	metadataJSON, err := json.Marshal(metadataPatch)
+	if err != nil {
+		return nil, err
+	}
+	nameSettings, err := getPlayerNameSettings(db, gameID)
+	if err != nil {
+		return nil, err
+	}
+	var previousNames map[string]*storedPlayerName
+	if name != "" {
+		previousNames, err = getStoredPlayerNames(db, nameSettings, gameID, publicID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	markAsEncrypted := name != ""
+	encryptedName := name
+	if name != "" {
+		encryptedName, err = util.EncryptData(name, encryptionKey)
+		if err != nil {
+			encryptedName = name
+			markAsEncrypted = false
+		}
+	}
+
+	query := `UPDATE players SET
+						name=CASE WHEN $3='' THEN name ELSE $3 END,
+						name_hash=CASE WHEN $3='' THEN name_hash ELSE $6 END,
+						metadata=jsonb_merge_patch(metadata, $4),
+						updated_at=$5,
+						version=version+1
+						WHERE game_id=$1 AND public_id=$2 AND ($7::bigint=0 OR version=$7)
+						RETURNING id`
+
+	var ids []int64
+	_, err = db.Select(&ids, query, gameID, publicID, encryptedName, string(metadataJSON), util.NowMilli(),
+		playerNameHash(nameSettings, name), expectedVersion)
+	if err != nil {
+		return nil, playerNameError(err, gameID, name)
+	}
+	if len(ids) == 0 {
+		if expectedVersion != 0 {
+			return nil, &VersionMismatchError{"Player", publicID, expectedVersion}
+		}
+		return nil, &ModelNotFoundError{"Player", publicID}
+	}
+
+	err = recordPlayerNameChange(db, encryptionKey, previousNames[publicID], name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = invalidatePlayerCacheByID(db, ids[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if markAsEncrypted {
+		queryEncrypt := `INSERT INTO encrypted_players (player_id, key_id) VALUES ($1, $2)
+						ON CONFLICT (player_id) DO UPDATE set key_id=$2`
+		_, err = db.Exec(queryEncrypt, ids[0], encryptionKeyID(encryptionKey))
+		if err != nil {
+			logger.Error("Error on insert EncryptedPlayer", zap.Error(err))
+		}
+	}
+
+	return GetPlayerByID(db, encryptionKey, ids[0])
+}
+
 // GetPlayerOwnershipDetails returns detailed information about a player owned clans
 func GetPlayerOwnershipDetails(db DB, gameID, publicID string) (map[string]interface{}, error) {
 	query := `
@@ -355,24 +502,24 @@ func ApplySecurityChanges(db egorp.Database, encryptionKey []byte, players []*Pl
 		player.Name = encryptedName
 
 		if err != nil {
-			err = trx.Rollback()
+			err = Rollback(trx)
 			return err
 		}
 
 		_, err = trx.Update(player)
 		if err != nil {
-			err = trx.Rollback()
+			err = Rollback(trx)
 			return err
 		}
 
-		err = trx.Insert(&EncryptedPlayer{PlayerID: player.ID})
+		err = trx.Insert(&EncryptedPlayer{PlayerID: player.ID, KeyID: encryptionKeyID(encryptionKey)})
 		if err != nil {
-			err = trx.Rollback()
+			err = Rollback(trx)
 			return err
 		}
 	}
 
-	err = trx.Commit()
+	err = Commit(trx)
 	if err != nil {
 		return err
 	}
@@ -391,7 +538,7 @@ func getPlayerMembershipDetails(db DB, encryptionKey []byte, gameID, publicID st
 	query := `
 	SELECT
 		p.id PlayerID, p.name PlayerName, p.metadata PlayerMetadata, p.public_id PlayerPublicID,
-		p.created_at PlayerCreatedAt, p.updated_at PlayerUpdatedAt,
+		p.created_at PlayerCreatedAt, p.updated_at PlayerUpdatedAt, p.version PlayerVersion,
 		m.membership_level MembershipLevel,
 		m.approved MembershipApproved, m.denied MembershipDenied, m.banned MembershipBanned,
 		c.public_id ClanPublicID, c.name ClanName, c.metadata DBClanMetadata, c.owner_id ClanOwnerID,
@@ -432,14 +579,15 @@ func getPlayerMembershipDetails(db DB, encryptionKey []byte, gameID, publicID st
 
 	result := make(map[string]interface{})
 
-	result["name"], err = util.DecryptData(details[0].PlayerName, encryptionKey)
+	result["name"], err = decryptName(details[0].PlayerName, encryptionKey)
 	if err != nil {
 		result["name"] = details[0].PlayerName
 	}
-	result["metadata"] = details[0].PlayerMetadata
+	result["metadata"] = decryptMetadata(details[0].PlayerMetadata, encryptionKey)
 	result["publicID"] = details[0].PlayerPublicID
 	result["createdAt"] = details[0].PlayerCreatedAt
 	result["updatedAt"] = details[0].PlayerUpdatedAt
+	result["version"] = details[0].PlayerVersion
 
 	if details[0].MembershipLevel.Valid {
 		// Player has memberships
@@ -508,8 +656,12 @@ func getPlayerMembershipDetails(db DB, encryptionKey []byte, gameID, publicID st
 	return result, nil
 }
 
-func decryptPlayerName(payload map[string]interface{}, encryptionKey []byte) map[string]interface{} {
-	name, err := util.DecryptData(fmt.Sprint(payload["name"]), encryptionKey)
+func decryptPlayerPayload(payload map[string]interface{}, encryptionKey []byte) map[string]interface{} {
+	if metadata, ok := payload["metadata"].(map[string]interface{}); ok {
+		payload["metadata"] = decryptMetadata(metadata, encryptionKey)
+	}
+
+	name, err := decryptName(fmt.Sprint(payload["name"]), encryptionKey)
 	if err != nil {
 		return payload
 	}
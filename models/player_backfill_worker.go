@@ -0,0 +1,297 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jrallison/go-workers"
+	opentracing "github.com/opentracing/opentracing-go"
+	egorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
+	"github.com/topfreegames/extensions/v9/tracing"
+	"github.com/topfreegames/khan/queues"
+	"github.com/uber-go/zap"
+)
+
+// defaultPlayerBackfillBatchSize is the amount of players updated in each transaction of a backfill
+const defaultPlayerBackfillBatchSize = 500
+
+// PlayerBackfillWorker is the worker that updates the stored players of a game after its settings change
+type PlayerBackfillWorker struct {
+	Logger        zap.Logger
+	DB            egorp.Database
+	EncryptionKey []byte
+	BatchSize     int
+}
+
+// NewPlayerBackfillWorker creates and returns a new player backfill worker
+func NewPlayerBackfillWorker(logger zap.Logger, db egorp.Database, encryptionKey []byte) *PlayerBackfillWorker {
+	return &PlayerBackfillWorker{
+		Logger:        logger,
+		DB:            db,
+		EncryptionKey: encryptionKey,
+		BatchSize:     defaultPlayerBackfillBatchSize,
+	}
+}
+
+// EnqueuePlayersMetadataEncryption enqueues the encryption of the values of the given metadata fields
+// stored before the game encrypted them and returns the id of the job
+func EnqueuePlayersMetadataEncryption(gameID string, fields []string) (string, error) {
+	return workers.EnqueueWithOptions(queues.KhanPlayerBackfillQueue, "EncryptMetadata", map[string]interface{}{
+		"game":   gameID,
+		"op":     "encryptMetadata",
+		"fields": fields,
+	}, workers.EnqueueOptions{
+		Retry: true,
+		At:    float64(time.Now().UnixNano()) / float64(time.Second),
+	})
+}
+
+// EnqueuePlayersNamesHashing enqueues the hashing of the names of the players stored before the game
+// required unique names and returns the id of the job
+func EnqueuePlayersNamesHashing(gameID string) (string, error) {
+	return workers.EnqueueWithOptions(queues.KhanPlayerBackfillQueue, "HashNames", map[string]interface{}{
+		"game": gameID,
+		"op":   "hashNames",
+	}, workers.EnqueueOptions{
+		Retry: true,
+		At:    float64(time.Now().UnixNano()) / float64(time.Second),
+	})
+}
+
+// PerformBackfill updates the stored players of a game as requested by the job
+func (w *PlayerBackfillWorker) PerformBackfill(m *workers.Msg) {
+	tags := opentracing.Tags{"component": "go-workers"}
+	span := opentracing.StartSpan("PerformBackfill", tags)
+	defer span.Finish()
+	defer tracing.LogPanic(span)
+	ctx := opentracing.ContextWithSpan(context.Background(), span)
+
+	item := m.Args()
+	data := item.MustMap()
+	gameID := data["game"].(string)
+	op := data["op"].(string)
+
+	deleted, err := IsGameDeleted(w.DB, gameID)
+	if err != nil {
+		panic(err)
+	}
+	if deleted {
+		w.Logger.Debug("Skipping backfill of deleted game.", zap.String("game", gameID), zap.String("operation", op))
+		return
+	}
+
+	switch op {
+	case "encryptMetadata":
+		var fields []string
+		for _, field := range data["fields"].([]interface{}) {
+			fields = append(fields, field.(string))
+		}
+		err = w.EncryptPlayersMetadata(ctx, gameID, fields)
+	case "hashNames":
+		err = w.HashPlayersNames(ctx, gameID)
+	default:
+		err = fmt.Errorf("unknown player backfill operation %s", op)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EncryptPlayersMetadata encrypts, in batches, the values of the given metadata fields of the players of
+// the game that are not encrypted yet. Metadata updated since it was read is skipped, since its new values
+// are encrypted when they are written
+func (w *PlayerBackfillWorker) EncryptPlayersMetadata(ctx context.Context, gameID string, fields []string) error {
+	logger := w.Logger.With(
+		zap.String("game", gameID),
+		zap.String("source", "EncryptPlayersMetadata"),
+	)
+	start := time.Now()
+	db := w.DB.WithContext(ctx).(egorp.Database)
+
+	var afterPlayerID int64
+	for {
+		var players []*EncryptedPlayerMetadata
+		_, err := db.Select(
+			&players,
+			"SELECT id, metadata FROM players WHERE game_id=$1 AND id > $2 ORDER BY id LIMIT $3",
+			gameID, afterPlayerID, w.BatchSize,
+		)
+		if err != nil {
+			logger.Error("Failed to get players to encrypt.", zap.Error(err))
+			return err
+		}
+		if len(players) == 0 {
+			break
+		}
+
+		err = w.encryptPlayersMetadata(db, players, fields)
+		if err != nil {
+			logger.Error("Failed to encrypt players metadata.", zap.Error(err))
+			return err
+		}
+		afterPlayerID = players[len(players)-1].PlayerID
+	}
+
+	logger.Info("Successfully encrypted players metadata.", zap.Duration("latency", time.Now().Sub(start)))
+	return nil
+}
+
+func (w *PlayerBackfillWorker) encryptPlayersMetadata(db egorp.Database, players []*EncryptedPlayerMetadata, fields []string) error {
+	trx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, player := range players {
+		metadata, encrypted, err := encryptStoredMetadata(player.Metadata, fields, w.EncryptionKey)
+		if err != nil {
+			trx.Rollback()
+			return err
+		}
+		if !encrypted {
+			continue
+		}
+
+		previousJSON, err := json.Marshal(player.Metadata)
+		if err != nil {
+			trx.Rollback()
+			return err
+		}
+		metadataJSON, err := json.Marshal(metadata)
+		if err != nil {
+			trx.Rollback()
+			return err
+		}
+
+		_, err = trx.Exec(
+			"UPDATE players SET metadata=$1::jsonb WHERE id=$2 AND metadata=$3::jsonb",
+			string(metadataJSON), player.PlayerID, string(previousJSON),
+		)
+		if err != nil {
+			trx.Rollback()
+			return err
+		}
+	}
+
+	return trx.Commit()
+}
+
+// HashPlayersNames stores, in batches, the name hash of the players of the game that do not have one, so
+// names stored before the game required unique names conflict with new ones. Players whose name is already
+// taken by another player of the game are skipped and keep allowing duplicates of their name
+func (w *PlayerBackfillWorker) HashPlayersNames(ctx context.Context, gameID string) error {
+	logger := w.Logger.With(
+		zap.String("game", gameID),
+		zap.String("source", "HashPlayersNames"),
+	)
+	start := time.Now()
+	db := w.DB.WithContext(ctx).(egorp.Database)
+
+	settings, err := getPlayerNameSettings(db, gameID)
+	if err != nil {
+		logger.Error("Failed to get player name settings.", zap.Error(err))
+		return err
+	}
+	if !settings.Unique {
+		logger.Info("Game does not require unique player names anymore, skipping.")
+		return nil
+	}
+
+	query := `SELECT p.id, p.public_id, p.name, ep.key_id
+	FROM players p
+		LEFT JOIN encrypted_players ep ON ep.player_id = p.id
+	WHERE p.game_id=$1 AND p.id > $2 AND p.name_hash IS NULL
+	ORDER BY p.id
+	LIMIT $3`
+
+	var afterPlayerID int64
+	var skipped []string
+	for {
+		var players []*storedPlayerName
+		_, err := db.Select(&players, query, gameID, afterPlayerID, w.BatchSize)
+		if err != nil {
+			logger.Error("Failed to get players to hash.", zap.Error(err))
+			return err
+		}
+		if len(players) == 0 {
+			break
+		}
+
+		batchSkipped, err := w.hashPlayersNames(db, gameID, settings, players)
+		if err != nil {
+			logger.Error("Failed to hash players names.", zap.Error(err))
+			return err
+		}
+		skipped = append(skipped, batchSkipped...)
+		afterPlayerID = players[len(players)-1].ID
+	}
+
+	if len(skipped) > 0 {
+		logger.Warn(
+			"Players with names taken by other players were not hashed.",
+			zap.Int("count", len(skipped)),
+			zap.String("players", strings.Join(skipped, ",")),
+		)
+	}
+	logger.Info("Successfully hashed players names.", zap.Duration("latency", time.Now().Sub(start)))
+	return nil
+}
+
+// hashPlayersNames stores the name hash of players in a transaction and returns the public ids of the ones
+// that were skipped, either because their name could not be read or because it is taken
+func (w *PlayerBackfillWorker) hashPlayersNames(
+	db egorp.Database, gameID string, settings *PlayerNameSettings, players []*storedPlayerName,
+) ([]string, error) {
+	trx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	var skipped []string
+	for _, player := range players {
+		name := player.Name
+		if player.KeyID.Valid {
+			name, err = decryptName(player.Name, w.EncryptionKey, player.KeyID.String)
+			if err != nil {
+				skipped = append(skipped, player.PublicID)
+				continue
+			}
+		}
+
+		// players renamed since they were read already have a name hash
+		res, err := trx.Exec(
+			`UPDATE players SET name_hash=$1
+			WHERE id=$2 AND name=$3 AND name_hash IS NULL
+				AND NOT EXISTS (SELECT 1 FROM players WHERE game_id=$4 AND name_hash=$1)`,
+			playerNameHash(settings, name), player.ID, player.Name, gameID,
+		)
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+		if rows == 0 {
+			skipped = append(skipped, player.PublicID)
+		}
+	}
+
+	err = trx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return skipped, nil
+}
@@ -0,0 +1,243 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"database/sql"
+	"fmt"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/khan/util"
+)
+
+// DeletedPlayerClan is a clan owned by a deleted player. NewOwner is nil if the clan was deleted
+type DeletedPlayerClan struct {
+	Clan     *Clan
+	NewOwner *Player
+}
+
+type playerMembershipDataDAO struct {
+	ClanPublicID      string         `db:"clan_public_id"`
+	ClanName          string         `db:"clan_name"`
+	Level             string         `db:"membership_level"`
+	Approved          bool           `db:"approved"`
+	Denied            bool           `db:"denied"`
+	Banned            bool           `db:"banned"`
+	Message           string         `db:"message"`
+	CreatedAt         int64          `db:"created_at"`
+	UpdatedAt         int64          `db:"updated_at"`
+	ApprovedAt        int64          `db:"approved_at"`
+	DeniedAt          int64          `db:"denied_at"`
+	DeletedAt         int64          `db:"deleted_at"`
+	PlayerPublicID    string         `db:"player_public_id"`
+	RequestorPublicID string         `db:"requestor_public_id"`
+	ApproverPublicID  sql.NullString `db:"approver_public_id"`
+	DenierPublicID    sql.NullString `db:"denier_public_id"`
+	DeletedByPublicID sql.NullString `db:"deleted_by_public_id"`
+}
+
+func (m *playerMembershipDataDAO) Serialize() map[string]interface{} {
+	return map[string]interface{}{
+		"clan": map[string]interface{}{
+			"publicID": m.ClanPublicID,
+			"name":     m.ClanName,
+		},
+		"level":      m.Level,
+		"approved":   m.Approved,
+		"denied":     m.Denied,
+		"banned":     m.Banned,
+		"message":    m.Message,
+		"createdAt":  m.CreatedAt,
+		"updatedAt":  m.UpdatedAt,
+		"approvedAt": m.ApprovedAt,
+		"deniedAt":   m.DeniedAt,
+		"deletedAt":  m.DeletedAt,
+		"player":     m.PlayerPublicID,
+		"requestor":  m.RequestorPublicID,
+		"approver":   nullOrString(m.ApproverPublicID),
+		"denier":     nullOrString(m.DenierPublicID),
+		"deletedBy":  nullOrString(m.DeletedByPublicID),
+	}
+}
+
+// getPlayerMembershipsData returns the memberships matching condition, including deleted ones
+func getPlayerMembershipsData(db DB, condition string, playerID int64) ([]map[string]interface{}, error) {
+	query := fmt.Sprintf(`
+	SELECT
+		c.public_id clan_public_id, c.name clan_name,
+		m.membership_level, m.approved, m.denied, m.banned, m.message,
+		m.created_at, m.updated_at, m.approved_at, m.denied_at, m.deleted_at,
+		p.public_id player_public_id, r.public_id requestor_public_id,
+		a.public_id approver_public_id, d.public_id denier_public_id, del.public_id deleted_by_public_id
+	FROM memberships m
+		INNER JOIN clans c ON c.id=m.clan_id
+		INNER JOIN players p ON p.id=m.player_id
+		INNER JOIN players r ON r.id=m.requestor_id
+		LEFT OUTER JOIN players a ON a.id=m.approver_id
+		LEFT OUTER JOIN players d ON d.id=m.denier_id
+		LEFT OUTER JOIN players del ON del.id=m.deleted_by
+	WHERE %s
+	ORDER BY m.created_at`, condition)
+
+	var memberships []*playerMembershipDataDAO
+	_, err := db.Select(&memberships, query, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(memberships))
+	for i, membership := range memberships {
+		result[i] = membership.Serialize()
+	}
+	return result, nil
+}
+
+// ExportPlayerData returns all the data tied to a player: the player, their previous names, all their
+// memberships, including deleted ones, the clans they own and the memberships of other players they
+// requested, approved, denied or deleted
+func ExportPlayerData(db DB, encryptionKey []byte, gameID, publicID string) (map[string]interface{}, error) {
+	player, err := GetPlayerByPublicID(db, encryptionKey, gameID, publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	playerJSON := player.Serialize(encryptionKey)
+	playerJSON["createdAt"] = player.CreatedAt
+	playerJSON["updatedAt"] = player.UpdatedAt
+
+	nameHistory, err := getPlayerNameHistory(db, encryptionKey, player.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := getPlayerMembershipsData(db, "m.player_id=$1", player.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := getPlayerMembershipsData(db, `m.player_id<>$1 AND (
+		m.requestor_id=$1 OR m.approver_id=$1 OR m.denier_id=$1 OR m.deleted_by=$1
+	)`, player.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var clans []Clan
+	_, err = db.Select(&clans, "SELECT * FROM clans WHERE owner_id=$1 ORDER BY created_at", player.ID)
+	if err != nil {
+		return nil, err
+	}
+	ownedClans := make([]map[string]interface{}, len(clans))
+	for i, clan := range clans {
+		ownedClans[i] = clan.Serialize()
+	}
+
+	return map[string]interface{}{
+		"player":             playerJSON,
+		"nameHistory":        nameHistory,
+		"memberships":        memberships,
+		"ownedClans":         ownedClans,
+		"membershipsActions": actions,
+	}, nil
+}
+
+// DeletePlayer erases the personal data of a player. Clans owned by the player are transferred to
+// their oldest member with the highest level, or deleted if they have no members. The memberships of
+// the player are deleted and the player is anonymized, since other players' memberships may still
+// reference them as requestor, approver, denier or deleter. It returns the player as it was before
+// the deletion and the clans they owned. Idempotency keys whose stored responses name the player are deleted
+// and the cached payloads showing the player are invalidated once the transaction of db commits
+func DeletePlayer(db DB, encryptionKey []byte, gameID, publicID string) (*Player, []*DeletedPlayerClan, error) {
+	player, err := GetPlayerByPublicID(db, encryptionKey, gameID, publicID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ownedClans []string
+	_, err = db.Select(&ownedClans, "SELECT public_id FROM clans WHERE owner_id=$1 ORDER BY id", player.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deletedClans := make([]*DeletedPlayerClan, 0, len(ownedClans))
+	for _, clanPublicID := range ownedClans {
+		clan, _, newOwner, err := LeaveClan(db, encryptionKey, gameID, clanPublicID)
+		if err != nil {
+			return nil, nil, err
+		}
+		deletedClans = append(deletedClans, &DeletedPlayerClan{Clan: clan, NewOwner: newOwner})
+	}
+
+	var memberClanIDs []int64
+	_, err = db.Select(&memberClanIDs, `
+	SELECT clan_id FROM memberships
+	WHERE player_id=$1 AND deleted_at=0 AND approved=true AND denied=false AND banned=false
+	`, player.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = db.Exec("DELETE FROM memberships WHERE player_id=$1", player.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, clanID := range memberClanIDs {
+		err = UpdateClanMembershipCount(db, clanID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	_, err = db.Exec("DELETE FROM encrypted_players WHERE player_id=$1", player.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = db.Exec("DELETE FROM player_name_history WHERE player_id=$1", player.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// clans with memberships the player acted on show the player in their details
+	var actedClanPublicIDs []string
+	_, err = db.Select(&actedClanPublicIDs, `
+	SELECT c.public_id FROM clans c
+	WHERE c.id IN (
+		SELECT m.clan_id FROM memberships m
+		WHERE m.requestor_id=$1 OR m.approver_id=$1 OR m.denier_id=$1 OR m.deleted_by=$1
+	)
+	`, player.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cacheInvalidator != nil {
+		afterCommit(db, func() {
+			cacheInvalidator.InvalidatePlayers(gameID, publicID)
+			if len(actedClanPublicIDs) > 0 {
+				cacheInvalidator.InvalidateClans(gameID, actedClanPublicIDs...)
+			}
+		})
+	}
+
+	err = DeletePlayerIdempotencyKeys(db, gameID, publicID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = db.Exec(`
+	UPDATE players SET
+		public_id=$2, name='', name_hash=NULL, metadata='{}', membership_count=0, ownership_count=0,
+		updated_at=$3, version=version+1
+	WHERE id=$1
+	`, player.ID, fmt.Sprintf("deleted-%s", uuid.NewV4().String()), util.NowMilli())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return player, deletedClans, nil
+}
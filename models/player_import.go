@@ -0,0 +1,291 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	egorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
+	"github.com/topfreegames/khan/util"
+	"github.com/uber-go/zap"
+)
+
+// PlayerImport is a player created or updated by UpsertPlayers
+type PlayerImport struct {
+	PublicID string
+	Name     string
+	Metadata map[string]interface{}
+}
+
+// PlayerImportError is the reason a player passed to UpsertPlayers was not imported
+type PlayerImportError struct {
+	Index    int
+	PublicID string
+	Reason   string
+}
+
+// ImportedPlayer is a player created or updated by UpsertPlayers, as stored in the database
+type ImportedPlayer struct {
+	Player  *Player
+	Created bool
+}
+
+// PlayersImportResult counts the players created and updated by UpsertPlayers and
+// holds the errors of the ones that were not imported
+type PlayersImportResult struct {
+	Created int
+	Updated int
+	Players []*ImportedPlayer
+	Errors  []*PlayerImportError
+}
+
+type upsertedPlayer struct {
+	ID              int64  `db:"id"`
+	PublicID        string `db:"public_id"`
+	MembershipCount int    `db:"membership_count"`
+	OwnershipCount  int    `db:"ownership_count"`
+	Version         int64  `db:"version"`
+	Inserted        bool   `db:"inserted"`
+}
+
+type playerImportRow struct {
+	index         int
+	publicID      string
+	name          string
+	metadata      map[string]interface{}
+	metadataJSON  []byte
+	encryptedName bool
+	plainName     string
+	nameHash      sql.NullString
+}
+
+// UpsertPlayers creates or updates players of a game in batches of batchSize players, each written
+// with a single multi-row insert in its own transaction. Names and the metadata fields the game encrypts
+// are encrypted the way CreatePlayer does. A failed batch is rolled back without stopping the import: its
+// players are reported in the result errors, along with players repeated in the import. In games with
+// unique player names, players named like a player before them in the import are reported as well
+func UpsertPlayers(db egorp.Database, logger zap.Logger, encryptionKey []byte, gameID string, players []*PlayerImport, batchSize int) (*PlayersImportResult, error) {
+	if _, err := GetGameByPublicID(db, gameID); err != nil {
+		return nil, err
+	}
+	metadataFields, err := getPlayerEncryptedMetadataFields(db, gameID)
+	if err != nil {
+		return nil, err
+	}
+	nameSettings, err := getPlayerNameSettings(db, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if batchSize <= 0 {
+		batchSize = len(players)
+	}
+
+	result := &PlayersImportResult{Players: []*ImportedPlayer{}, Errors: []*PlayerImportError{}}
+	fail := func(index int, publicID string, err error) {
+		result.Errors = append(result.Errors, &PlayerImportError{Index: index, PublicID: publicID, Reason: err.Error()})
+	}
+
+	seen := make(map[string]bool, len(players))
+	seenNames := make(map[string]bool)
+	rows := make([]*playerImportRow, 0, batchSize)
+	for index, player := range players {
+		if seen[player.PublicID] {
+			fail(index, player.PublicID, fmt.Errorf("Player %s is repeated in the import", player.PublicID))
+			continue
+		}
+		seen[player.PublicID] = true
+
+		row, err := newPlayerImportRow(index, player, metadataFields, encryptionKey)
+		if err != nil {
+			fail(index, player.PublicID, err)
+			continue
+		}
+		row.nameHash = playerNameHash(nameSettings, player.Name)
+		if row.nameHash.Valid {
+			if seenNames[row.nameHash.String] {
+				fail(index, player.PublicID, fmt.Errorf("Player name %s is repeated in the import", player.Name))
+				continue
+			}
+			seenNames[row.nameHash.String] = true
+		}
+		rows = append(rows, row)
+
+		if len(rows) == batchSize {
+			upsertPlayersBatch(db, logger, encryptionKey, gameID, nameSettings, rows, result)
+			rows = rows[:0]
+		}
+	}
+	if len(rows) > 0 {
+		upsertPlayersBatch(db, logger, encryptionKey, gameID, nameSettings, rows, result)
+	}
+
+	return result, nil
+}
+
+func newPlayerImportRow(index int, player *PlayerImport, metadataFields []string, encryptionKey []byte) (*playerImportRow, error) {
+	metadata := player.Metadata
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	metadata, err := encryptMetadata(metadata, metadataFields, encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	row := &playerImportRow{
+		index:         index,
+		publicID:      player.PublicID,
+		name:          player.Name,
+		metadata:      metadata,
+		metadataJSON:  metadataJSON,
+		encryptedName: true,
+		plainName:     player.Name,
+	}
+	encryptedName, err := util.EncryptData(player.Name, encryptionKey)
+	if err != nil {
+		row.encryptedName = false
+	} else {
+		row.name = encryptedName
+	}
+	return row, nil
+}
+
+// upsertPlayersBatch writes rows in a transaction and records them in result. A batch that fails is rolled
+// back and its players are reported in the result errors
+func upsertPlayersBatch(
+	db egorp.Database, logger zap.Logger, encryptionKey []byte, gameID string, nameSettings *PlayerNameSettings,
+	rows []*playerImportRow, result *PlayersImportResult,
+) {
+	failBatch := func(err error) {
+		logger.Error("Error on upserting players batch", zap.Error(err))
+		for _, row := range rows {
+			result.Errors = append(result.Errors, &PlayerImportError{Index: row.index, PublicID: row.publicID, Reason: err.Error()})
+		}
+	}
+
+	trx, err := db.Begin()
+	if err != nil {
+		failBatch(err)
+		return
+	}
+	upserted, err := writePlayersBatch(trx, encryptionKey, gameID, nameSettings, rows)
+	if err != nil {
+		Rollback(trx)
+		failBatch(err)
+		return
+	}
+	err = Commit(trx)
+	if err != nil {
+		failBatch(err)
+		return
+	}
+
+	upsertedByPublicID := make(map[string]*upsertedPlayer, len(upserted))
+	for _, player := range upserted {
+		upsertedByPublicID[player.PublicID] = player
+	}
+	for _, row := range rows {
+		player := upsertedByPublicID[row.publicID]
+		if player == nil {
+			continue
+		}
+		if player.Inserted {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+		result.Players = append(result.Players, &ImportedPlayer{
+			Player: &Player{
+				ID:              player.ID,
+				GameID:          gameID,
+				PublicID:        row.publicID,
+				Name:            row.name,
+				Metadata:        row.metadata,
+				MembershipCount: player.MembershipCount,
+				OwnershipCount:  player.OwnershipCount,
+				Version:         player.Version,
+			},
+			Created: player.Inserted,
+		})
+	}
+}
+
+// writePlayersBatch upserts rows, records the ID of the key their names were encrypted with and the
+// previous names of the renamed players
+func writePlayersBatch(
+	db DB, encryptionKey []byte, gameID string, nameSettings *PlayerNameSettings, rows []*playerImportRow,
+) ([]*upsertedPlayer, error) {
+	values := make([]string, len(rows))
+	publicIDs := make([]string, len(rows))
+	args := []interface{}{gameID, util.NowMilli()}
+	for i, row := range rows {
+		values[i] = fmt.Sprintf("($1, $%d, $%d, $%d, $%d, $2, $2)", len(args)+1, len(args)+2, len(args)+3, len(args)+4)
+		args = append(args, row.publicID, row.name, row.metadataJSON, row.nameHash)
+		publicIDs[i] = row.publicID
+	}
+
+	previousNames, err := getStoredPlayerNames(db, nameSettings, gameID, publicIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`INSERT INTO players(game_id, public_id, name, metadata, name_hash, created_at, updated_at)
+	VALUES %s ON CONFLICT (game_id, public_id)
+	DO UPDATE set name=EXCLUDED.name, metadata=EXCLUDED.metadata, name_hash=EXCLUDED.name_hash,
+		updated_at=EXCLUDED.updated_at, version=players.version+1
+	RETURNING id, public_id, membership_count, ownership_count, version, (xmax = 0) inserted`, strings.Join(values, ", "))
+
+	var upserted []*upsertedPlayer
+	_, err = db.Select(&upserted, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		err = recordPlayerNameChange(db, encryptionKey, previousNames[row.publicID], row.plainName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	playerIDs := make(map[string]int64, len(upserted))
+	for _, player := range upserted {
+		playerIDs[player.PublicID] = player.ID
+	}
+
+	keyID := encryptionKeyID(encryptionKey)
+	encryptedValues := []string{}
+	encryptedArgs := []interface{}{keyID}
+	for _, row := range rows {
+		if !row.encryptedName {
+			continue
+		}
+		encryptedValues = append(encryptedValues, fmt.Sprintf("($%d, $1)", len(encryptedArgs)+1))
+		encryptedArgs = append(encryptedArgs, playerIDs[row.publicID])
+	}
+	if len(encryptedValues) > 0 {
+		_, err = db.Exec(fmt.Sprintf(`INSERT INTO encrypted_players (player_id, key_id) VALUES %s
+		ON CONFLICT (player_id) DO UPDATE set key_id=EXCLUDED.key_id`, strings.Join(encryptedValues, ", ")), encryptedArgs...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = invalidatePlayersCache(db, gameID, publicIDs)
+	if err != nil {
+		return nil, err
+	}
+	return upserted, nil
+}
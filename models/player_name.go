@@ -0,0 +1,232 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/lib/pq"
+	egorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
+	"github.com/topfreegames/khan/util"
+)
+
+// playerNameHashIndex is the unique index that enforces unique player names
+const playerNameHashIndex = "players_game_id_name_hash"
+
+// PlayerNameSettings configures how a game handles the names of its players
+type PlayerNameSettings struct {
+	// KeepHistory records the previous name of a player every time the player is renamed
+	KeepHistory bool `db:"player_name_history" json:"keepHistory" doc:"Whether the previous names of the players are recorded"`
+	// Unique forbids two players of the game from having the same name, ignoring case
+	Unique bool `db:"unique_player_names" json:"unique" doc:"Whether the names of the players must be unique, ignoring case"`
+}
+
+// PlayerNameChange is a previous name of a player, encrypted like the name of the player was
+type PlayerNameChange struct {
+	ID        int64          `db:"id"`
+	PlayerID  int64          `db:"player_id"`
+	Name      string         `db:"name"`
+	KeyID     sql.NullString `db:"key_id"`
+	CreatedAt int64          `db:"created_at"`
+}
+
+// storedPlayerName is the name of a player as stored in the database
+type storedPlayerName struct {
+	ID       int64          `db:"id"`
+	PublicID string         `db:"public_id"`
+	Name     string         `db:"name"`
+	KeyID    sql.NullString `db:"key_id"`
+}
+
+var playerNameHashKey []byte
+
+// SetPlayerNameHashKey configures the key player names are hashed with to enforce unique names.
+// Changing the key makes names hashed with the previous key no longer conflict with new ones
+func SetPlayerNameHashKey(key []byte) {
+	playerNameHashKey = key
+}
+
+// getPlayerNameSettings returns the player name settings of the game
+func getPlayerNameSettings(db DB, gameID string) (*PlayerNameSettings, error) {
+	var settings []*PlayerNameSettings
+	_, err := db.Select(&settings, "SELECT player_name_history, unique_player_names FROM games WHERE public_id=$1", gameID)
+	if err != nil {
+		return nil, err
+	}
+	if len(settings) == 0 {
+		return nil, &ModelNotFoundError{"Game", gameID}
+	}
+	return settings[0], nil
+}
+
+// playerNameHash returns the name hash stored with a player named name, which is null unless the
+// game requires unique names
+func playerNameHash(settings *PlayerNameSettings, name string) sql.NullString {
+	if !settings.Unique {
+		return sql.NullString{}
+	}
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	return sql.NullString{String: util.HashData(normalized, playerNameHashKey), Valid: true}
+}
+
+// playerNameError translates violations of the unique player names index into PlayerNameTakenError
+func playerNameError(err error, gameID, name string) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == playerNameHashIndex {
+		return &PlayerNameTakenError{GameID: gameID, Name: name}
+	}
+	return err
+}
+
+// getStoredPlayerNames returns the names of the players of the game with the given public ids,
+// indexed by public id, if the game keeps the name history of its players. The players are locked
+// until the transaction of db ends, so concurrent renames do not record the same previous name
+func getStoredPlayerNames(db DB, settings *PlayerNameSettings, gameID string, publicIDs ...string) (map[string]*storedPlayerName, error) {
+	if !settings.KeepHistory {
+		return nil, nil
+	}
+
+	query := `SELECT p.id, p.public_id, p.name, ep.key_id
+	FROM players p
+		LEFT JOIN encrypted_players ep ON ep.player_id = p.id
+	WHERE p.game_id=$1 AND p.public_id=ANY($2)
+	ORDER BY p.id
+	FOR UPDATE OF p`
+
+	var names []*storedPlayerName
+	_, err := db.Select(&names, query, gameID, pq.StringArray(publicIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*storedPlayerName, len(names))
+	for _, name := range names {
+		result[name.PublicID] = name
+	}
+	return result, nil
+}
+
+// recordPlayerNameChange adds the previous name of a player to its name history if the player was renamed
+func recordPlayerNameChange(db DB, encryptionKey []byte, previous *storedPlayerName, name string) error {
+	if previous == nil {
+		return nil
+	}
+
+	previousName := previous.Name
+	if previous.KeyID.Valid {
+		decrypted, err := decryptName(previous.Name, encryptionKey, previous.KeyID.String)
+		if err == nil {
+			previousName = decrypted
+		}
+	}
+	if previousName == name {
+		return nil
+	}
+
+	return db.Insert(&PlayerNameChange{
+		PlayerID:  previous.ID,
+		Name:      previous.Name,
+		KeyID:     previous.KeyID,
+		CreatedAt: util.NowMilli(),
+	})
+}
+
+// GetPlayerNameHistory returns the previous names of a player, newest first
+func GetPlayerNameHistory(db DB, encryptionKey []byte, gameID, publicID string) ([]map[string]interface{}, error) {
+	player, err := GetPlayerByPublicID(db, encryptionKey, gameID, publicID)
+	if err != nil {
+		return nil, err
+	}
+	return getPlayerNameHistory(db, encryptionKey, player.ID)
+}
+
+func getPlayerNameHistory(db DB, encryptionKey []byte, playerID int64) ([]map[string]interface{}, error) {
+	var changes []*PlayerNameChange
+	_, err := db.Select(
+		&changes,
+		"SELECT * FROM player_name_history WHERE player_id=$1 ORDER BY created_at DESC, id DESC",
+		playerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]map[string]interface{}, len(changes))
+	for i, change := range changes {
+		name := change.Name
+		if change.KeyID.Valid {
+			decrypted, err := decryptName(change.Name, encryptionKey, change.KeyID.String)
+			if err == nil {
+				name = decrypted
+			}
+		}
+		history[i] = map[string]interface{}{
+			"name":      name,
+			"changedAt": change.CreatedAt,
+		}
+	}
+	return history, nil
+}
+
+// GetPlayerNameChangesToRotate returns up to amount previous player names with ID greater than afterID
+// that were encrypted with a key other than currentKeyID, or directly with the key while a key provider
+// is set, ordered by ID
+func GetPlayerNameChangesToRotate(db DB, currentKeyID string, afterID int64, amount int) ([]*PlayerNameChange, error) {
+	query := `SELECT * FROM player_name_history
+	WHERE key_id IS NOT NULL AND (key_id <> $1 OR ($4 AND name NOT LIKE $5)) AND id > $2
+	ORDER BY id
+	LIMIT $3`
+
+	var changes []*PlayerNameChange
+	_, err := db.Select(
+		&changes, query, currentKeyID, afterID, amount, util.UsesEnvelopes(), util.EnvelopePrefix+"%",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// RotatePlayerNameHistoryEncryptionKey re-encrypts previous player names with the current key of keyring,
+// wrapped by the key provider if one is set. Names that cannot be decrypted with any key of the keyring are
+// left untouched and their IDs are returned
+func RotatePlayerNameHistoryEncryptionKey(db egorp.Database, keyring *util.Keyring, changes []*PlayerNameChange) ([]int64, error) {
+	var failedIDs []int64
+
+	trx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range changes {
+		name, err := keyring.Decrypt(change.Name, change.KeyID.String)
+		if err != nil {
+			failedIDs = append(failedIDs, change.ID)
+			continue
+		}
+
+		encryptedName, keyID, err := keyring.Encrypt(name)
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+
+		_, err = trx.Exec("UPDATE player_name_history SET name=$1, key_id=$2 WHERE id=$3", encryptedName, keyID, change.ID)
+		if err != nil {
+			trx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = trx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return failedIDs, nil
+}
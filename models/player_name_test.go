@@ -0,0 +1,195 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	egorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
+	. "github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+	"github.com/topfreegames/khan/testing"
+	"github.com/uber-go/zap"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+var _ = Describe("Player Name Model", func() {
+	var testDb egorp.Database
+	var logger zap.Logger
+
+	BeforeEach(func() {
+		var err error
+		testDb, err = GetTestDB()
+		logger = testing.NewMockLogger()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	setPlayerNameSettings := func(gameID string, settings PlayerNameSettings) {
+		_, err := testDb.Exec(
+			"UPDATE games SET player_name_history=$2, unique_player_names=$3 WHERE public_id=$1",
+			gameID, settings.KeepHistory, settings.Unique,
+		)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Describe("Unique Player Names", func() {
+		It("Should not create a player named like another player of the game", func() {
+			game, _, err := fixtures.CreatePlayerFactory(testDb, "")
+			Expect(err).NotTo(HaveOccurred())
+			setPlayerNameSettings(game.PublicID, PlayerNameSettings{Unique: true})
+
+			_, err = CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "Taken Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), " taken name", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(BeAssignableToTypeOf(&PlayerNameTakenError{}))
+		})
+
+		It("Should not rename a player to the name of another player of the game", func() {
+			game, _, err := fixtures.CreatePlayerFactory(testDb, "")
+			Expect(err).NotTo(HaveOccurred())
+			setPlayerNameSettings(game.PublicID, PlayerNameSettings{Unique: true})
+
+			_, err = CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "Taken Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+			player, err := CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "Other Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = UpdatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID, "Taken Name", nil, 0)
+			Expect(err).To(BeAssignableToTypeOf(&PlayerNameTakenError{}))
+
+			_, err = PatchPlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID, "Taken Name", nil, 0)
+			Expect(err).To(BeAssignableToTypeOf(&PlayerNameTakenError{}))
+		})
+
+		It("Should allow players of other games to have the same name", func() {
+			game, _, err := fixtures.CreatePlayerFactory(testDb, "")
+			Expect(err).NotTo(HaveOccurred())
+			setPlayerNameSettings(game.PublicID, PlayerNameSettings{Unique: true})
+			otherGame, _, err := fixtures.CreatePlayerFactory(testDb, "")
+			Expect(err).NotTo(HaveOccurred())
+			setPlayerNameSettings(otherGame.PublicID, PlayerNameSettings{Unique: true})
+
+			_, err = CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "Same Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), otherGame.PublicID, uuid.NewV4().String(), "Same Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should allow players to have the same name if names are not unique", func() {
+			game, _, err := fixtures.CreatePlayerFactory(testDb, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "Same Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "Same Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should hash the names stored before names were unique", func() {
+			game, _, err := fixtures.CreatePlayerFactory(testDb, "")
+			Expect(err).NotTo(HaveOccurred())
+			first, err := CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "Old Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+			second, err := CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "old name", nil)
+			Expect(err).NotTo(HaveOccurred())
+			setPlayerNameSettings(game.PublicID, PlayerNameSettings{Unique: true})
+
+			worker := NewPlayerBackfillWorker(logger, testDb, fixtures.GetEncryptionKey())
+			worker.BatchSize = 1
+			err = worker.HashPlayersNames(context.Background(), game.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+
+			dbFirst, err := GetPlayerByID(testDb, fixtures.GetEncryptionKey(), first.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbFirst.NameHash.Valid).To(BeTrue())
+			dbSecond, err := GetPlayerByID(testDb, fixtures.GetEncryptionKey(), second.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dbSecond.NameHash.Valid).To(BeFalse())
+
+			_, err = CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "OLD NAME", nil)
+			Expect(err).To(BeAssignableToTypeOf(&PlayerNameTakenError{}))
+		})
+	})
+
+	Describe("Player Name History", func() {
+		It("Should record the previous names of a renamed player", func() {
+			game, _, err := fixtures.CreatePlayerFactory(testDb, "")
+			Expect(err).NotTo(HaveOccurred())
+			setPlayerNameSettings(game.PublicID, PlayerNameSettings{KeepHistory: true})
+
+			player, err := CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "First Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = UpdatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID, "Second Name", nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = UpdatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID, "Second Name", nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = PatchPlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID, "Third Name", nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			history, err := GetPlayerNameHistory(testDb, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(2))
+			Expect(history[0]["name"]).To(Equal("Second Name"))
+			Expect(history[1]["name"]).To(Equal("First Name"))
+		})
+
+		It("Should keep previous names encrypted", func() {
+			game, _, err := fixtures.CreatePlayerFactory(testDb, "")
+			Expect(err).NotTo(HaveOccurred())
+			setPlayerNameSettings(game.PublicID, PlayerNameSettings{KeepHistory: true})
+
+			player, err := CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "First Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = UpdatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID, "Second Name", nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			_, err = testDb.Select(&names, "SELECT name FROM player_name_history WHERE player_id=$1", player.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(HaveLen(1))
+			Expect(names[0]).NotTo(Equal("First Name"))
+		})
+
+		It("Should not record previous names if the game does not keep them", func() {
+			game, _, err := fixtures.CreatePlayerFactory(testDb, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			player, err := CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "First Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = UpdatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID, "Second Name", nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			history, err := GetPlayerNameHistory(testDb, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(BeEmpty())
+		})
+
+		It("Should delete the previous names of a deleted player", func() {
+			game, _, err := fixtures.CreatePlayerFactory(testDb, "")
+			Expect(err).NotTo(HaveOccurred())
+			setPlayerNameSettings(game.PublicID, PlayerNameSettings{KeepHistory: true})
+
+			player, err := CreatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, uuid.NewV4().String(), "First Name", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = UpdatePlayer(testDb, logger, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID, "Second Name", nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = DeletePlayer(testDb, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID)
+			Expect(err).NotTo(HaveOccurred())
+
+			count, err := testDb.SelectInt("SELECT COUNT(*) FROM player_name_history WHERE player_id=$1", player.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeEquivalentTo(0))
+		})
+	})
+})
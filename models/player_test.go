@@ -8,6 +8,9 @@
 package models_test
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -70,6 +73,132 @@ var _ = Describe("Player Model", func() {
 			})
 		})
 
+		Describe("Export Player Data", func() {
+			It("Should export the player, their memberships and owned clans", func() {
+				_, clan, owner, players, _, err := fixtures.GetClanWithMemberships(testDb, 1, 0, 0, 0, "", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				data, err := ExportPlayerData(testDb, fixtures.GetEncryptionKey(), clan.GameID, owner.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+
+				playerJSON := data["player"].(map[string]interface{})
+				Expect(playerJSON["publicID"]).To(Equal(owner.PublicID))
+				Expect(playerJSON["createdAt"]).To(Equal(owner.CreatedAt))
+
+				ownedClans := data["ownedClans"].([]map[string]interface{})
+				Expect(ownedClans).To(HaveLen(1))
+				Expect(ownedClans[0]["publicID"]).To(Equal(clan.PublicID))
+
+				actions := data["membershipsActions"].([]map[string]interface{})
+				Expect(actions).To(HaveLen(1))
+				Expect(actions[0]["player"]).To(Equal(players[0].PublicID))
+
+				data, err = ExportPlayerData(testDb, fixtures.GetEncryptionKey(), clan.GameID, players[0].PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				memberships := data["memberships"].([]map[string]interface{})
+				Expect(memberships).To(HaveLen(1))
+				Expect(memberships[0]["clan"].(map[string]interface{})["publicID"]).To(Equal(clan.PublicID))
+				Expect(memberships[0]["approved"]).To(BeTrue())
+			})
+
+			It("Should fail if player does not exist", func() {
+				_, err := ExportPlayerData(testDb, fixtures.GetEncryptionKey(), "invalid-game", "invalid-player")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Player was not found with id: invalid-player"))
+			})
+		})
+
+		Describe("Delete Player", func() {
+			It("Should anonymize the player and delete their memberships", func() {
+				_, clan, _, players, _, err := fixtures.GetClanWithMemberships(testDb, 2, 0, 0, 0, "", "")
+				Expect(err).NotTo(HaveOccurred())
+				player := players[0]
+
+				_, clans, err := DeletePlayer(testDb, fixtures.GetEncryptionKey(), clan.GameID, player.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clans).To(BeEmpty())
+
+				_, err = GetPlayerByPublicID(testDb, fixtures.GetEncryptionKey(), clan.GameID, player.PublicID)
+				Expect(err).To(HaveOccurred())
+
+				var dbPlayer Player
+				err = testDb.SelectOne(&dbPlayer, "SELECT * FROM players WHERE id=$1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.PublicID).To(HavePrefix("deleted-"))
+				Expect(dbPlayer.Name).To(BeEmpty())
+				Expect(dbPlayer.Metadata).To(BeEmpty())
+				Expect(dbPlayer.MembershipCount).To(Equal(0))
+
+				count, err := testDb.SelectInt("SELECT COUNT(*) FROM memberships WHERE player_id=$1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(int64(0)))
+
+				dbClan, err := GetClanByID(testDb, clan.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbClan.MembershipCount).To(Equal(clan.MembershipCount - 1))
+			})
+
+			It("Should delete the idempotency keys naming the player", func() {
+				_, player, err := fixtures.CreatePlayerFactory(testDb, "")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = ReserveIdempotencyKey(
+					testDb, player.GameID, IdempotencyCaller(player.PublicID, "", ""), "key", "POST",
+					"/games/game/clans", "hash", time.Hour, time.Hour,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = ReserveIdempotencyKey(
+					testDb, player.GameID, "key:api-key", "key", "POST", "/games/game/players", "hash", time.Hour, time.Hour,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				err = CompleteIdempotencyKey(
+					testDb, fixtures.GetEncryptionKey(), player.GameID, "key:api-key", "key", 200,
+					fmt.Sprintf(`{"success":true,"publicID":"%s"}`, player.PublicID), nil,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, err = DeletePlayer(testDb, fixtures.GetEncryptionKey(), player.GameID, player.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+
+				count, err := testDb.SelectInt("SELECT COUNT(*) FROM idempotency_keys WHERE game_id=$1", player.GameID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(BeEquivalentTo(0))
+			})
+
+			It("Should transfer the clans owned by the player", func() {
+				_, clan, owner, players, _, err := fixtures.GetClanWithMemberships(testDb, 1, 0, 0, 0, "", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, clans, err := DeletePlayer(testDb, fixtures.GetEncryptionKey(), clan.GameID, owner.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clans).To(HaveLen(1))
+				Expect(clans[0].Clan.PublicID).To(Equal(clan.PublicID))
+				Expect(clans[0].NewOwner.PublicID).To(Equal(players[0].PublicID))
+
+				dbClan, err := GetClanByID(testDb, clan.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbClan.OwnerID).To(Equal(players[0].ID))
+			})
+
+			It("Should delete the clans owned by the player without members", func() {
+				_, clan, owner, _, _, err := fixtures.GetClanWithMemberships(testDb, 0, 0, 0, 0, "", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, clans, err := DeletePlayer(testDb, fixtures.GetEncryptionKey(), clan.GameID, owner.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clans).To(HaveLen(1))
+				Expect(clans[0].NewOwner).To(BeNil())
+
+				_, err = GetClanByID(testDb, clan.ID)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("Should fail if player does not exist", func() {
+				_, _, err := DeletePlayer(testDb, fixtures.GetEncryptionKey(), "invalid-game", "invalid-player")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Player was not found with id: invalid-player"))
+			})
+		})
+
 		Describe("Get Player By ID", func() {
 			It("Should get existing Player", func() {
 				_, player, err := fixtures.CreatePlayerFactory(testDb, "")
@@ -250,6 +379,225 @@ var _ = Describe("Player Model", func() {
 				Expect(encryptedPlayer).To(BeNil())
 
 			})
+
+			It("Should encrypt the metadata fields the game encrypts at rest", func() {
+				game := fixtures.GameFactory.MustCreateWithOption(map[string]interface{}{
+					"PlayerEncryptedMetadataFields": "email,device",
+				}).(*Game)
+				err := testDb.Insert(game)
+				Expect(err).NotTo(HaveOccurred())
+
+				metadata := map[string]interface{}{
+					"email":  "player@example.com",
+					"device": map[string]interface{}{"id": "device-id"},
+					"level":  10.0,
+				}
+				player, err := CreatePlayer(
+					testDb,
+					logger,
+					fixtures.GetEncryptionKey(),
+					game.PublicID,
+					uuid.NewV4().String(),
+					"player-name",
+					metadata,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(player.Metadata).To(Equal(metadata))
+				Expect(player.Serialize(fixtures.GetEncryptionKey())["metadata"]).To(Equal(metadata))
+
+				var dbPlayer *Player
+				err = testDb.SelectOne(&dbPlayer, "select * from players where id = $1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Metadata["email"]).To(HavePrefix(EncryptedMetadataPrefix))
+				Expect(dbPlayer.Metadata["device"]).To(HavePrefix(EncryptedMetadataPrefix))
+				Expect(dbPlayer.Metadata["level"]).To(Equal(10.0))
+				Expect(dbPlayer.Serialize(fixtures.GetEncryptionKey())["metadata"]).To(Equal(metadata))
+
+				details, err := GetPlayerDetails(testDb, fixtures.GetEncryptionKey(), game.PublicID, player.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(details["metadata"]).To(Equal(metadata))
+			})
+
+			It("Should encrypt client metadata values that already look encrypted", func() {
+				game := fixtures.GameFactory.MustCreateWithOption(map[string]interface{}{
+					"PlayerEncryptedMetadataFields": "email",
+				}).(*Game)
+				err := testDb.Insert(game)
+				Expect(err).NotTo(HaveOccurred())
+
+				metadata := map[string]interface{}{"email": EncryptedMetadataPrefix + "not-encrypted"}
+				player, err := CreatePlayer(
+					testDb,
+					logger,
+					fixtures.GetEncryptionKey(),
+					game.PublicID,
+					uuid.NewV4().String(),
+					"player-name",
+					metadata,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				var dbPlayer *Player
+				err = testDb.SelectOne(&dbPlayer, "select * from players where id = $1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Metadata["email"]).NotTo(Equal(metadata["email"]))
+				Expect(dbPlayer.Serialize(fixtures.GetEncryptionKey())["metadata"]).To(Equal(metadata))
+			})
+
+			It("Should encrypt the metadata stored before the game encrypted its fields", func() {
+				game := fixtures.GameFactory.MustCreate().(*Game)
+				err := testDb.Insert(game)
+				Expect(err).NotTo(HaveOccurred())
+
+				metadata := map[string]interface{}{"email": "player@example.com", "level": 10.0}
+				player, err := CreatePlayer(
+					testDb,
+					logger,
+					fixtures.GetEncryptionKey(),
+					game.PublicID,
+					uuid.NewV4().String(),
+					"player-name",
+					metadata,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				worker := NewPlayerBackfillWorker(logger, testDb, fixtures.GetEncryptionKey())
+				worker.BatchSize = 1
+				err = worker.EncryptPlayersMetadata(context.Background(), game.PublicID, []string{"email"})
+				Expect(err).NotTo(HaveOccurred())
+
+				var dbPlayer *Player
+				err = testDb.SelectOne(&dbPlayer, "select * from players where id = $1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Metadata["email"]).To(HavePrefix(EncryptedMetadataPrefix))
+				Expect(dbPlayer.Metadata["level"]).To(Equal(10.0))
+				Expect(dbPlayer.Serialize(fixtures.GetEncryptionKey())["metadata"]).To(Equal(metadata))
+
+				encryptedEmail := dbPlayer.Metadata["email"]
+				err = worker.EncryptPlayersMetadata(context.Background(), game.PublicID, []string{"email"})
+				Expect(err).NotTo(HaveOccurred())
+				err = testDb.SelectOne(&dbPlayer, "select * from players where id = $1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Metadata["email"]).To(Equal(encryptedEmail))
+			})
+		})
+
+		Describe("Upsert Players", func() {
+			It("Should create and update players in batches", func() {
+				_, player, err := fixtures.CreatePlayerFactory(testDb, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				players := []*PlayerImport{
+					{PublicID: player.PublicID, Name: "updated-name", Metadata: map[string]interface{}{"x": "b"}},
+					{PublicID: "player-1", Name: "name-1"},
+					{PublicID: "player-1", Name: "repeated-name"},
+					{PublicID: "player-2", Name: "name-2", Metadata: map[string]interface{}{"x": "a"}},
+				}
+				result, err := UpsertPlayers(testDb, logger, fixtures.GetEncryptionKey(), player.GameID, players, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Created).To(Equal(2))
+				Expect(result.Updated).To(Equal(1))
+				Expect(result.Errors).To(HaveLen(1))
+				Expect(result.Errors[0].Index).To(Equal(2))
+				Expect(result.Players).To(HaveLen(3))
+				Expect(result.Players[0].Created).To(BeFalse())
+				Expect(result.Players[0].Player.Serialize(fixtures.GetEncryptionKey())["name"]).To(Equal("updated-name"))
+				Expect(result.Players[1].Created).To(BeTrue())
+				Expect(result.Players[1].Player.PublicID).To(Equal("player-1"))
+
+				dbPlayer, err := GetPlayerByPublicID(testDb, fixtures.GetEncryptionKey(), player.GameID, player.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Name).To(Equal("updated-name"))
+				Expect(dbPlayer.Metadata["x"]).To(Equal("b"))
+
+				dbPlayer, err = GetPlayerByPublicID(testDb, fixtures.GetEncryptionKey(), player.GameID, "player-2")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Name).To(Equal("name-2"))
+
+				var encryptedPlayer EncryptedPlayer
+				err = testDb.SelectOne(&encryptedPlayer, "select * from encrypted_players where player_id = $1", dbPlayer.ID)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("Should fail if game does not exist", func() {
+				players := []*PlayerImport{{PublicID: "player", Name: "name"}}
+				_, err := UpsertPlayers(testDb, logger, fixtures.GetEncryptionKey(), "invalid-game", players, 10)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Game was not found with id: invalid-game"))
+			})
+		})
+
+		Describe("Patch Player", func() {
+			It("Should merge the patch into the player metadata", func() {
+				_, player, err := fixtures.CreatePlayerFactory(testDb, "")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = testDb.Exec(
+					"UPDATE players SET metadata=$1 WHERE id=$2",
+					`{"x": "1", "y": "2", "nested": {"a": 1, "b": 2}}`, player.ID,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				updPlayer, err := PatchPlayer(
+					testDb,
+					logger,
+					fixtures.GetEncryptionKey(),
+					player.GameID,
+					player.PublicID,
+					"",
+					map[string]interface{}{
+						"x":      nil,
+						"z":      "3",
+						"nested": map[string]interface{}{"b": nil, "c": 3},
+					},
+					0,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updPlayer.ID).To(Equal(player.ID))
+
+				dbPlayer, err := GetPlayerByID(testDb, fixtures.GetEncryptionKey(), player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Name).To(Equal(player.Name))
+				Expect(dbPlayer.Metadata).NotTo(HaveKey("x"))
+				Expect(dbPlayer.Metadata["y"]).To(Equal("2"))
+				Expect(dbPlayer.Metadata["z"]).To(Equal("3"))
+				Expect(dbPlayer.Metadata["nested"]).To(BeEquivalentTo(map[string]interface{}{"a": float64(1), "c": float64(3)}))
+			})
+
+			It("Should replace the player name", func() {
+				_, player, err := fixtures.CreatePlayerFactory(testDb, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = PatchPlayer(testDb, logger, fixtures.GetEncryptionKey(), player.GameID, player.PublicID, "new-name", nil, 0)
+				Expect(err).NotTo(HaveOccurred())
+
+				dbPlayer, err := GetPlayerByID(testDb, fixtures.GetEncryptionKey(), player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Name).To(Equal("new-name"))
+				Expect(dbPlayer.Metadata).To(Equal(player.Metadata))
+			})
+
+			It("Should fail if player does not exist", func() {
+				_, player, err := fixtures.CreatePlayerFactory(testDb, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = PatchPlayer(testDb, logger, fixtures.GetEncryptionKey(), player.GameID, "invalid-player", "", nil, 0)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Player was not found with id: invalid-player"))
+			})
+
+			It("Should fail if player is not at the expected version", func() {
+				_, player, err := fixtures.CreatePlayerFactory(testDb, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = PatchPlayer(testDb, logger, fixtures.GetEncryptionKey(), player.GameID, player.PublicID, "new-name", nil, player.Version+1)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&VersionMismatchError{}))
+
+				dbPlayer, err := GetPlayerByID(testDb, fixtures.GetEncryptionKey(), player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Name).To(Equal(player.Name))
+				Expect(dbPlayer.Version).To(Equal(player.Version))
+			})
 		})
 
 		Describe("Update Player", func() {
@@ -266,6 +614,7 @@ var _ = Describe("Player Model", func() {
 					player.PublicID,
 					player.Name,
 					metadata,
+					0,
 				)
 
 				Expect(err).NotTo(HaveOccurred())
@@ -277,6 +626,54 @@ var _ = Describe("Player Model", func() {
 				Expect(dbPlayer.Metadata["x"]).To(BeEquivalentTo(metadata["x"]))
 			})
 
+			It("Should update a Player with UpdatePlayer if it is at the expected version", func() {
+				_, player, err := fixtures.CreatePlayerFactory(testDb, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				dbPlayer, err := GetPlayerByPublicID(testDb, fixtures.GetEncryptionKey(), player.GameID, player.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+
+				updatedPlayer, err := UpdatePlayer(
+					testDb,
+					logger,
+					fixtures.GetEncryptionKey(),
+					player.GameID,
+					player.PublicID,
+					player.Name,
+					map[string]interface{}{"x": "b"},
+					dbPlayer.Version,
+				)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedPlayer.Version).To(Equal(dbPlayer.Version + 1))
+			})
+
+			It("Should not update a Player with UpdatePlayer if it is not at the expected version", func() {
+				_, player, err := fixtures.CreatePlayerFactory(testDb, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				dbPlayer, err := GetPlayerByPublicID(testDb, fixtures.GetEncryptionKey(), player.GameID, player.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = UpdatePlayer(
+					testDb,
+					logger,
+					fixtures.GetEncryptionKey(),
+					player.GameID,
+					player.PublicID,
+					player.Name,
+					map[string]interface{}{"x": "b"},
+					dbPlayer.Version+1,
+				)
+
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&VersionMismatchError{}))
+
+				dbPlayer, err = GetPlayerByPublicID(testDb, fixtures.GetEncryptionKey(), player.GameID, player.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Metadata["x"]).NotTo(Equal("b"))
+			})
+
 			It("Should update a Player encrypting the Player.Name", func() {
 				_, player, err := fixtures.CreatePlayerFactory(testDb, "")
 				Expect(err).NotTo(HaveOccurred())
@@ -291,6 +688,7 @@ var _ = Describe("Player Model", func() {
 					player.PublicID,
 					playerName,
 					player.Metadata,
+					0,
 				)
 
 				Expect(updatedPlayer.Name).To(Equal(playerName))
@@ -322,6 +720,7 @@ var _ = Describe("Player Model", func() {
 					player.PublicID,
 					playerName,
 					metadata,
+					0,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -346,6 +745,7 @@ var _ = Describe("Player Model", func() {
 					player.PublicID,
 					playerName,
 					metadata,
+					0,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -373,6 +773,7 @@ var _ = Describe("Player Model", func() {
 					publicID,
 					publicID,
 					metadata,
+					0,
 				)
 
 				Expect(err).NotTo(HaveOccurred())
@@ -402,6 +803,7 @@ var _ = Describe("Player Model", func() {
 					publicID,
 					playerName,
 					metadata,
+					0,
 				)
 
 				Expect(err).NotTo(HaveOccurred())
@@ -436,6 +838,7 @@ var _ = Describe("Player Model", func() {
 					playerPublicID,
 					playerName,
 					metadata,
+					0,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -462,6 +865,7 @@ var _ = Describe("Player Model", func() {
 					playerPublicID,
 					playerName,
 					metadata,
+					0,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -497,6 +901,7 @@ var _ = Describe("Player Model", func() {
 					uuid.NewV4().String(),
 					uuid.NewV4().String(),
 					metadata,
+					0,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -516,6 +921,7 @@ var _ = Describe("Player Model", func() {
 					"qwe",
 					"some player name",
 					map[string]interface{}{},
+					0,
 				)
 
 				Expect(err).To(HaveOccurred())
@@ -1116,5 +1522,212 @@ var _ = Describe("Player Model", func() {
 				Expect(encryptedPlayer.PlayerID).To(Equal(secondPlayer.ID))
 			})
 		})
+
+		Describe("Encryption key rotation", func() {
+			newKey := []byte("11111111111111111111111111111111")
+			var keyring *util.Keyring
+
+			BeforeEach(func() {
+				keyring = util.NewKeyring("new", map[string][]byte{
+					util.DefaultEncryptionKeyID: fixtures.GetEncryptionKey(),
+					"new":                       newKey,
+				})
+				SetEncryptionKeyring(keyring)
+			})
+
+			AfterEach(func() {
+				SetEncryptionKeyring(nil)
+			})
+
+			createPlayer := func() *Player {
+				game := fixtures.GameFactory.MustCreate().(*Game)
+				err := testDb.Insert(game)
+				Expect(err).NotTo(HaveOccurred())
+				player, err := CreatePlayer(
+					testDb,
+					logger,
+					fixtures.GetEncryptionKey(),
+					game.PublicID,
+					uuid.NewV4().String(),
+					"player-name",
+					map[string]interface{}{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+				return player
+			}
+
+			It("Should record the ID of the key used to encrypt the player", func() {
+				player := createPlayer()
+
+				var encryptedPlayer *EncryptedPlayer
+				err := testDb.SelectOne(&encryptedPlayer, "select * from encrypted_players where player_id = $1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encryptedPlayer.KeyID).To(Equal(util.DefaultEncryptionKeyID))
+			})
+
+			It("Should re-encrypt players with the current key", func() {
+				player := createPlayer()
+
+				players, err := GetPlayersToRotate(testDb, keyring.CurrentKeyID(), player.ID-1, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(players).To(HaveLen(1))
+				Expect(players[0].PlayerID).To(Equal(player.ID))
+
+				failedPlayerIDs, err := RotatePlayersEncryptionKey(testDb, keyring, players)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failedPlayerIDs).To(BeEmpty())
+
+				var encryptedPlayer *EncryptedPlayer
+				err = testDb.SelectOne(&encryptedPlayer, "select * from encrypted_players where player_id = $1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encryptedPlayer.KeyID).To(Equal("new"))
+
+				var dbPlayer *Player
+				err = testDb.SelectOne(&dbPlayer, "select * from players where id = $1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				decryptedName, err := util.DecryptData(dbPlayer.Name, newKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decryptedName).To(Equal("player-name"))
+
+				players, err = GetPlayersToRotate(testDb, keyring.CurrentKeyID(), player.ID-1, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(players).To(BeEmpty())
+			})
+
+			It("Should decrypt players with their recorded key", func() {
+				player := createPlayer()
+
+				dbPlayer, err := GetPlayerByID(testDb, newKey, player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Name).To(Equal("player-name"))
+			})
+
+			It("Should return players that cannot be decrypted", func() {
+				player := createPlayer()
+				players, err := GetPlayersToRotate(testDb, keyring.CurrentKeyID(), player.ID-1, 10)
+				Expect(err).NotTo(HaveOccurred())
+
+				otherKeyring := util.NewKeyring("new", map[string][]byte{"new": newKey})
+				failedPlayerIDs, err := RotatePlayersEncryptionKey(testDb, otherKeyring, players)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failedPlayerIDs).To(Equal([]int64{player.ID}))
+			})
+
+			It("Should re-encrypt the encrypted metadata values with the current key", func() {
+				game := fixtures.GameFactory.MustCreateWithOption(map[string]interface{}{
+					"PlayerEncryptedMetadataFields": "email",
+				}).(*Game)
+				err := testDb.Insert(game)
+				Expect(err).NotTo(HaveOccurred())
+				metadata := map[string]interface{}{"email": "player@example.com", "level": 10.0}
+				player, err := CreatePlayer(
+					testDb,
+					logger,
+					fixtures.GetEncryptionKey(),
+					game.PublicID,
+					uuid.NewV4().String(),
+					"player-name",
+					metadata,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				players, err := GetPlayersMetadataToRotate(testDb, player.ID-1, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(players).To(HaveLen(1))
+				Expect(players[0].PlayerID).To(Equal(player.ID))
+
+				failedPlayerIDs, err := RotatePlayersMetadataEncryptionKey(testDb, keyring, players)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failedPlayerIDs).To(BeEmpty())
+
+				var dbPlayer *Player
+				err = testDb.SelectOne(&dbPlayer, "select * from players where id = $1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Metadata["level"]).To(Equal(10.0))
+				encryptedEmail := strings.TrimPrefix(dbPlayer.Metadata["email"].(string), EncryptedMetadataPrefix)
+				email, err := util.DecryptData(encryptedEmail, newKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(email).To(Equal(`"player@example.com"`))
+
+				details, err := GetPlayerDetails(testDb, newKey, game.PublicID, player.PublicID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(details["metadata"]).To(Equal(metadata))
+			})
+
+			It("Should wrap names and metadata values encrypted directly with the current key", func() {
+				game := fixtures.GameFactory.MustCreateWithOption(map[string]interface{}{
+					"PlayerEncryptedMetadataFields": "email",
+				}).(*Game)
+				err := testDb.Insert(game)
+				Expect(err).NotTo(HaveOccurred())
+				player, err := CreatePlayer(
+					testDb,
+					logger,
+					fixtures.GetEncryptionKey(),
+					game.PublicID,
+					uuid.NewV4().String(),
+					"player-name",
+					map[string]interface{}{"email": "player@example.com"},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				defaultKeyring := util.NewKeyring(util.DefaultEncryptionKeyID, map[string][]byte{
+					util.DefaultEncryptionKeyID: fixtures.GetEncryptionKey(),
+				})
+				util.SetKeyProvider(util.NewLocalKeyProvider())
+				defer util.SetKeyProvider(nil)
+
+				players, err := GetPlayersToRotate(testDb, defaultKeyring.CurrentKeyID(), player.ID-1, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(players).To(HaveLen(1))
+				failedPlayerIDs, err := RotatePlayersEncryptionKey(testDb, defaultKeyring, players)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failedPlayerIDs).To(BeEmpty())
+
+				metadataPlayers, err := GetPlayersMetadataToRotate(testDb, player.ID-1, 10)
+				Expect(err).NotTo(HaveOccurred())
+				failedPlayerIDs, err = RotatePlayersMetadataEncryptionKey(testDb, defaultKeyring, metadataPlayers)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failedPlayerIDs).To(BeEmpty())
+
+				var dbPlayer *Player
+				err = testDb.SelectOne(&dbPlayer, "select * from players where id = $1", player.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dbPlayer.Name).To(HavePrefix(util.EnvelopePrefix))
+				Expect(dbPlayer.Metadata["email"]).To(HavePrefix(EncryptedMetadataPrefix + util.EnvelopePrefix))
+				name, err := util.DecryptData(dbPlayer.Name, fixtures.GetEncryptionKey())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(name).To(Equal("player-name"))
+
+				players, err = GetPlayersToRotate(testDb, defaultKeyring.CurrentKeyID(), player.ID-1, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(players).To(BeEmpty())
+			})
+
+			It("Should return players whose metadata cannot be decrypted", func() {
+				game := fixtures.GameFactory.MustCreateWithOption(map[string]interface{}{
+					"PlayerEncryptedMetadataFields": "email",
+				}).(*Game)
+				err := testDb.Insert(game)
+				Expect(err).NotTo(HaveOccurred())
+				player, err := CreatePlayer(
+					testDb,
+					logger,
+					fixtures.GetEncryptionKey(),
+					game.PublicID,
+					uuid.NewV4().String(),
+					"player-name",
+					map[string]interface{}{"email": "player@example.com"},
+				)
+				Expect(err).NotTo(HaveOccurred())
+				players, err := GetPlayersMetadataToRotate(testDb, player.ID-1, 10)
+				Expect(err).NotTo(HaveOccurred())
+
+				otherKeyring := util.NewKeyring("new", map[string][]byte{"new": newKey})
+				failedPlayerIDs, err := RotatePlayersMetadataEncryptionKey(testDb, otherKeyring, players)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failedPlayerIDs).To(Equal([]int64{player.ID}))
+			})
+		})
 	})
 })
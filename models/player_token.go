@@ -0,0 +1,105 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"fmt"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// PlayerTokenSettings configures the tokens game clients call Khan directly with. Tokens are JWTs
+// the game signs with the private key of PublicKey
+type PlayerTokenSettings struct {
+	// PublicKey is the PEM encoded RSA or ECDSA public key tokens are verified with. Player tokens are disabled if empty
+	PublicKey string `db:"player_token_public_key" json:"publicKey" doc:"PEM encoded RSA or ECDSA public key player tokens are verified with"`
+	// MaxTTL is the longest lifetime of a token in seconds. Zero uses the default of the Khan instance
+	MaxTTL int `db:"player_token_max_ttl" json:"maxTTL" doc:"Longest lifetime of a player token in seconds"`
+}
+
+// Enabled returns whether the game accepts player tokens
+func (s *PlayerTokenSettings) Enabled() bool {
+	return s.PublicKey != ""
+}
+
+// Validate returns an error if the public key cannot be parsed
+func (s *PlayerTokenSettings) Validate() error {
+	if s.MaxTTL < 0 {
+		return fmt.Errorf("maxTTL must not be negative")
+	}
+	if !s.Enabled() {
+		return nil
+	}
+	_, _, err := s.parsePublicKey()
+	return err
+}
+
+// parsePublicKey returns the public key with the signing methods that can be verified with it
+func (s *PlayerTokenSettings) parsePublicKey() (interface{}, []string, error) {
+	if key, err := jwt.ParseRSAPublicKeyFromPEM([]byte(s.PublicKey)); err == nil {
+		return key, []string{"RS256", "RS384", "RS512"}, nil
+	}
+	if key, err := jwt.ParseECPublicKeyFromPEM([]byte(s.PublicKey)); err == nil {
+		return key, []string{"ES256", "ES384", "ES512"}, nil
+	}
+	return nil, nil, fmt.Errorf("publicKey must be a PEM encoded RSA or ECDSA public key")
+}
+
+// ValidateGamesPlayerTokenSettings returns an error naming the first game whose player token settings are invalid
+func ValidateGamesPlayerTokenSettings(db DB) error {
+	var games []*Game
+	_, err := db.Select(&games, "SELECT * FROM games WHERE player_token_public_key<>'' ORDER BY id")
+	if err != nil {
+		return err
+	}
+	for _, game := range games {
+		if err := game.PlayerTokenSettings.Validate(); err != nil {
+			return fmt.Errorf("game %s has invalid player token settings: %s", game.PublicID, err.Error())
+		}
+	}
+	return nil
+}
+
+// VerifyPlayerToken returns the public ID of the player a token of the game was issued to. The token must be
+// signed with the game key, have the game as audience and expire within the max TTL of the game or defaultMaxTTL
+func (s *PlayerTokenSettings) VerifyPlayerToken(gameID, token string, defaultMaxTTL time.Duration) (string, error) {
+	if !s.Enabled() {
+		return "", &InvalidPlayerTokenError{"game does not accept player tokens"}
+	}
+	key, methods, err := s.parsePublicKey()
+	if err != nil {
+		// keys are validated when games are written and at startup, so this only happens if a key is changed in the DB
+		return "", &InvalidPlayerTokenError{"game player token public key is invalid"}
+	}
+
+	var claims jwt.StandardClaims
+	parser := &jwt.Parser{ValidMethods: methods}
+	_, err = parser.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return key, nil
+	})
+	if err != nil {
+		return "", &InvalidPlayerTokenError{err.Error()}
+	}
+
+	maxTTL := defaultMaxTTL
+	if s.MaxTTL > 0 {
+		maxTTL = time.Duration(s.MaxTTL) * time.Second
+	}
+	switch {
+	case claims.Subject == "":
+		return "", &InvalidPlayerTokenError{"token has no subject"}
+	case !claims.VerifyAudience(gameID, true):
+		return "", &InvalidPlayerTokenError{"token audience is not the game"}
+	case claims.ExpiresAt == 0 || claims.IssuedAt == 0:
+		return "", &InvalidPlayerTokenError{"token must have exp and iat claims"}
+	case time.Duration(claims.ExpiresAt-claims.IssuedAt)*time.Second > maxTTL:
+		return "", &InvalidPlayerTokenError{fmt.Sprintf("token lifetime is longer than %s", maxTTL)}
+	}
+	return claims.Subject, nil
+}
@@ -0,0 +1,105 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models_test
+
+import (
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	. "github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/models/fixtures"
+)
+
+var _ = Describe("Player Token Model", func() {
+	var settings *PlayerTokenSettings
+	var signToken func(playerPublicID string, ttl time.Duration) string
+	gameID := uuid.NewV4().String()
+
+	BeforeEach(func() {
+		key, publicKey, err := fixtures.GetPlayerTokenKey()
+		Expect(err).NotTo(HaveOccurred())
+		settings = &PlayerTokenSettings{PublicKey: publicKey}
+		signToken = func(playerPublicID string, ttl time.Duration) string {
+			token, err := fixtures.SignPlayerToken(key, gameID, playerPublicID, ttl)
+			Expect(err).NotTo(HaveOccurred())
+			return token
+		}
+	})
+
+	Describe("Validate", func() {
+		It("Should accept RSA public keys and empty keys", func() {
+			Expect(settings.Validate()).To(Succeed())
+			Expect((&PlayerTokenSettings{}).Validate()).To(Succeed())
+		})
+
+		It("Should not accept invalid keys", func() {
+			err := (&PlayerTokenSettings{PublicKey: "not a key"}).Validate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Verify Player Token", func() {
+		It("Should return the subject of a valid token", func() {
+			playerPublicID := uuid.NewV4().String()
+			subject, err := settings.VerifyPlayerToken(gameID, signToken(playerPublicID, time.Minute), time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subject).To(Equal(playerPublicID))
+		})
+
+		It("Should not accept expired tokens", func() {
+			_, err := settings.VerifyPlayerToken(gameID, signToken("player", -time.Minute), time.Hour)
+			Expect(err).To(BeAssignableToTypeOf(&InvalidPlayerTokenError{}))
+		})
+
+		It("Should not accept tokens that live longer than the max TTL", func() {
+			token := signToken("player", 2*time.Hour)
+			_, err := settings.VerifyPlayerToken(gameID, token, time.Hour)
+			Expect(err).To(BeAssignableToTypeOf(&InvalidPlayerTokenError{}))
+
+			settings.MaxTTL = 3 * 3600
+			_, err = settings.VerifyPlayerToken(gameID, token, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should not accept tokens of other games", func() {
+			_, err := settings.VerifyPlayerToken(uuid.NewV4().String(), signToken("player", time.Minute), time.Hour)
+			Expect(err).To(BeAssignableToTypeOf(&InvalidPlayerTokenError{}))
+		})
+
+		It("Should not accept tokens signed with other keys", func() {
+			_, publicKey, err := fixtures.GetPlayerTokenKey()
+			Expect(err).NotTo(HaveOccurred())
+			otherSettings := &PlayerTokenSettings{PublicKey: publicKey}
+
+			_, err = otherSettings.VerifyPlayerToken(gameID, signToken("player", time.Minute), time.Hour)
+			Expect(err).To(BeAssignableToTypeOf(&InvalidPlayerTokenError{}))
+		})
+
+		It("Should not accept tokens signed with HMAC", func() {
+			now := time.Now()
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{
+				Subject:   "player",
+				Audience:  gameID,
+				IssuedAt:  now.Unix(),
+				ExpiresAt: now.Add(time.Minute).Unix(),
+			}).SignedString([]byte(settings.PublicKey))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = settings.VerifyPlayerToken(gameID, token, time.Hour)
+			Expect(err).To(BeAssignableToTypeOf(&InvalidPlayerTokenError{}))
+		})
+
+		It("Should not accept tokens if the game has no public key", func() {
+			_, err := (&PlayerTokenSettings{}).VerifyPlayerToken(gameID, signToken("player", time.Minute), time.Hour)
+			Expect(err).To(BeAssignableToTypeOf(&InvalidPlayerTokenError{}))
+		})
+	})
+})
@@ -21,16 +21,18 @@ type PruneStats struct {
 	PendingInvitesPruned      int
 	DeniedMembershipsPruned   int
 	DeletedMembershipsPruned  int
+	IdempotencyKeysPruned     int
 }
 
 //GetStats returns a formatted message
 func (ps *PruneStats) GetStats() string {
 	return fmt.Sprintf(
-		"-Pending Applications: %d\n-Pending Invites: %d\n-Denied Memberships: %d\n-Deleted Memberships: %d\n",
+		"-Pending Applications: %d\n-Pending Invites: %d\n-Denied Memberships: %d\n-Deleted Memberships: %d\n-Idempotency Keys: %d\n",
 		ps.PendingApplicationsPruned,
 		ps.PendingInvitesPruned,
 		ps.DeniedMembershipsPruned,
 		ps.DeletedMembershipsPruned,
+		ps.IdempotencyKeysPruned,
 	)
 }
 
@@ -0,0 +1,207 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/jrallison/go-workers"
+	"github.com/topfreegames/khan/mongo"
+	"github.com/topfreegames/khan/queues"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DefaultSearchMinPrefixLength is the minimum prefix length used when the game does not configure one
+const DefaultSearchMinPrefixLength = 4
+
+// SearchSettings holds the per game options used to index and search clans by name.
+// Zero values keep the default behavior, so games created before these settings existed
+// are indexed the same way as before.
+type SearchSettings struct {
+	// MinPrefixLength is the length of the shortest name prefix indexed for each word
+	MinPrefixLength int `db:"search_min_prefix_length" json:"minPrefixLength" doc:"Length of the shortest name prefix indexed for each word"`
+	// AccentSensitive disables accent folding on indexation and search
+	AccentSensitive bool `db:"search_accent_sensitive" json:"accentSensitive" doc:"Whether accents are kept on indexation and search"`
+	// CaseSensitive disables case folding on indexation and search
+	CaseSensitive bool `db:"search_case_sensitive" json:"caseSensitive" doc:"Whether case is kept on indexation and search"`
+	// CJKNGramSize enables n-gram tokenization of chinese, japanese and korean text when greater than zero
+	CJKNGramSize int `db:"search_cjk_ngram_size" json:"cjkNGramSize" doc:"Size of the n-grams of chinese, japanese and korean text, zero disables them"`
+	// NameWeight is the text index weight of the clan name
+	NameWeight int `db:"search_name_weight" json:"nameWeight" doc:"Text index weight of the clan name"`
+	// NamePrefixesWeight is the text index weight of the clan name prefixes
+	NamePrefixesWeight int `db:"search_name_prefixes_weight" json:"namePrefixesWeight" doc:"Text index weight of the clan name prefixes"`
+}
+
+type searchSegment struct {
+	runes []rune
+	cjk   bool
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+func (s *SearchSettings) minPrefixLength() int {
+	if s.MinPrefixLength <= 0 {
+		return DefaultSearchMinPrefixLength
+	}
+	return s.MinPrefixLength
+}
+
+func (s *SearchSettings) normalize(word string) string {
+	if !s.CaseSensitive {
+		word = strings.ToLower(word)
+	}
+	if !s.AccentSensitive {
+		t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+		if folded, _, err := transform.String(t, word); err == nil {
+			word = folded
+		}
+	}
+	return word
+}
+
+// segments splits a word into runs of CJK and non CJK characters when n-gram tokenization is enabled
+func (s *SearchSettings) segments(word string) []searchSegment {
+	wordRunes := []rune(word)
+	if s.CJKNGramSize <= 0 {
+		return []searchSegment{{runes: wordRunes}}
+	}
+
+	var segments []searchSegment
+	for _, r := range wordRunes {
+		cjk := isCJK(r)
+		last := len(segments) - 1
+		if last >= 0 && segments[last].cjk == cjk {
+			segments[last].runes = append(segments[last].runes, r)
+			continue
+		}
+		segments = append(segments, searchSegment{runes: []rune{r}, cjk: cjk})
+	}
+	return segments
+}
+
+func (s *SearchSettings) nGrams(segment []rune) []string {
+	if len(segment) <= s.CJKNGramSize {
+		return []string{string(segment)}
+	}
+	grams := make([]string, 0, len(segment)-s.CJKNGramSize+1)
+	for i := 0; i+s.CJKNGramSize <= len(segment); i++ {
+		grams = append(grams, string(segment[i:i+s.CJKNGramSize]))
+	}
+	return grams
+}
+
+// NamePrefixes returns the tokens indexed for a clan name: the prefixes of each word
+// starting at MinPrefixLength characters, and the n-grams of CJK text if enabled
+func (s *SearchSettings) NamePrefixes(name string) []string {
+	minPrefixLength := s.minPrefixLength()
+	foundPrefixes := make(map[string]bool)
+	var prefixes []string
+	add := func(prefix string) {
+		if !foundPrefixes[prefix] {
+			foundPrefixes[prefix] = true
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	for _, word := range strings.Fields(name) {
+		for _, segment := range s.segments(s.normalize(word)) {
+			if segment.cjk {
+				for _, gram := range s.nGrams(segment.runes) {
+					add(gram)
+				}
+				continue
+			}
+
+			segmentLen := len(segment.runes)
+			firstPrefixIdx := minPrefixLength
+			if firstPrefixIdx > segmentLen {
+				firstPrefixIdx = segmentLen
+			}
+			for i := firstPrefixIdx; i <= segmentLen; i++ {
+				add(string(segment.runes[:i]))
+			}
+		}
+	}
+	return prefixes
+}
+
+// SearchTerm returns the term sent to the text index, splitting CJK text in n-grams if enabled
+func (s *SearchSettings) SearchTerm(term string) string {
+	if s.CJKNGramSize <= 0 {
+		return term
+	}
+
+	var tokens []string
+	for _, word := range strings.Fields(term) {
+		for _, segment := range s.segments(word) {
+			if segment.cjk {
+				tokens = append(tokens, s.nGrams(segment.runes)...)
+			} else {
+				tokens = append(tokens, string(segment.runes))
+			}
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// TextSearchFilter returns the MongoDB $text filter for the given term
+func (s *SearchSettings) TextSearchFilter(term string) bson.M {
+	return bson.M{"$text": bson.M{
+		"$search":             s.SearchTerm(term),
+		"$caseSensitive":      s.CaseSensitive,
+		"$diacriticSensitive": s.AccentSensitive,
+	}}
+}
+
+// GetClanNameTextIndexCommand returns the mongo command to create the clan names text index with the game weights
+func (s *SearchSettings) GetClanNameTextIndexCommand(gameID string, background bool) bson.D {
+	return mongo.GetClanNameTextIndexCommand(gameID, background, s.NameWeight, s.NamePrefixesWeight)
+}
+
+// GetGameSearchSettings returns the search settings of a game by its public id
+func GetGameSearchSettings(db DB, gameID string) (*SearchSettings, error) {
+	var settings []*SearchSettings
+	_, err := db.Select(&settings, `
+	SELECT
+		search_min_prefix_length, search_accent_sensitive, search_case_sensitive,
+		search_cjk_ngram_size, search_name_weight, search_name_prefixes_weight
+	FROM games WHERE public_id=$1`, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if len(settings) < 1 {
+		return nil, &ModelNotFoundError{"Game", gameID}
+	}
+	return settings[0], nil
+}
+
+// TextIndexChanged returns whether the clan names text index built with other differs from the one built
+// with the settings, which is the case when the weights change
+func (s *SearchSettings) TextIndexChanged(other *SearchSettings) bool {
+	return s.NameWeight != other.NameWeight || s.NamePrefixesWeight != other.NamePrefixesWeight
+}
+
+// EnqueueClansReindex enqueues the reindex of the clans of a game into MongoDB with the current search
+// settings of the game and returns the id of the job. The text index is rebuilt when rebuildIndex is set
+func EnqueueClansReindex(gameID string, rebuildIndex bool) (string, error) {
+	return workers.EnqueueWithOptions(queues.KhanMongoQueue, "Reindex", map[string]interface{}{
+		"game":         gameID,
+		"op":           "reindex",
+		"rebuildIndex": rebuildIndex,
+	}, workers.EnqueueOptions{
+		Retry: true,
+		At:    float64(time.Now().UnixNano()) / float64(time.Second),
+	})
+}
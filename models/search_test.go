@@ -0,0 +1,95 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package models_test
+
+import (
+	"github.com/globalsign/mgo/bson"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/topfreegames/khan/models"
+)
+
+var _ = Describe("Search Settings", func() {
+	Describe("NamePrefixes", func() {
+		It("Should use the default minimum prefix length with zero settings", func() {
+			settings := &SearchSettings{}
+			Expect(settings.NamePrefixes("Brazil Clan")).To(Equal([]string{
+				"braz", "brazi", "brazil", "clan",
+			}))
+		})
+
+		It("Should use the configured minimum prefix length", func() {
+			settings := &SearchSettings{MinPrefixLength: 2}
+			Expect(settings.NamePrefixes("Clan")).To(Equal([]string{
+				"cl", "cla", "clan",
+			}))
+		})
+
+		It("Should fold accents unless accent sensitive", func() {
+			settings := &SearchSettings{}
+			Expect(settings.NamePrefixes("Clán")).To(Equal([]string{"clan"}))
+
+			settings.AccentSensitive = true
+			Expect(settings.NamePrefixes("Clán")).To(Equal([]string{"clán"}))
+		})
+
+		It("Should fold case unless case sensitive", func() {
+			settings := &SearchSettings{CaseSensitive: true}
+			Expect(settings.NamePrefixes("Clan")).To(Equal([]string{"Clan"}))
+		})
+
+		It("Should split CJK text in n-grams if enabled", func() {
+			settings := &SearchSettings{CJKNGramSize: 2}
+			Expect(settings.NamePrefixes("東京の猫clan")).To(Equal([]string{
+				"東京", "京の", "の猫", "clan",
+			}))
+		})
+
+		It("Should keep CJK text as a single prefix if n-grams are disabled", func() {
+			settings := &SearchSettings{}
+			Expect(settings.NamePrefixes("東京の猫")).To(Equal([]string{"東京の猫"}))
+		})
+	})
+
+	Describe("TextSearchFilter", func() {
+		It("Should build the text filter with the game settings", func() {
+			settings := &SearchSettings{CJKNGramSize: 2, CaseSensitive: true}
+			Expect(settings.TextSearchFilter("東京の clan")).To(Equal(bson.M{"$text": bson.M{
+				"$search":             "東京 京の clan",
+				"$caseSensitive":      true,
+				"$diacriticSensitive": false,
+			}}))
+		})
+	})
+
+	Describe("GetClanNameTextIndexCommand", func() {
+		It("Should use the default weights with zero settings", func() {
+			settings := &SearchSettings{}
+			cmd := settings.GetClanNameTextIndexCommand("game-id", false)
+			index := cmd[1].Value.([]interface{})[0].(bson.M)
+			Expect(index["weights"]).To(Equal(bson.M{"name": 256, "namePrefixes": 1}))
+		})
+
+		It("Should use the configured weights", func() {
+			settings := &SearchSettings{NameWeight: 10, NamePrefixesWeight: 5}
+			cmd := settings.GetClanNameTextIndexCommand("game-id", true)
+			index := cmd[1].Value.([]interface{})[0].(bson.M)
+			Expect(index["weights"]).To(Equal(bson.M{"name": 10, "namePrefixes": 5}))
+			Expect(index["background"]).To(BeTrue())
+		})
+	})
+
+	Describe("TextIndexChanged", func() {
+		It("Should only consider the weights", func() {
+			settings := &SearchSettings{MinPrefixLength: 2, NameWeight: 10}
+			Expect(settings.TextIndexChanged(&SearchSettings{MinPrefixLength: 3, NameWeight: 10})).To(BeFalse())
+			Expect(settings.TextIndexChanged(&SearchSettings{MinPrefixLength: 2, NameWeight: 20})).To(BeTrue())
+			Expect(settings.TextIndexChanged(&SearchSettings{MinPrefixLength: 2, NameWeight: 10, NamePrefixesWeight: 2})).To(BeTrue())
+		})
+	})
+})
@@ -6,10 +6,43 @@ import (
 	"github.com/globalsign/mgo/bson"
 )
 
+// DefaultClanNameWeight is the text index weight of the clan name when the game does not configure one
+const DefaultClanNameWeight = 256
+
+// DefaultClanNamePrefixesWeight is the text index weight of the clan name prefixes when the game does not configure one
+const DefaultClanNamePrefixesWeight = 1
+
+// GetClanNameTextIndexName returns the name of the clan names text index.
+func GetClanNameTextIndexName(gameID string) string {
+	return fmt.Sprintf("clans_%s_name_text_namePrefixes_text_index", gameID)
+}
+
+// GetClansCollectionName returns the name of the clans collection of a game.
+func GetClansCollectionName(gameID string) string {
+	return fmt.Sprintf("clans_%s", gameID)
+}
+
 // GetClanNameTextIndexCommand returns a mongo command to create the clan names text index.
-func GetClanNameTextIndexCommand(gameID string, background bool) bson.D {
+// Weights lower or equal to zero are replaced by the default weights.
+func GetClanNameTextIndexCommand(gameID string, background bool, nameWeight, namePrefixesWeight int) bson.D {
+	return GetClanNameTextIndexCommandForCollection(
+		GetClansCollectionName(gameID), gameID, background, nameWeight, namePrefixesWeight,
+	)
+}
+
+// GetClanNameTextIndexCommandForCollection returns a mongo command to create the clan names text index
+// of a game in the given collection.
+func GetClanNameTextIndexCommandForCollection(
+	collection, gameID string, background bool, nameWeight, namePrefixesWeight int,
+) bson.D {
+	if nameWeight <= 0 {
+		nameWeight = DefaultClanNameWeight
+	}
+	if namePrefixesWeight <= 0 {
+		namePrefixesWeight = DefaultClanNamePrefixesWeight
+	}
 	return bson.D{
-		{Name: "createIndexes", Value: fmt.Sprintf("clans_%s", gameID)},
+		{Name: "createIndexes", Value: collection},
 		{Name: "indexes", Value: []interface{}{
 			bson.M{
 				"key": bson.M{
@@ -17,13 +50,35 @@ func GetClanNameTextIndexCommand(gameID string, background bool) bson.D {
 					"namePrefixes": "text",
 				},
 				"weights": bson.M{
-					"name":         256,
-					"namePrefixes": 1,
+					"name":         nameWeight,
+					"namePrefixes": namePrefixesWeight,
 				},
-				"name":             fmt.Sprintf("clans_%s_name_text_namePrefixes_text_index", gameID),
+				"name":             GetClanNameTextIndexName(gameID),
 				"background":       background,
 				"default_language": "none",
 			},
 		}},
 	}
 }
+
+// GetDropClansCollectionCommand returns a mongo command to drop the clans collection of a game.
+func GetDropClansCollectionCommand(gameID string) bson.D {
+	return GetDropCollectionCommand(GetClansCollectionName(gameID))
+}
+
+// GetDropCollectionCommand returns a mongo command to drop a collection.
+func GetDropCollectionCommand(collection string) bson.D {
+	return bson.D{
+		{Name: "drop", Value: collection},
+	}
+}
+
+// GetRenameCollectionCommand returns a mongo command to replace the target collection of a database
+// with the source collection. It must be run against the admin database.
+func GetRenameCollectionCommand(database, source, target string) bson.D {
+	return bson.D{
+		{Name: "renameCollection", Value: fmt.Sprintf("%s.%s", database, source)},
+		{Name: "to", Value: fmt.Sprintf("%s.%s", database, target)},
+		{Name: "dropTarget", Value: true},
+	}
+}
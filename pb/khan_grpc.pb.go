@@ -0,0 +1,1619 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// KhanClient is the client API for Khan service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KhanClient interface {
+	// GET /healthcheck
+	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	// GET /status
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// GET /games
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	// POST /games
+	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error)
+	// GET /games/:gameID
+	RetrieveGame(ctx context.Context, in *RetrieveGameRequest, opts ...grpc.CallOption) (*RetrieveGameResponse, error)
+	// PUT /games/:gameID
+	UpdateGame(ctx context.Context, in *UpdateGameRequest, opts ...grpc.CallOption) (*UpdateGameResponse, error)
+	// DELETE /games/:gameID
+	DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*DeleteGameResponse, error)
+	// GET /games/:gameID/versions
+	ListGameVersions(ctx context.Context, in *ListGameVersionsRequest, opts ...grpc.CallOption) (*ListGameVersionsResponse, error)
+	// POST /games/:gameID/versions/:version/rollback
+	RollbackGame(ctx context.Context, in *RollbackGameRequest, opts ...grpc.CallOption) (*RollbackGameResponse, error)
+	// GET /games/:gameID/api-keys
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// POST /games/:gameID/api-keys
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// DELETE /games/:gameID/api-keys/:apiKeyPublicID
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	// POST /games/:gameID/hooks
+	CreateHook(ctx context.Context, in *CreateHookRequest, opts ...grpc.CallOption) (*CreateHookResponse, error)
+	// DELETE /games/:gameID/hooks/:publicID
+	RemoveHook(ctx context.Context, in *RemoveHookRequest, opts ...grpc.CallOption) (*RemoveHookResponse, error)
+	// POST /games/:gameID/players
+	CreatePlayer(ctx context.Context, in *CreatePlayerRequest, opts ...grpc.CallOption) (*CreatePlayerResponse, error)
+	// PUT /games/:gameID/players
+	UpsertPlayers(ctx context.Context, in *UpsertPlayersRequest, opts ...grpc.CallOption) (*UpsertPlayersResponse, error)
+	// PUT /games/:gameID/players/:playerPublicID
+	UpdatePlayer(ctx context.Context, in *UpdatePlayerRequest, opts ...grpc.CallOption) (*UpdatePlayerResponse, error)
+	// PATCH /games/:gameID/players/:playerPublicID
+	PatchPlayer(ctx context.Context, in *PatchPlayerRequest, opts ...grpc.CallOption) (*PatchPlayerResponse, error)
+	// GET /games/:gameID/players/:playerPublicID
+	RetrievePlayer(ctx context.Context, in *RetrievePlayerRequest, opts ...grpc.CallOption) (*RetrievePlayerResponse, error)
+	// DELETE /games/:gameID/players/:playerPublicID
+	DeletePlayer(ctx context.Context, in *DeletePlayerRequest, opts ...grpc.CallOption) (*DeletePlayerResponse, error)
+	// GET /games/:gameID/players/:playerPublicID/export
+	ExportPlayer(ctx context.Context, in *ExportPlayerRequest, opts ...grpc.CallOption) (*ExportPlayerResponse, error)
+	// GET /games/:gameID/players/:playerPublicID/name-history
+	RetrievePlayerNameHistory(ctx context.Context, in *RetrievePlayerNameHistoryRequest, opts ...grpc.CallOption) (*RetrievePlayerNameHistoryResponse, error)
+	// GET /games/:gameID/clans/search
+	SearchClans(ctx context.Context, in *SearchClansRequest, opts ...grpc.CallOption) (*SearchClansResponse, error)
+	// GET /games/:gameID/clans/suggest
+	SuggestClans(ctx context.Context, in *SuggestClansRequest, opts ...grpc.CallOption) (*SuggestClansResponse, error)
+	// GET /games/:gameID/clans
+	ListClans(ctx context.Context, in *ListClansRequest, opts ...grpc.CallOption) (*ListClansResponse, error)
+	// POST /games/:gameID/clans
+	CreateClan(ctx context.Context, in *CreateClanRequest, opts ...grpc.CallOption) (*CreateClanResponse, error)
+	// GET /games/:gameID/clans-summary
+	RetrieveClansSummaries(ctx context.Context, in *RetrieveClansSummariesRequest, opts ...grpc.CallOption) (*RetrieveClansSummariesResponse, error)
+	// GET /games/:gameID/clans/:clanPublicID
+	RetrieveClan(ctx context.Context, in *RetrieveClanRequest, opts ...grpc.CallOption) (*RetrieveClanResponse, error)
+	// GET /games/:gameID/clans/:clanPublicID/members
+	RetrieveClanMembers(ctx context.Context, in *RetrieveClanMembersRequest, opts ...grpc.CallOption) (*RetrieveClanMembersResponse, error)
+	// GET /games/:gameID/clans/:clanPublicID/summary
+	RetrieveClanSummary(ctx context.Context, in *RetrieveClanSummaryRequest, opts ...grpc.CallOption) (*RetrieveClanSummaryResponse, error)
+	// PUT /games/:gameID/clans/:clanPublicID
+	UpdateClan(ctx context.Context, in *UpdateClanRequest, opts ...grpc.CallOption) (*UpdateClanResponse, error)
+	// PATCH /games/:gameID/clans/:clanPublicID
+	PatchClan(ctx context.Context, in *PatchClanRequest, opts ...grpc.CallOption) (*PatchClanResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/leave
+	LeaveClan(ctx context.Context, in *LeaveClanRequest, opts ...grpc.CallOption) (*LeaveClanResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/transfer-ownership
+	TransferClanOwnership(ctx context.Context, in *TransferClanOwnershipRequest, opts ...grpc.CallOption) (*TransferClanOwnershipResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/application
+	ApplyForMembership(ctx context.Context, in *ApplyForMembershipRequest, opts ...grpc.CallOption) (*ApplyForMembershipResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/application/:action
+	ApproveOrDenyMembershipApplication(ctx context.Context, in *ApproveOrDenyMembershipApplicationRequest, opts ...grpc.CallOption) (*ApproveOrDenyMembershipApplicationResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/invitation
+	InviteForMembership(ctx context.Context, in *InviteForMembershipRequest, opts ...grpc.CallOption) (*InviteForMembershipResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/invitation/:action
+	ApproveOrDenyMembershipInvitation(ctx context.Context, in *ApproveOrDenyMembershipInvitationRequest, opts ...grpc.CallOption) (*ApproveOrDenyMembershipInvitationResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/delete
+	DeleteMembership(ctx context.Context, in *DeleteMembershipRequest, opts ...grpc.CallOption) (*DeleteMembershipResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/promote
+	PromoteMembership(ctx context.Context, in *PromoteMembershipRequest, opts ...grpc.CallOption) (*PromoteMembershipResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/demote
+	DemoteMembership(ctx context.Context, in *DemoteMembershipRequest, opts ...grpc.CallOption) (*DemoteMembershipResponse, error)
+}
+
+type khanClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKhanClient(cc grpc.ClientConnInterface) KhanClient {
+	return &khanClient{cc}
+}
+
+func (c *khanClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/HealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error) {
+	out := new(ListGamesResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/ListGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error) {
+	out := new(CreateGameResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/CreateGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) RetrieveGame(ctx context.Context, in *RetrieveGameRequest, opts ...grpc.CallOption) (*RetrieveGameResponse, error) {
+	out := new(RetrieveGameResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/RetrieveGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) UpdateGame(ctx context.Context, in *UpdateGameRequest, opts ...grpc.CallOption) (*UpdateGameResponse, error) {
+	out := new(UpdateGameResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/UpdateGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*DeleteGameResponse, error) {
+	out := new(DeleteGameResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/DeleteGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) ListGameVersions(ctx context.Context, in *ListGameVersionsRequest, opts ...grpc.CallOption) (*ListGameVersionsResponse, error) {
+	out := new(ListGameVersionsResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/ListGameVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) RollbackGame(ctx context.Context, in *RollbackGameRequest, opts ...grpc.CallOption) (*RollbackGameResponse, error) {
+	out := new(RollbackGameResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/RollbackGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) CreateHook(ctx context.Context, in *CreateHookRequest, opts ...grpc.CallOption) (*CreateHookResponse, error) {
+	out := new(CreateHookResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/CreateHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) RemoveHook(ctx context.Context, in *RemoveHookRequest, opts ...grpc.CallOption) (*RemoveHookResponse, error) {
+	out := new(RemoveHookResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/RemoveHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) CreatePlayer(ctx context.Context, in *CreatePlayerRequest, opts ...grpc.CallOption) (*CreatePlayerResponse, error) {
+	out := new(CreatePlayerResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/CreatePlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) UpsertPlayers(ctx context.Context, in *UpsertPlayersRequest, opts ...grpc.CallOption) (*UpsertPlayersResponse, error) {
+	out := new(UpsertPlayersResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/UpsertPlayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) UpdatePlayer(ctx context.Context, in *UpdatePlayerRequest, opts ...grpc.CallOption) (*UpdatePlayerResponse, error) {
+	out := new(UpdatePlayerResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/UpdatePlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) PatchPlayer(ctx context.Context, in *PatchPlayerRequest, opts ...grpc.CallOption) (*PatchPlayerResponse, error) {
+	out := new(PatchPlayerResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/PatchPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) RetrievePlayer(ctx context.Context, in *RetrievePlayerRequest, opts ...grpc.CallOption) (*RetrievePlayerResponse, error) {
+	out := new(RetrievePlayerResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/RetrievePlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) DeletePlayer(ctx context.Context, in *DeletePlayerRequest, opts ...grpc.CallOption) (*DeletePlayerResponse, error) {
+	out := new(DeletePlayerResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/DeletePlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) ExportPlayer(ctx context.Context, in *ExportPlayerRequest, opts ...grpc.CallOption) (*ExportPlayerResponse, error) {
+	out := new(ExportPlayerResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/ExportPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) RetrievePlayerNameHistory(ctx context.Context, in *RetrievePlayerNameHistoryRequest, opts ...grpc.CallOption) (*RetrievePlayerNameHistoryResponse, error) {
+	out := new(RetrievePlayerNameHistoryResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/RetrievePlayerNameHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) SearchClans(ctx context.Context, in *SearchClansRequest, opts ...grpc.CallOption) (*SearchClansResponse, error) {
+	out := new(SearchClansResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/SearchClans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) SuggestClans(ctx context.Context, in *SuggestClansRequest, opts ...grpc.CallOption) (*SuggestClansResponse, error) {
+	out := new(SuggestClansResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/SuggestClans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) ListClans(ctx context.Context, in *ListClansRequest, opts ...grpc.CallOption) (*ListClansResponse, error) {
+	out := new(ListClansResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/ListClans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) CreateClan(ctx context.Context, in *CreateClanRequest, opts ...grpc.CallOption) (*CreateClanResponse, error) {
+	out := new(CreateClanResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/CreateClan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) RetrieveClansSummaries(ctx context.Context, in *RetrieveClansSummariesRequest, opts ...grpc.CallOption) (*RetrieveClansSummariesResponse, error) {
+	out := new(RetrieveClansSummariesResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/RetrieveClansSummaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) RetrieveClan(ctx context.Context, in *RetrieveClanRequest, opts ...grpc.CallOption) (*RetrieveClanResponse, error) {
+	out := new(RetrieveClanResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/RetrieveClan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) RetrieveClanMembers(ctx context.Context, in *RetrieveClanMembersRequest, opts ...grpc.CallOption) (*RetrieveClanMembersResponse, error) {
+	out := new(RetrieveClanMembersResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/RetrieveClanMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) RetrieveClanSummary(ctx context.Context, in *RetrieveClanSummaryRequest, opts ...grpc.CallOption) (*RetrieveClanSummaryResponse, error) {
+	out := new(RetrieveClanSummaryResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/RetrieveClanSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) UpdateClan(ctx context.Context, in *UpdateClanRequest, opts ...grpc.CallOption) (*UpdateClanResponse, error) {
+	out := new(UpdateClanResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/UpdateClan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) PatchClan(ctx context.Context, in *PatchClanRequest, opts ...grpc.CallOption) (*PatchClanResponse, error) {
+	out := new(PatchClanResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/PatchClan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) LeaveClan(ctx context.Context, in *LeaveClanRequest, opts ...grpc.CallOption) (*LeaveClanResponse, error) {
+	out := new(LeaveClanResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/LeaveClan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) TransferClanOwnership(ctx context.Context, in *TransferClanOwnershipRequest, opts ...grpc.CallOption) (*TransferClanOwnershipResponse, error) {
+	out := new(TransferClanOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/TransferClanOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) ApplyForMembership(ctx context.Context, in *ApplyForMembershipRequest, opts ...grpc.CallOption) (*ApplyForMembershipResponse, error) {
+	out := new(ApplyForMembershipResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/ApplyForMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) ApproveOrDenyMembershipApplication(ctx context.Context, in *ApproveOrDenyMembershipApplicationRequest, opts ...grpc.CallOption) (*ApproveOrDenyMembershipApplicationResponse, error) {
+	out := new(ApproveOrDenyMembershipApplicationResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/ApproveOrDenyMembershipApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) InviteForMembership(ctx context.Context, in *InviteForMembershipRequest, opts ...grpc.CallOption) (*InviteForMembershipResponse, error) {
+	out := new(InviteForMembershipResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/InviteForMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) ApproveOrDenyMembershipInvitation(ctx context.Context, in *ApproveOrDenyMembershipInvitationRequest, opts ...grpc.CallOption) (*ApproveOrDenyMembershipInvitationResponse, error) {
+	out := new(ApproveOrDenyMembershipInvitationResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/ApproveOrDenyMembershipInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) DeleteMembership(ctx context.Context, in *DeleteMembershipRequest, opts ...grpc.CallOption) (*DeleteMembershipResponse, error) {
+	out := new(DeleteMembershipResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/DeleteMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) PromoteMembership(ctx context.Context, in *PromoteMembershipRequest, opts ...grpc.CallOption) (*PromoteMembershipResponse, error) {
+	out := new(PromoteMembershipResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/PromoteMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *khanClient) DemoteMembership(ctx context.Context, in *DemoteMembershipRequest, opts ...grpc.CallOption) (*DemoteMembershipResponse, error) {
+	out := new(DemoteMembershipResponse)
+	err := c.cc.Invoke(ctx, "/khan.Khan/DemoteMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KhanServer is the server API for Khan service.
+// All implementations must embed UnimplementedKhanServer
+// for forward compatibility
+type KhanServer interface {
+	// GET /healthcheck
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	// GET /status
+	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// GET /games
+	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	// POST /games
+	CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error)
+	// GET /games/:gameID
+	RetrieveGame(context.Context, *RetrieveGameRequest) (*RetrieveGameResponse, error)
+	// PUT /games/:gameID
+	UpdateGame(context.Context, *UpdateGameRequest) (*UpdateGameResponse, error)
+	// DELETE /games/:gameID
+	DeleteGame(context.Context, *DeleteGameRequest) (*DeleteGameResponse, error)
+	// GET /games/:gameID/versions
+	ListGameVersions(context.Context, *ListGameVersionsRequest) (*ListGameVersionsResponse, error)
+	// POST /games/:gameID/versions/:version/rollback
+	RollbackGame(context.Context, *RollbackGameRequest) (*RollbackGameResponse, error)
+	// GET /games/:gameID/api-keys
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// POST /games/:gameID/api-keys
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// DELETE /games/:gameID/api-keys/:apiKeyPublicID
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	// POST /games/:gameID/hooks
+	CreateHook(context.Context, *CreateHookRequest) (*CreateHookResponse, error)
+	// DELETE /games/:gameID/hooks/:publicID
+	RemoveHook(context.Context, *RemoveHookRequest) (*RemoveHookResponse, error)
+	// POST /games/:gameID/players
+	CreatePlayer(context.Context, *CreatePlayerRequest) (*CreatePlayerResponse, error)
+	// PUT /games/:gameID/players
+	UpsertPlayers(context.Context, *UpsertPlayersRequest) (*UpsertPlayersResponse, error)
+	// PUT /games/:gameID/players/:playerPublicID
+	UpdatePlayer(context.Context, *UpdatePlayerRequest) (*UpdatePlayerResponse, error)
+	// PATCH /games/:gameID/players/:playerPublicID
+	PatchPlayer(context.Context, *PatchPlayerRequest) (*PatchPlayerResponse, error)
+	// GET /games/:gameID/players/:playerPublicID
+	RetrievePlayer(context.Context, *RetrievePlayerRequest) (*RetrievePlayerResponse, error)
+	// DELETE /games/:gameID/players/:playerPublicID
+	DeletePlayer(context.Context, *DeletePlayerRequest) (*DeletePlayerResponse, error)
+	// GET /games/:gameID/players/:playerPublicID/export
+	ExportPlayer(context.Context, *ExportPlayerRequest) (*ExportPlayerResponse, error)
+	// GET /games/:gameID/players/:playerPublicID/name-history
+	RetrievePlayerNameHistory(context.Context, *RetrievePlayerNameHistoryRequest) (*RetrievePlayerNameHistoryResponse, error)
+	// GET /games/:gameID/clans/search
+	SearchClans(context.Context, *SearchClansRequest) (*SearchClansResponse, error)
+	// GET /games/:gameID/clans/suggest
+	SuggestClans(context.Context, *SuggestClansRequest) (*SuggestClansResponse, error)
+	// GET /games/:gameID/clans
+	ListClans(context.Context, *ListClansRequest) (*ListClansResponse, error)
+	// POST /games/:gameID/clans
+	CreateClan(context.Context, *CreateClanRequest) (*CreateClanResponse, error)
+	// GET /games/:gameID/clans-summary
+	RetrieveClansSummaries(context.Context, *RetrieveClansSummariesRequest) (*RetrieveClansSummariesResponse, error)
+	// GET /games/:gameID/clans/:clanPublicID
+	RetrieveClan(context.Context, *RetrieveClanRequest) (*RetrieveClanResponse, error)
+	// GET /games/:gameID/clans/:clanPublicID/members
+	RetrieveClanMembers(context.Context, *RetrieveClanMembersRequest) (*RetrieveClanMembersResponse, error)
+	// GET /games/:gameID/clans/:clanPublicID/summary
+	RetrieveClanSummary(context.Context, *RetrieveClanSummaryRequest) (*RetrieveClanSummaryResponse, error)
+	// PUT /games/:gameID/clans/:clanPublicID
+	UpdateClan(context.Context, *UpdateClanRequest) (*UpdateClanResponse, error)
+	// PATCH /games/:gameID/clans/:clanPublicID
+	PatchClan(context.Context, *PatchClanRequest) (*PatchClanResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/leave
+	LeaveClan(context.Context, *LeaveClanRequest) (*LeaveClanResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/transfer-ownership
+	TransferClanOwnership(context.Context, *TransferClanOwnershipRequest) (*TransferClanOwnershipResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/application
+	ApplyForMembership(context.Context, *ApplyForMembershipRequest) (*ApplyForMembershipResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/application/:action
+	ApproveOrDenyMembershipApplication(context.Context, *ApproveOrDenyMembershipApplicationRequest) (*ApproveOrDenyMembershipApplicationResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/invitation
+	InviteForMembership(context.Context, *InviteForMembershipRequest) (*InviteForMembershipResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/invitation/:action
+	ApproveOrDenyMembershipInvitation(context.Context, *ApproveOrDenyMembershipInvitationRequest) (*ApproveOrDenyMembershipInvitationResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/delete
+	DeleteMembership(context.Context, *DeleteMembershipRequest) (*DeleteMembershipResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/promote
+	PromoteMembership(context.Context, *PromoteMembershipRequest) (*PromoteMembershipResponse, error)
+	// POST /games/:gameID/clans/:clanPublicID/memberships/demote
+	DemoteMembership(context.Context, *DemoteMembershipRequest) (*DemoteMembershipResponse, error)
+	mustEmbedUnimplementedKhanServer()
+}
+
+// UnimplementedKhanServer must be embedded to have forward compatible implementations.
+type UnimplementedKhanServer struct {
+}
+
+func (UnimplementedKhanServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedKhanServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedKhanServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedKhanServer) CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (UnimplementedKhanServer) RetrieveGame(context.Context, *RetrieveGameRequest) (*RetrieveGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveGame not implemented")
+}
+func (UnimplementedKhanServer) UpdateGame(context.Context, *UpdateGameRequest) (*UpdateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGame not implemented")
+}
+func (UnimplementedKhanServer) DeleteGame(context.Context, *DeleteGameRequest) (*DeleteGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGame not implemented")
+}
+func (UnimplementedKhanServer) ListGameVersions(context.Context, *ListGameVersionsRequest) (*ListGameVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGameVersions not implemented")
+}
+func (UnimplementedKhanServer) RollbackGame(context.Context, *RollbackGameRequest) (*RollbackGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackGame not implemented")
+}
+func (UnimplementedKhanServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedKhanServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedKhanServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedKhanServer) CreateHook(context.Context, *CreateHookRequest) (*CreateHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHook not implemented")
+}
+func (UnimplementedKhanServer) RemoveHook(context.Context, *RemoveHookRequest) (*RemoveHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHook not implemented")
+}
+func (UnimplementedKhanServer) CreatePlayer(context.Context, *CreatePlayerRequest) (*CreatePlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlayer not implemented")
+}
+func (UnimplementedKhanServer) UpsertPlayers(context.Context, *UpsertPlayersRequest) (*UpsertPlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPlayers not implemented")
+}
+func (UnimplementedKhanServer) UpdatePlayer(context.Context, *UpdatePlayerRequest) (*UpdatePlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlayer not implemented")
+}
+func (UnimplementedKhanServer) PatchPlayer(context.Context, *PatchPlayerRequest) (*PatchPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchPlayer not implemented")
+}
+func (UnimplementedKhanServer) RetrievePlayer(context.Context, *RetrievePlayerRequest) (*RetrievePlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrievePlayer not implemented")
+}
+func (UnimplementedKhanServer) DeletePlayer(context.Context, *DeletePlayerRequest) (*DeletePlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlayer not implemented")
+}
+func (UnimplementedKhanServer) ExportPlayer(context.Context, *ExportPlayerRequest) (*ExportPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPlayer not implemented")
+}
+func (UnimplementedKhanServer) RetrievePlayerNameHistory(context.Context, *RetrievePlayerNameHistoryRequest) (*RetrievePlayerNameHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrievePlayerNameHistory not implemented")
+}
+func (UnimplementedKhanServer) SearchClans(context.Context, *SearchClansRequest) (*SearchClansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchClans not implemented")
+}
+func (UnimplementedKhanServer) SuggestClans(context.Context, *SuggestClansRequest) (*SuggestClansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestClans not implemented")
+}
+func (UnimplementedKhanServer) ListClans(context.Context, *ListClansRequest) (*ListClansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClans not implemented")
+}
+func (UnimplementedKhanServer) CreateClan(context.Context, *CreateClanRequest) (*CreateClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClan not implemented")
+}
+func (UnimplementedKhanServer) RetrieveClansSummaries(context.Context, *RetrieveClansSummariesRequest) (*RetrieveClansSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveClansSummaries not implemented")
+}
+func (UnimplementedKhanServer) RetrieveClan(context.Context, *RetrieveClanRequest) (*RetrieveClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveClan not implemented")
+}
+func (UnimplementedKhanServer) RetrieveClanMembers(context.Context, *RetrieveClanMembersRequest) (*RetrieveClanMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveClanMembers not implemented")
+}
+func (UnimplementedKhanServer) RetrieveClanSummary(context.Context, *RetrieveClanSummaryRequest) (*RetrieveClanSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveClanSummary not implemented")
+}
+func (UnimplementedKhanServer) UpdateClan(context.Context, *UpdateClanRequest) (*UpdateClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClan not implemented")
+}
+func (UnimplementedKhanServer) PatchClan(context.Context, *PatchClanRequest) (*PatchClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchClan not implemented")
+}
+func (UnimplementedKhanServer) LeaveClan(context.Context, *LeaveClanRequest) (*LeaveClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveClan not implemented")
+}
+func (UnimplementedKhanServer) TransferClanOwnership(context.Context, *TransferClanOwnershipRequest) (*TransferClanOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferClanOwnership not implemented")
+}
+func (UnimplementedKhanServer) ApplyForMembership(context.Context, *ApplyForMembershipRequest) (*ApplyForMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyForMembership not implemented")
+}
+func (UnimplementedKhanServer) ApproveOrDenyMembershipApplication(context.Context, *ApproveOrDenyMembershipApplicationRequest) (*ApproveOrDenyMembershipApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOrDenyMembershipApplication not implemented")
+}
+func (UnimplementedKhanServer) InviteForMembership(context.Context, *InviteForMembershipRequest) (*InviteForMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteForMembership not implemented")
+}
+func (UnimplementedKhanServer) ApproveOrDenyMembershipInvitation(context.Context, *ApproveOrDenyMembershipInvitationRequest) (*ApproveOrDenyMembershipInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOrDenyMembershipInvitation not implemented")
+}
+func (UnimplementedKhanServer) DeleteMembership(context.Context, *DeleteMembershipRequest) (*DeleteMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMembership not implemented")
+}
+func (UnimplementedKhanServer) PromoteMembership(context.Context, *PromoteMembershipRequest) (*PromoteMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteMembership not implemented")
+}
+func (UnimplementedKhanServer) DemoteMembership(context.Context, *DemoteMembershipRequest) (*DemoteMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemoteMembership not implemented")
+}
+func (UnimplementedKhanServer) mustEmbedUnimplementedKhanServer() {}
+
+// UnsafeKhanServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KhanServer will
+// result in compilation errors.
+type UnsafeKhanServer interface {
+	mustEmbedUnimplementedKhanServer()
+}
+
+func RegisterKhanServer(s grpc.ServiceRegistrar, srv KhanServer) {
+	s.RegisterService(&_Khan_serviceDesc, srv)
+}
+
+func _Khan_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/HealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/ListGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).ListGames(ctx, req.(*ListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).CreateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/CreateGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).CreateGame(ctx, req.(*CreateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_RetrieveGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).RetrieveGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/RetrieveGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).RetrieveGame(ctx, req.(*RetrieveGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_UpdateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).UpdateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/UpdateGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).UpdateGame(ctx, req.(*UpdateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_DeleteGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).DeleteGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/DeleteGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).DeleteGame(ctx, req.(*DeleteGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_ListGameVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGameVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).ListGameVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/ListGameVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).ListGameVersions(ctx, req.(*ListGameVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_RollbackGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).RollbackGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/RollbackGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).RollbackGame(ctx, req.(*RollbackGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_CreateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).CreateHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/CreateHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).CreateHook(ctx, req.(*CreateHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_RemoveHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).RemoveHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/RemoveHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).RemoveHook(ctx, req.(*RemoveHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_CreatePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).CreatePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/CreatePlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).CreatePlayer(ctx, req.(*CreatePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_UpsertPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertPlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).UpsertPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/UpsertPlayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).UpsertPlayers(ctx, req.(*UpsertPlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_UpdatePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).UpdatePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/UpdatePlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).UpdatePlayer(ctx, req.(*UpdatePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_PatchPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).PatchPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/PatchPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).PatchPlayer(ctx, req.(*PatchPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_RetrievePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrievePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).RetrievePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/RetrievePlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).RetrievePlayer(ctx, req.(*RetrievePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_DeletePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).DeletePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/DeletePlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).DeletePlayer(ctx, req.(*DeletePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_ExportPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).ExportPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/ExportPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).ExportPlayer(ctx, req.(*ExportPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_RetrievePlayerNameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrievePlayerNameHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).RetrievePlayerNameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/RetrievePlayerNameHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).RetrievePlayerNameHistory(ctx, req.(*RetrievePlayerNameHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_SearchClans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchClansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).SearchClans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/SearchClans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).SearchClans(ctx, req.(*SearchClansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_SuggestClans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestClansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).SuggestClans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/SuggestClans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).SuggestClans(ctx, req.(*SuggestClansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_ListClans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).ListClans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/ListClans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).ListClans(ctx, req.(*ListClansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_CreateClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).CreateClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/CreateClan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).CreateClan(ctx, req.(*CreateClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_RetrieveClansSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveClansSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).RetrieveClansSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/RetrieveClansSummaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).RetrieveClansSummaries(ctx, req.(*RetrieveClansSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_RetrieveClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).RetrieveClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/RetrieveClan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).RetrieveClan(ctx, req.(*RetrieveClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_RetrieveClanMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveClanMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).RetrieveClanMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/RetrieveClanMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).RetrieveClanMembers(ctx, req.(*RetrieveClanMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_RetrieveClanSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveClanSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).RetrieveClanSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/RetrieveClanSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).RetrieveClanSummary(ctx, req.(*RetrieveClanSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_UpdateClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).UpdateClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/UpdateClan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).UpdateClan(ctx, req.(*UpdateClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_PatchClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).PatchClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/PatchClan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).PatchClan(ctx, req.(*PatchClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_LeaveClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).LeaveClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/LeaveClan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).LeaveClan(ctx, req.(*LeaveClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_TransferClanOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferClanOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).TransferClanOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/TransferClanOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).TransferClanOwnership(ctx, req.(*TransferClanOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_ApplyForMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyForMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).ApplyForMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/ApplyForMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).ApplyForMembership(ctx, req.(*ApplyForMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_ApproveOrDenyMembershipApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveOrDenyMembershipApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).ApproveOrDenyMembershipApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/ApproveOrDenyMembershipApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).ApproveOrDenyMembershipApplication(ctx, req.(*ApproveOrDenyMembershipApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_InviteForMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteForMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).InviteForMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/InviteForMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).InviteForMembership(ctx, req.(*InviteForMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_ApproveOrDenyMembershipInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveOrDenyMembershipInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).ApproveOrDenyMembershipInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/ApproveOrDenyMembershipInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).ApproveOrDenyMembershipInvitation(ctx, req.(*ApproveOrDenyMembershipInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_DeleteMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).DeleteMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/DeleteMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).DeleteMembership(ctx, req.(*DeleteMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_PromoteMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).PromoteMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/PromoteMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).PromoteMembership(ctx, req.(*PromoteMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Khan_DemoteMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DemoteMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KhanServer).DemoteMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/khan.Khan/DemoteMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KhanServer).DemoteMembership(ctx, req.(*DemoteMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Khan_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "khan.Khan",
+	HandlerType: (*KhanServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HealthCheck",
+			Handler:    _Khan_HealthCheck_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Khan_Status_Handler,
+		},
+		{
+			MethodName: "ListGames",
+			Handler:    _Khan_ListGames_Handler,
+		},
+		{
+			MethodName: "CreateGame",
+			Handler:    _Khan_CreateGame_Handler,
+		},
+		{
+			MethodName: "RetrieveGame",
+			Handler:    _Khan_RetrieveGame_Handler,
+		},
+		{
+			MethodName: "UpdateGame",
+			Handler:    _Khan_UpdateGame_Handler,
+		},
+		{
+			MethodName: "DeleteGame",
+			Handler:    _Khan_DeleteGame_Handler,
+		},
+		{
+			MethodName: "ListGameVersions",
+			Handler:    _Khan_ListGameVersions_Handler,
+		},
+		{
+			MethodName: "RollbackGame",
+			Handler:    _Khan_RollbackGame_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _Khan_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _Khan_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _Khan_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "CreateHook",
+			Handler:    _Khan_CreateHook_Handler,
+		},
+		{
+			MethodName: "RemoveHook",
+			Handler:    _Khan_RemoveHook_Handler,
+		},
+		{
+			MethodName: "CreatePlayer",
+			Handler:    _Khan_CreatePlayer_Handler,
+		},
+		{
+			MethodName: "UpsertPlayers",
+			Handler:    _Khan_UpsertPlayers_Handler,
+		},
+		{
+			MethodName: "UpdatePlayer",
+			Handler:    _Khan_UpdatePlayer_Handler,
+		},
+		{
+			MethodName: "PatchPlayer",
+			Handler:    _Khan_PatchPlayer_Handler,
+		},
+		{
+			MethodName: "RetrievePlayer",
+			Handler:    _Khan_RetrievePlayer_Handler,
+		},
+		{
+			MethodName: "DeletePlayer",
+			Handler:    _Khan_DeletePlayer_Handler,
+		},
+		{
+			MethodName: "ExportPlayer",
+			Handler:    _Khan_ExportPlayer_Handler,
+		},
+		{
+			MethodName: "RetrievePlayerNameHistory",
+			Handler:    _Khan_RetrievePlayerNameHistory_Handler,
+		},
+		{
+			MethodName: "SearchClans",
+			Handler:    _Khan_SearchClans_Handler,
+		},
+		{
+			MethodName: "SuggestClans",
+			Handler:    _Khan_SuggestClans_Handler,
+		},
+		{
+			MethodName: "ListClans",
+			Handler:    _Khan_ListClans_Handler,
+		},
+		{
+			MethodName: "CreateClan",
+			Handler:    _Khan_CreateClan_Handler,
+		},
+		{
+			MethodName: "RetrieveClansSummaries",
+			Handler:    _Khan_RetrieveClansSummaries_Handler,
+		},
+		{
+			MethodName: "RetrieveClan",
+			Handler:    _Khan_RetrieveClan_Handler,
+		},
+		{
+			MethodName: "RetrieveClanMembers",
+			Handler:    _Khan_RetrieveClanMembers_Handler,
+		},
+		{
+			MethodName: "RetrieveClanSummary",
+			Handler:    _Khan_RetrieveClanSummary_Handler,
+		},
+		{
+			MethodName: "UpdateClan",
+			Handler:    _Khan_UpdateClan_Handler,
+		},
+		{
+			MethodName: "PatchClan",
+			Handler:    _Khan_PatchClan_Handler,
+		},
+		{
+			MethodName: "LeaveClan",
+			Handler:    _Khan_LeaveClan_Handler,
+		},
+		{
+			MethodName: "TransferClanOwnership",
+			Handler:    _Khan_TransferClanOwnership_Handler,
+		},
+		{
+			MethodName: "ApplyForMembership",
+			Handler:    _Khan_ApplyForMembership_Handler,
+		},
+		{
+			MethodName: "ApproveOrDenyMembershipApplication",
+			Handler:    _Khan_ApproveOrDenyMembershipApplication_Handler,
+		},
+		{
+			MethodName: "InviteForMembership",
+			Handler:    _Khan_InviteForMembership_Handler,
+		},
+		{
+			MethodName: "ApproveOrDenyMembershipInvitation",
+			Handler:    _Khan_ApproveOrDenyMembershipInvitation_Handler,
+		},
+		{
+			MethodName: "DeleteMembership",
+			Handler:    _Khan_DeleteMembership_Handler,
+		},
+		{
+			MethodName: "PromoteMembership",
+			Handler:    _Khan_PromoteMembership_Handler,
+		},
+		{
+			MethodName: "DemoteMembership",
+			Handler:    _Khan_DemoteMembership_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "khan.proto",
+}
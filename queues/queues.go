@@ -8,3 +8,9 @@ const KhanESQueue = "khan_es_updater"
 
 // KhanMongoQueue is the queue that will receive Mongo updates
 const KhanMongoQueue = "khan_mongo_updater"
+
+// KhanGameDeletionQueue is the queue that will receive game deletions
+const KhanGameDeletionQueue = "khan_game_deleter"
+
+// KhanPlayerBackfillQueue is the queue that will receive updates of the stored players of games whose settings changed
+const KhanPlayerBackfillQueue = "khan_player_backfiller"
@@ -0,0 +1,40 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+// Memory is a Store kept in the memory of the current process, so each process limits keys on its own.
+type Memory struct {
+	mutex   sync.Mutex
+	buckets *gocache.Cache
+}
+
+// NewMemory returns an in-memory Store that forgets full buckets every cleanupInterval.
+func NewMemory(cleanupInterval time.Duration) *Memory {
+	return &Memory{
+		buckets: gocache.New(gocache.NoExpiration, cleanupInterval),
+	}
+}
+
+// Take takes a token of the bucket of key at now. If the bucket is empty, it returns false and how long
+// until it has a token.
+func (m *Memory) Take(key string, limit Limit, now time.Time) (bool, time.Duration, error) {
+	if limit.Unlimited() {
+		return true, 0, nil
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	b := &bucket{tokens: float64(limit.Burst), last: now}
+	if value, present := m.buckets.Get(key); present {
+		b = value.(*bucket)
+	}
+	allowed, retryAfter := b.take(limit, now)
+	m.buckets.Set(key, b, limit.ttl())
+	return allowed, retryAfter, nil
+}
@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// RejectedMetric is the statsd counter of requests rejected for being over the limit, tagged with the route
+// group and game.
+const RejectedMetric = "rate_limit_rejected"
+
+// FailOpenMetric is the statsd counter of requests allowed because the store failed, tagged with the route
+// group and game.
+const FailOpenMetric = "rate_limit_fail_open"
+
+// Limit is the token bucket of a key: it holds at most Burst tokens and is refilled with Rate tokens per
+// second. Every request takes one token.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Unlimited returns whether the limit allows every request.
+func (l Limit) Unlimited() bool {
+	return l.Rate <= 0 || l.Burst <= 0
+}
+
+// ttl returns how long it takes for an empty bucket to be full again, after which it can be forgotten.
+func (l Limit) ttl() time.Duration {
+	return time.Duration(math.Ceil(float64(l.Burst) / l.Rate * float64(time.Second)))
+}
+
+// Store keeps the token buckets of the keys being limited.
+type Store interface {
+	// Take takes a token of the bucket of key at now. If the bucket is empty, it returns false and how long
+	// until it has a token.
+	Take(key string, limit Limit, now time.Time) (bool, time.Duration, error)
+}
+
+// bucket is the state of a token bucket.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take refills the bucket up to now and takes a token from it.
+func (b *bucket) take(limit Limit, now time.Time) (bool, time.Duration) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed.Seconds()*limit.Rate)
+		b.last = now
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration(math.Ceil((1 - b.tokens) / limit.Rate * float64(time.Second)))
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// takeScript refills the bucket stored in the hash KEYS[1] up to ARGV[3] milliseconds, with ARGV[1] tokens
+// per second up to ARGV[2] tokens, and takes a token from it. It returns whether there was a token and, if
+// not, the milliseconds until there is one.
+var takeScript = redis.NewScript(1, `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "last")
+local tokens = tonumber(bucket[1])
+local last = tonumber(bucket[2])
+if tokens == nil or last == nil then
+	tokens = burst
+	last = now
+end
+if now > last then
+	tokens = math.min(burst, tokens + (now - last) * rate / 1000)
+	last = now
+end
+local allowed = 0
+local retryAfter = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retryAfter = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call("HMSET", KEYS[1], "tokens", tostring(tokens), "last", last)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate))
+return {allowed, retryAfter}
+`)
+
+// Redis is a Store shared by every process connected to the same Redis instance.
+type Redis struct {
+	pool   *redis.Pool
+	prefix string
+}
+
+// NewRedis returns a Redis Store whose keys are prefixed by prefix.
+func NewRedis(pool *redis.Pool, prefix string) *Redis {
+	return &Redis{
+		pool:   pool,
+		prefix: prefix,
+	}
+}
+
+// Take takes a token of the bucket of key at now. If the bucket is empty, it returns false and how long
+// until it has a token.
+func (r *Redis) Take(key string, limit Limit, now time.Time) (bool, time.Duration, error) {
+	if limit.Unlimited() {
+		return true, 0, nil
+	}
+
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	nowMilli := now.UnixNano() / int64(time.Millisecond)
+	result, err := redis.Int64s(takeScript.Do(conn, r.prefix+key, limit.Rate, limit.Burst, nowMilli))
+	if err != nil {
+		return false, 0, err
+	}
+	return result[0] == 1, time.Duration(result[1]) * time.Millisecond, nil
+}
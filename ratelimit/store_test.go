@@ -0,0 +1,98 @@
+package ratelimit_test
+
+import (
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	"github.com/topfreegames/khan/ratelimit"
+	"github.com/topfreegames/khan/testing"
+)
+
+var _ = Describe("Store", func() {
+	limit := ratelimit.Limit{Rate: 2, Burst: 3}
+
+	assertStore := func(getStore func() ratelimit.Store) {
+		It("Should allow a burst of requests", func() {
+			store := getStore()
+			key := uuid.NewV4().String()
+			now := time.Now()
+
+			for i := 0; i < limit.Burst; i++ {
+				allowed, _, err := store.Take(key, limit, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(allowed).To(BeTrue())
+			}
+
+			allowed, retryAfter, err := store.Take(key, limit, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowed).To(BeFalse())
+			Expect(retryAfter).To(Equal(time.Second / 2))
+		})
+
+		It("Should refill the bucket over time", func() {
+			store := getStore()
+			key := uuid.NewV4().String()
+			now := time.Now()
+
+			for i := 0; i < limit.Burst; i++ {
+				store.Take(key, limit, now)
+			}
+
+			allowed, _, err := store.Take(key, limit, now.Add(time.Second/2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+			allowed, _, err = store.Take(key, limit, now.Add(time.Second/2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowed).To(BeFalse())
+		})
+
+		It("Should limit keys independently", func() {
+			store := getStore()
+			key := uuid.NewV4().String()
+			now := time.Now()
+
+			for i := 0; i < limit.Burst; i++ {
+				store.Take(key, limit, now)
+			}
+
+			allowed, _, err := store.Take(uuid.NewV4().String(), limit, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+		})
+
+		It("Should allow every request if unlimited", func() {
+			store := getStore()
+			key := uuid.NewV4().String()
+			now := time.Now()
+
+			for i := 0; i < 10; i++ {
+				allowed, _, err := store.Take(key, ratelimit.Limit{}, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(allowed).To(BeTrue())
+			}
+		})
+	}
+
+	Describe("Memory", func() {
+		assertStore(func() ratelimit.Store {
+			return ratelimit.NewMemory(time.Minute)
+		})
+	})
+
+	Describe("Redis", func() {
+		var pool *redis.Pool
+
+		BeforeEach(func() {
+			var err error
+			pool, err = testing.GetTestRedisPool()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		assertStore(func() ratelimit.Store {
+			return ratelimit.NewRedis(pool, "khan:test:ratelimit:")
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	gorp "github.com/topfreegames/extensions/v9/gorp/interfaces"
 	"github.com/topfreegames/khan/log"
 	"github.com/topfreegames/khan/models"
+	"github.com/topfreegames/khan/util"
 	"github.com/uber-go/zap"
 )
 
@@ -22,7 +23,12 @@ type EncryptionScript struct {
 	Config        *viper.Viper
 	Logger        zap.Logger
 	EncryptionKey []byte
+	Keyring       *util.Keyring
 	db            gorp.Database
+
+	lastRotatedPlayerID         int64
+	lastRotatedNameChangeID     int64
+	lastRotatedMetadataPlayerID int64
 }
 
 // GetEncryptionScript returns a new Khan API Application
@@ -57,6 +63,10 @@ func (app *EncryptionScript) setConfigurationDefaults() {
 	app.Config.SetDefault("postgres.port", 5432)
 	app.Config.SetDefault("postgres.sslMode", "disable")
 	app.Config.SetDefault("security.encryptionKey", "00000000000000000000000000000000")
+	app.Config.SetDefault("security.encryptionKeyFile", "")
+	app.Config.SetDefault("security.keyProvider", "")
+	app.Config.SetDefault("security.kms.timeout", time.Second)
+	app.Config.SetDefault("security.kms.cacheTTL", 5*time.Minute)
 	app.Config.SetDefault("script.tick", "1s")
 	app.Config.SetDefault("script.playerAmount", "500")
 
@@ -84,7 +94,27 @@ func (app *EncryptionScript) loadConfiguration() {
 		log.P(logger, "Config file failed to load.")
 	}
 
-	app.EncryptionKey = []byte(app.Config.GetString("security.encryptionKey"))
+	app.configureEncryption(logger)
+}
+
+func (app *EncryptionScript) configureEncryption(logger zap.Logger) {
+	keyring, err := util.NewKeyringFromConfig(app.Config)
+	if err != nil {
+		log.P(logger, "Could not load encryption keys.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+	keyProvider, err := util.NewKeyProviderFromConfig(app.Config)
+	if err != nil {
+		log.P(logger, "Could not configure key provider.", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+
+	util.SetKeyProvider(keyProvider)
+	app.Keyring = keyring
+	app.EncryptionKey = keyring.CurrentKey()
+	models.SetEncryptionKeyring(keyring)
 }
 
 func (app *EncryptionScript) connectDatabase() {
@@ -126,8 +156,29 @@ func (app *EncryptionScript) connectDatabase() {
 	app.db = db
 }
 
-// Start starts listening for web requests at specified host and port
+// Start encrypts the names of players in batches until it is stopped
 func (app *EncryptionScript) Start() {
+	app.run(app.encryptPlayers)
+}
+
+// StartKeyRotation re-encrypts in batches the names, previous names and encrypted metadata values of players
+// encrypted with a key other than the current one of the keyring until it is stopped
+func (app *EncryptionScript) StartKeyRotation() {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "StartKeyRotation"),
+		zap.String("currentKeyID", app.Keyring.CurrentKeyID()),
+	)
+
+	if _, _, err := app.Keyring.Encrypt(""); err != nil {
+		log.P(logger, "Could not encrypt with the current encryption key", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+	}
+	app.run(app.rotatePlayersKey)
+}
+
+func (app *EncryptionScript) run(step func()) {
 	logger := app.Logger.With(
 		zap.String("source", "app"),
 		zap.String("operation", "Start"),
@@ -137,7 +188,7 @@ func (app *EncryptionScript) Start() {
 	stopScript := make(chan bool, 1)
 	signal.Notify(sg, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGKILL, syscall.SIGTERM)
 
-	go app.executeScript(stopScript)
+	go app.executeScript(stopScript, step)
 
 	// stop server
 	select {
@@ -153,14 +204,14 @@ func (app *EncryptionScript) Start() {
 	log.I(logger, "app stopped")
 }
 
-func (app *EncryptionScript) executeScript(stopChan chan bool) {
+func (app *EncryptionScript) executeScript(stopChan chan bool, step func()) {
 	ticker := time.NewTicker(app.Config.GetDuration("script.tick"))
 	for {
 		select {
 		case <-stopChan:
 			log.I(app.Logger, "Finishing script")
 		case <-ticker.C:
-			app.encryptPlayers()
+			step()
 		}
 	}
 }
@@ -198,3 +249,121 @@ func (app *EncryptionScript) encryptPlayers() {
 
 	app.Logger.Debug("encryption done", zap.String("spent time", time.Since(initTime).String()))
 }
+
+func (app *EncryptionScript) rotatePlayersKey() {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "rotatePlayersKey"),
+	)
+
+	amount := app.Config.GetInt("script.playerAmount")
+
+	initTime := time.Now()
+
+	players, err := models.GetPlayersToRotate(app.db, app.Keyring.CurrentKeyID(), app.lastRotatedPlayerID, amount)
+	if err != nil {
+		log.E(logger, "error on get players to rotate", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return
+	}
+
+	if len(players) == 0 {
+		app.rotatePlayerNameHistoryKey()
+		return
+	}
+
+	failedPlayerIDs, err := models.RotatePlayersEncryptionKey(app.db, app.Keyring, players)
+	if err != nil {
+		log.E(logger, "error on update players", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return
+	}
+	app.lastRotatedPlayerID = players[len(players)-1].PlayerID
+
+	for _, playerID := range failedPlayerIDs {
+		log.W(logger, "could not decrypt player name with any key of the keyring", func(cm log.CM) {
+			cm.Write(zap.Int64("playerID", playerID))
+		})
+	}
+
+	app.Logger.Debug("key rotation done", zap.String("spent time", time.Since(initTime).String()))
+}
+
+// rotatePlayerNameHistoryKey re-encrypts previous player names once the names of all players are rotated
+func (app *EncryptionScript) rotatePlayerNameHistoryKey() {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "rotatePlayerNameHistoryKey"),
+	)
+
+	amount := app.Config.GetInt("script.playerAmount")
+
+	changes, err := models.GetPlayerNameChangesToRotate(app.db, app.Keyring.CurrentKeyID(), app.lastRotatedNameChangeID, amount)
+	if err != nil {
+		log.E(logger, "error on get player name history to rotate", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return
+	}
+
+	if len(changes) == 0 {
+		app.rotatePlayersMetadataKey()
+		return
+	}
+
+	failedIDs, err := models.RotatePlayerNameHistoryEncryptionKey(app.db, app.Keyring, changes)
+	if err != nil {
+		log.E(logger, "error on update player name history", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return
+	}
+	app.lastRotatedNameChangeID = changes[len(changes)-1].ID
+
+	for _, id := range failedIDs {
+		log.W(logger, "could not decrypt previous player name with any key of the keyring", func(cm log.CM) {
+			cm.Write(zap.Int64("nameChangeID", id))
+		})
+	}
+}
+
+// rotatePlayersMetadataKey re-encrypts the encrypted metadata values of players once the previous player
+// names are rotated
+func (app *EncryptionScript) rotatePlayersMetadataKey() {
+	logger := app.Logger.With(
+		zap.String("source", "app"),
+		zap.String("operation", "rotatePlayersMetadataKey"),
+	)
+
+	amount := app.Config.GetInt("script.playerAmount")
+
+	players, err := models.GetPlayersMetadataToRotate(app.db, app.lastRotatedMetadataPlayerID, amount)
+	if err != nil {
+		log.E(logger, "error on get player metadata to rotate", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return
+	}
+
+	if len(players) == 0 {
+		logger.Warn("FINISHED, there is no player to rotate")
+		return
+	}
+
+	failedPlayerIDs, err := models.RotatePlayersMetadataEncryptionKey(app.db, app.Keyring, players)
+	if err != nil {
+		log.E(logger, "error on update player metadata", func(cm log.CM) {
+			cm.Write(zap.Error(err))
+		})
+		return
+	}
+	app.lastRotatedMetadataPlayerID = players[len(players)-1].PlayerID
+
+	for _, playerID := range failedPlayerIDs {
+		log.W(logger, "could not decrypt player metadata with any key of the keyring", func(cm log.CM) {
+			cm.Write(zap.Int64("playerID", playerID))
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/topfreegames/khan/caches"
 	"github.com/topfreegames/khan/util"
 
-	gocache "github.com/patrickmn/go-cache"
 	"github.com/topfreegames/khan/models"
 )
 
@@ -39,7 +38,7 @@ func GetTestDB() (models.DB, error) {
 // GetTestClansSummariesCache returns a test cache for clans summaries.
 func GetTestClansSummariesCache(ttl, cleanupInterval time.Duration) *caches.ClansSummaries {
 	return &caches.ClansSummaries{
-		Cache: gocache.New(ttl, cleanupInterval),
+		Cache: caches.NewMemory(ttl, cleanupInterval),
 	}
 }
 
@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/topfreegames/khan/util"
+	"github.com/wildlife-studios/crypto"
+)
+
+// FakeKMS is an in-memory key management service that creates a random master key for each key ID.
+// It implements util.KMSClient and serves the HTTP API used by util.HTTPKMSClient
+type FakeKMS struct {
+	mutex        sync.Mutex
+	masterKeys   map[string][]byte
+	DecryptCalls int
+}
+
+// NewFakeKMS returns a new FakeKMS
+func NewFakeKMS() *FakeKMS {
+	return &FakeKMS{masterKeys: make(map[string][]byte)}
+}
+
+func (k *FakeKMS) masterKey(keyID string) ([]byte, error) {
+	if key, ok := k.masterKeys[keyID]; ok {
+		return key, nil
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	k.masterKeys[keyID] = key
+	return key, nil
+}
+
+// GenerateDataKey returns a new data key of size bytes and the data key wrapped by the master key keyID
+func (k *FakeKMS) GenerateDataKey(keyID string, size int) ([]byte, []byte, error) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	masterKey, err := k.masterKey(keyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	dataKey := make([]byte, size)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, err
+	}
+	wrappedDataKey, err := crypto.NewXChacha().Encrypt(dataKey, masterKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dataKey, wrappedDataKey, nil
+}
+
+// Decrypt returns the data key wrapped by the master key keyID
+func (k *FakeKMS) Decrypt(keyID string, wrappedDataKey []byte) ([]byte, error) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	k.DecryptCalls++
+	masterKey, err := k.masterKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.NewXChacha().Decrypt(wrappedDataKey, masterKey)
+}
+
+// ServeHTTP serves the generateDataKey and decrypt calls of the KMS HTTP API
+func (k *FakeKMS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var response interface{}
+	var err error
+	switch r.URL.Path {
+	case "/generateDataKey":
+		var request util.KMSGenerateDataKeyRequest
+		if err = json.NewDecoder(r.Body).Decode(&request); err == nil {
+			var plaintext, ciphertextBlob []byte
+			plaintext, ciphertextBlob, err = k.GenerateDataKey(request.KeyID, request.NumberOfBytes)
+			response = &util.KMSGenerateDataKeyResponse{Plaintext: plaintext, CiphertextBlob: ciphertextBlob}
+		}
+	case "/decrypt":
+		var request util.KMSDecryptRequest
+		if err = json.NewDecoder(r.Body).Decode(&request); err == nil {
+			var plaintext []byte
+			plaintext, err = k.Decrypt(request.KeyID, request.CiphertextBlob)
+			response = &util.KMSDecryptResponse{Plaintext: plaintext}
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,19 @@
+package testing
+
+import (
+	"github.com/garyburd/redigo/redis"
+	"github.com/spf13/viper"
+	"github.com/topfreegames/khan/caches"
+)
+
+// GetTestRedisPool returns a pool of connections to the test redis
+func GetTestRedisPool() (*redis.Pool, error) {
+	config := viper.New()
+	config.SetConfigType("yaml")
+	config.SetConfigFile("../config/test.yaml")
+	err := config.ReadInConfig()
+	if err != nil {
+		return nil, err
+	}
+	return caches.NewRedisPool(config), nil
+}
@@ -0,0 +1,53 @@
+// khan
+// https://github.com/topfreegames/khan
+//
+// Licensed under the MIT license:
+// http://www.opensource.org/licenses/mit-license
+// Copyright © 2016 Top Free Games <backend@tfgco.com>
+
+package util
+
+// DeepCopyMap returns a copy of m that shares no nested maps or slices with it, so that callers can
+// safely modify a payload that is shared with others
+func DeepCopyMap(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = deepCopyValue(v)
+	}
+	return result
+}
+
+func deepCopyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return DeepCopyMap(v)
+	case []map[string]interface{}:
+		if v == nil {
+			return v
+		}
+		result := make([]map[string]interface{}, len(v))
+		for i, item := range v {
+			result[i] = DeepCopyMap(item)
+		}
+		return result
+	case []interface{}:
+		if v == nil {
+			return v
+		}
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = deepCopyValue(item)
+		}
+		return result
+	case []string:
+		if v == nil {
+			return v
+		}
+		return append([]string{}, v...)
+	default:
+		return value
+	}
+}
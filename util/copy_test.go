@@ -0,0 +1,36 @@
+package util_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/topfreegames/khan/util"
+)
+
+var _ = Describe("Copy", func() {
+	Describe("DeepCopyMap", func() {
+		It("Should not share nested maps and slices with the original", func() {
+			original := map[string]interface{}{
+				"name":     "clan",
+				"metadata": map[string]interface{}{"x": 1},
+				"members":  []map[string]interface{}{{"publicID": "p1"}},
+				"tags":     []interface{}{map[string]interface{}{"y": 2}},
+			}
+
+			copied := DeepCopyMap(original)
+			Expect(copied).To(Equal(original))
+
+			copied["metadata"].(map[string]interface{})["x"] = 3
+			copied["members"].([]map[string]interface{})[0]["publicID"] = "p2"
+			copied["tags"].([]interface{})[0].(map[string]interface{})["y"] = 4
+
+			Expect(original["metadata"]).To(Equal(map[string]interface{}{"x": 1}))
+			Expect(original["members"]).To(Equal([]map[string]interface{}{{"publicID": "p1"}}))
+			Expect(original["tags"]).To(Equal([]interface{}{map[string]interface{}{"y": 2}}))
+		})
+
+		It("Should return nil for a nil map", func() {
+			Expect(DeepCopyMap(nil)).To(BeNil())
+		})
+	})
+})
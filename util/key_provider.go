@@ -0,0 +1,157 @@
+package util
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/wildlife-studios/crypto"
+)
+
+// DataKeySize is the length in bytes of the data keys records are encrypted with
+const DataKeySize = 32
+
+// EnvelopePrefix starts the data encrypted with a data key. Base64 has no colons, so data
+// encrypted directly with the key never starts with it
+const EnvelopePrefix = "envelope:"
+
+// KeyProvider provides the data keys each record is encrypted with, wrapped by a master key it
+// manages. masterKey identifies the master key: it is the key itself for local providers and
+// its ID for remote ones
+type KeyProvider interface {
+	// GenerateDataKey returns a new data key and the data key wrapped by the master key
+	GenerateDataKey(masterKey []byte) ([]byte, []byte, error)
+	// UnwrapDataKey returns the data key wrapped by the master key
+	UnwrapDataKey(masterKey, wrappedDataKey []byte) ([]byte, error)
+}
+
+var keyProvider KeyProvider
+
+// SetKeyProvider makes EncryptData encrypt each record with a data key from provider.
+// A nil provider encrypts records directly with the key
+func SetKeyProvider(provider KeyProvider) {
+	keyProvider = provider
+}
+
+// UsesEnvelopes returns whether EncryptData encrypts each record with a data key from a key provider
+func UsesEnvelopes() bool {
+	return keyProvider != nil
+}
+
+// NeedsEnvelope returns whether encodedData was encrypted directly with the key while a key provider is
+// set, in which case it should be encrypted again so that it is wrapped by the key provider
+func NeedsEnvelope(encodedData string) bool {
+	return UsesEnvelopes() && !strings.HasPrefix(encodedData, EnvelopePrefix)
+}
+
+// LocalKeyProvider wraps data keys with a 32 bytes master key held by the process,
+// usually read from a file only it can access
+type LocalKeyProvider struct{}
+
+// NewLocalKeyProvider returns a new LocalKeyProvider
+func NewLocalKeyProvider() *LocalKeyProvider {
+	return &LocalKeyProvider{}
+}
+
+// GenerateDataKey returns a new random data key and the data key encrypted with masterKey
+func (p *LocalKeyProvider) GenerateDataKey(masterKey []byte) ([]byte, []byte, error) {
+	if len(masterKey) != 32 {
+		return nil, nil, &TokenSizeError{Msg: "The key length is different than 32"}
+	}
+
+	dataKey := make([]byte, DataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, err
+	}
+	wrappedDataKey, err := crypto.NewXChacha().Encrypt(dataKey, masterKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dataKey, wrappedDataKey, nil
+}
+
+// UnwrapDataKey returns the data key encrypted with masterKey
+func (p *LocalKeyProvider) UnwrapDataKey(masterKey, wrappedDataKey []byte) ([]byte, error) {
+	if len(masterKey) != 32 {
+		return nil, &TokenSizeError{Msg: "The key length is different than 32"}
+	}
+	return crypto.NewXChacha().Decrypt(wrappedDataKey, masterKey)
+}
+
+// NewKeyProviderFromConfig returns the key provider configured in security.keyProvider: "local",
+// "kms" or "" to encrypt records directly with the key
+func NewKeyProviderFromConfig(config *viper.Viper) (KeyProvider, error) {
+	switch providerType := config.GetString("security.keyProvider"); providerType {
+	case "":
+		return nil, nil
+	case "local":
+		return NewLocalKeyProvider(), nil
+	case "kms":
+		endpoint := config.GetString("security.kms.endpoint")
+		if endpoint == "" {
+			return nil, errors.New("security.kms.endpoint is required by the kms key provider")
+		}
+		client := NewHTTPKMSClient(endpoint, config.GetDuration("security.kms.timeout"))
+		return NewKMSKeyProvider(client, config.GetDuration("security.kms.cacheTTL")), nil
+	default:
+		return nil, fmt.Errorf("unknown key provider %s", providerType)
+	}
+}
+
+// readKeyFile returns the key stored in a file, ignoring surrounding whitespace
+func readKeyFile(path string) ([]byte, error) {
+	key, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strings.TrimSpace(string(key))), nil
+}
+
+func encryptEnvelope(data string, masterKey []byte) (string, error) {
+	dataKey, wrappedDataKey, err := keyProvider.GenerateDataKey(masterKey)
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := crypto.NewXChacha().Encrypt([]byte(data), dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	return EnvelopePrefix +
+		base64.StdEncoding.EncodeToString(wrappedDataKey) + ":" +
+		base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+func decryptEnvelope(encodedData string, masterKey []byte) (string, error) {
+	if keyProvider == nil {
+		return "", errors.New("no key provider to decrypt the data key")
+	}
+
+	parts := strings.Split(strings.TrimPrefix(encodedData, EnvelopePrefix), ":")
+	if len(parts) != 2 {
+		return "", errors.New("invalid envelope encrypted data")
+	}
+	wrappedDataKey, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", err
+	}
+	cipheredData, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", err
+	}
+
+	dataKey, err := keyProvider.UnwrapDataKey(masterKey, wrappedDataKey)
+	if err != nil {
+		return "", err
+	}
+	data, err := crypto.NewXChacha().Decrypt(cipheredData, dataKey)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
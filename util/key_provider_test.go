@@ -0,0 +1,161 @@
+package util_test
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+
+	kt "github.com/topfreegames/khan/testing"
+	. "github.com/topfreegames/khan/util"
+)
+
+var _ = Describe("Key providers", func() {
+	masterKey := []byte("00000000000000000000000000000000")
+
+	AfterEach(func() {
+		SetKeyProvider(nil)
+	})
+
+	Describe("LocalKeyProvider", func() {
+		BeforeEach(func() {
+			SetKeyProvider(NewLocalKeyProvider())
+		})
+
+		It("Should encrypt each record with its own data key", func() {
+			first, err := EncryptData(data, masterKey)
+			Expect(err).NotTo(HaveOccurred())
+			second, err := EncryptData(data, masterKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first).To(HavePrefix("envelope:"))
+			Expect(strings.Split(first, ":")[1]).NotTo(Equal(strings.Split(second, ":")[1]))
+
+			decrypted, err := DecryptData(first, masterKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(data))
+		})
+
+		It("Should not decrypt with another master key", func() {
+			encrypted, err := EncryptData(data, masterKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = DecryptData(encrypted, []byte("11111111111111111111111111111111"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should decrypt data encrypted directly with the key", func() {
+			SetKeyProvider(nil)
+			encrypted, err := EncryptData(data, masterKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			SetKeyProvider(NewLocalKeyProvider())
+			decrypted, err := DecryptData(encrypted, masterKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(data))
+		})
+
+		It("Should tell data encrypted directly with the key needs an envelope", func() {
+			SetKeyProvider(nil)
+			direct, err := EncryptData(data, masterKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(NeedsEnvelope(direct)).To(BeFalse())
+
+			SetKeyProvider(NewLocalKeyProvider())
+			envelope, err := EncryptData(data, masterKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(NeedsEnvelope(direct)).To(BeTrue())
+			Expect(NeedsEnvelope(envelope)).To(BeFalse())
+		})
+
+		It("Should return TokenSizeError if the master key does not have 32 bytes", func() {
+			_, err := EncryptData(data, masterKey[:31])
+			Expect(err).To(BeAssignableToTypeOf(&TokenSizeError{}))
+		})
+	})
+
+	Describe("KMSKeyProvider", func() {
+		var kms *kt.FakeKMS
+
+		BeforeEach(func() {
+			kms = kt.NewFakeKMS()
+		})
+
+		It("Should wrap data keys with the master key in the KMS", func() {
+			SetKeyProvider(NewKMSKeyProvider(kms, 0))
+
+			encrypted, err := EncryptData(data, []byte("alias/khan"))
+			Expect(err).NotTo(HaveOccurred())
+
+			decrypted, err := DecryptData(encrypted, []byte("alias/khan"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(data))
+
+			_, err = DecryptData(encrypted, []byte("alias/other"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should cache unwrapped data keys", func() {
+			SetKeyProvider(NewKMSKeyProvider(kms, time.Minute))
+
+			encrypted, err := EncryptData(data, []byte("alias/khan"))
+			Expect(err).NotTo(HaveOccurred())
+			for i := 0; i < 3; i++ {
+				_, err = DecryptData(encrypted, []byte("alias/khan"))
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(kms.DecryptCalls).To(Equal(1))
+		})
+
+		It("Should call the KMS HTTP API", func() {
+			server := httptest.NewServer(kms)
+			defer server.Close()
+			SetKeyProvider(NewKMSKeyProvider(NewHTTPKMSClient(server.URL, time.Second), 0))
+
+			encrypted, err := EncryptData(data, []byte("alias/khan"))
+			Expect(err).NotTo(HaveOccurred())
+
+			decrypted, err := DecryptData(encrypted, []byte("alias/khan"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(data))
+			Expect(kms.DecryptCalls).To(Equal(1))
+		})
+	})
+
+	Describe("NewKeyProviderFromConfig", func() {
+		It("Should not return a provider by default", func() {
+			provider, err := NewKeyProviderFromConfig(viper.New())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider).To(BeNil())
+		})
+
+		It("Should return an error for unknown providers", func() {
+			config := viper.New()
+			config.Set("security.keyProvider", "unknown")
+			_, err := NewKeyProviderFromConfig(config)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should read the master key from security.encryptionKeyFile", func() {
+			file, err := ioutil.TempFile("", "khan-master-key")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.Remove(file.Name())
+			_, err = file.WriteString(string(masterKey) + "\n")
+			Expect(err).NotTo(HaveOccurred())
+			file.Close()
+
+			config := viper.New()
+			config.Set("security.keyProvider", "local")
+			config.Set("security.encryptionKeyFile", file.Name())
+
+			keyring, err := NewKeyringFromConfig(config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keyring.CurrentKey()).To(Equal(masterKey))
+		})
+	})
+})
@@ -0,0 +1,124 @@
+package util
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultEncryptionKeyID identifies the key configured in security.encryptionKey. Players encrypted
+// before key IDs were recorded were encrypted with it
+const DefaultEncryptionKeyID = "default"
+
+// Keyring holds every encryption key player names may be encrypted with, by key ID,
+// and the ID of the key new data is encrypted with
+type Keyring struct {
+	currentKeyID string
+	keys         map[string][]byte
+}
+
+// NewKeyring returns a keyring that encrypts with the key identified by currentKeyID
+func NewKeyring(currentKeyID string, keys map[string][]byte) *Keyring {
+	keyring := &Keyring{
+		currentKeyID: currentKeyID,
+		keys:         make(map[string][]byte, len(keys)),
+	}
+	for keyID, key := range keys {
+		keyring.keys[keyID] = key
+	}
+	return keyring
+}
+
+// NewKeyringFromConfig returns the keyring configured in security.encryptionKey (or the file in
+// security.encryptionKeyFile), security.encryptionKeys and security.currentEncryptionKeyID.
+// Key IDs are case insensitive
+func NewKeyringFromConfig(config *viper.Viper) (*Keyring, error) {
+	keys := make(map[string][]byte)
+	if keyFile := config.GetString("security.encryptionKeyFile"); keyFile != "" {
+		key, err := readKeyFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		keys[DefaultEncryptionKeyID] = key
+	} else if key := config.GetString("security.encryptionKey"); key != "" {
+		keys[DefaultEncryptionKeyID] = []byte(key)
+	}
+	for keyID, key := range config.GetStringMapString("security.encryptionKeys") {
+		keys[strings.ToLower(keyID)] = []byte(key)
+	}
+
+	currentKeyID := strings.ToLower(config.GetString("security.currentEncryptionKeyID"))
+	if currentKeyID == "" {
+		currentKeyID = DefaultEncryptionKeyID
+	}
+	return NewKeyring(currentKeyID, keys), nil
+}
+
+// CurrentKeyID returns the ID of the key new data is encrypted with
+func (k *Keyring) CurrentKeyID() string {
+	return k.currentKeyID
+}
+
+// CurrentKey returns the key new data is encrypted with, or nil if it is not in the keyring
+func (k *Keyring) CurrentKey() []byte {
+	return k.keys[k.currentKeyID]
+}
+
+// Key returns the key identified by keyID
+func (k *Keyring) Key(keyID string) ([]byte, bool) {
+	key, ok := k.keys[keyID]
+	return key, ok
+}
+
+// KeyID returns the ID of key
+func (k *Keyring) KeyID(key []byte) (string, bool) {
+	for keyID, keyringKey := range k.keys {
+		if string(keyringKey) == string(key) {
+			return keyID, true
+		}
+	}
+	return "", false
+}
+
+// Encrypt ciphers data with the current key and returns it with the ID of the key
+func (k *Keyring) Encrypt(data string) (string, string, error) {
+	encrypted, err := EncryptData(data, k.CurrentKey())
+	if err != nil {
+		return "", "", err
+	}
+	return encrypted, k.currentKeyID, nil
+}
+
+// Decrypt deciphers data trying the keys identified by keyIDs first and then every other key in the keyring
+func (k *Keyring) Decrypt(encodedData string, keyIDs ...string) (string, error) {
+	tried := make(map[string]bool, len(k.keys))
+	err := errors.New("no encryption key in the keyring")
+	for _, keyID := range append(keyIDs, k.sortedKeyIDs()...) {
+		key, ok := k.keys[keyID]
+		if !ok || tried[keyID] {
+			continue
+		}
+		tried[keyID] = true
+
+		var data string
+		data, err = DecryptData(encodedData, key)
+		if err == nil {
+			return data, nil
+		}
+	}
+	return "", err
+}
+
+// sortedKeyIDs returns the current key ID followed by the other key IDs in order
+func (k *Keyring) sortedKeyIDs() []string {
+	keyIDs := make([]string, 0, len(k.keys))
+	for keyID := range k.keys {
+		if keyID != k.currentKeyID {
+			keyIDs = append(keyIDs, keyID)
+		}
+	}
+	sort.Strings(keyIDs)
+	return append([]string{k.currentKeyID}, keyIDs...)
+}
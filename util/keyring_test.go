@@ -0,0 +1,115 @@
+package util_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+
+	. "github.com/topfreegames/khan/util"
+)
+
+var _ = Describe("Keyring", func() {
+	oldKey := []byte("00000000000000000000000000000000")
+	newKey := []byte("11111111111111111111111111111111")
+
+	var keyring *Keyring
+
+	BeforeEach(func() {
+		keyring = NewKeyring("new", map[string][]byte{
+			DefaultEncryptionKeyID: oldKey,
+			"new":                  newKey,
+		})
+	})
+
+	Describe("Encrypt", func() {
+		It("Should encrypt with the current key and return its ID", func() {
+			encrypted, keyID, err := keyring.Encrypt(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keyID).To(Equal("new"))
+
+			decrypted, err := DecryptData(encrypted, newKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(data))
+		})
+
+		It("Should return an error if the current key is not in the keyring", func() {
+			keyring = NewKeyring("missing", map[string][]byte{"new": newKey})
+			_, _, err := keyring.Encrypt(data)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Decrypt", func() {
+		It("Should decrypt with the given key ID", func() {
+			encrypted, err := EncryptData(data, oldKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			decrypted, err := keyring.Decrypt(encrypted, DefaultEncryptionKeyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(data))
+		})
+
+		It("Should fall back to the other keys", func() {
+			encrypted, err := EncryptData(data, oldKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			decrypted, err := keyring.Decrypt(encrypted, "unknown")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(data))
+		})
+
+		It("Should return an error if no key matches", func() {
+			encrypted, err := EncryptData(data, []byte("22222222222222222222222222222222"))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = keyring.Decrypt(encrypted)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("KeyID", func() {
+		It("Should return the ID of a key", func() {
+			keyID, ok := keyring.KeyID(oldKey)
+			Expect(ok).To(BeTrue())
+			Expect(keyID).To(Equal(DefaultEncryptionKeyID))
+
+			_, ok = keyring.KeyID([]byte("22222222222222222222222222222222"))
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("NewKeyringFromConfig", func() {
+		It("Should use security.encryptionKey as the default key", func() {
+			config := viper.New()
+			config.Set("security.encryptionKey", string(oldKey))
+
+			keyring, err := NewKeyringFromConfig(config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keyring.CurrentKeyID()).To(Equal(DefaultEncryptionKeyID))
+			Expect(keyring.CurrentKey()).To(Equal(oldKey))
+		})
+
+		It("Should load versioned keys", func() {
+			config := viper.New()
+			config.SetConfigType("yaml")
+			err := config.ReadConfig(strings.NewReader(`
+security:
+  encryptionKey: "00000000000000000000000000000000"
+  encryptionKeys:
+    "2026-10": "11111111111111111111111111111111"
+  currentEncryptionKeyID: "2026-10"
+`))
+			Expect(err).NotTo(HaveOccurred())
+
+			keyring, err := NewKeyringFromConfig(config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keyring.CurrentKeyID()).To(Equal("2026-10"))
+			Expect(keyring.CurrentKey()).To(Equal(newKey))
+			key, ok := keyring.Key(DefaultEncryptionKeyID)
+			Expect(ok).To(BeTrue())
+			Expect(key).To(Equal(oldKey))
+		})
+	})
+})
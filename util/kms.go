@@ -0,0 +1,137 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+// KMSClient is a client of a remote key management service that keeps the master keys
+type KMSClient interface {
+	// GenerateDataKey returns a new data key of size bytes and the data key wrapped by the master key keyID
+	GenerateDataKey(keyID string, size int) ([]byte, []byte, error)
+	// Decrypt returns the data key wrapped by the master key keyID
+	Decrypt(keyID string, wrappedDataKey []byte) ([]byte, error)
+}
+
+// KMSKeyProvider wraps data keys with master keys that never leave a remote key management service.
+// The master key passed to it is the key ID in the service. Unwrapped data keys are cached for a while,
+// so reading the same record again does not call the service
+type KMSKeyProvider struct {
+	Client KMSClient
+	cache  *gocache.Cache
+}
+
+// NewKMSKeyProvider returns a KMSKeyProvider that caches unwrapped data keys for cacheTTL.
+// A zero cacheTTL disables the cache
+func NewKMSKeyProvider(client KMSClient, cacheTTL time.Duration) *KMSKeyProvider {
+	provider := &KMSKeyProvider{Client: client}
+	if cacheTTL > 0 {
+		provider.cache = gocache.New(cacheTTL, cacheTTL)
+	}
+	return provider
+}
+
+// GenerateDataKey returns a new data key wrapped by the master key with ID masterKey
+func (p *KMSKeyProvider) GenerateDataKey(masterKey []byte) ([]byte, []byte, error) {
+	return p.Client.GenerateDataKey(string(masterKey), DataKeySize)
+}
+
+// UnwrapDataKey returns the data key wrapped by the master key with ID masterKey
+func (p *KMSKeyProvider) UnwrapDataKey(masterKey, wrappedDataKey []byte) ([]byte, error) {
+	cacheKey := fmt.Sprintf("%s/%s", masterKey, wrappedDataKey)
+	if p.cache != nil {
+		if dataKey, ok := p.cache.Get(cacheKey); ok {
+			return dataKey.([]byte), nil
+		}
+	}
+
+	dataKey, err := p.Client.Decrypt(string(masterKey), wrappedDataKey)
+	if err != nil {
+		return nil, err
+	}
+	if p.cache != nil {
+		p.cache.Set(cacheKey, dataKey, gocache.DefaultExpiration)
+	}
+	return dataKey, nil
+}
+
+// KMSGenerateDataKeyRequest is the body of the generateDataKey call of the KMS HTTP API
+type KMSGenerateDataKeyRequest struct {
+	KeyID         string `json:"keyId"`
+	NumberOfBytes int    `json:"numberOfBytes"`
+}
+
+// KMSGenerateDataKeyResponse is the response of the generateDataKey call of the KMS HTTP API
+type KMSGenerateDataKeyResponse struct {
+	Plaintext      []byte `json:"plaintext"`
+	CiphertextBlob []byte `json:"ciphertextBlob"`
+}
+
+// KMSDecryptRequest is the body of the decrypt call of the KMS HTTP API
+type KMSDecryptRequest struct {
+	KeyID          string `json:"keyId"`
+	CiphertextBlob []byte `json:"ciphertextBlob"`
+}
+
+// KMSDecryptResponse is the response of the decrypt call of the KMS HTTP API
+type KMSDecryptResponse struct {
+	Plaintext []byte `json:"plaintext"`
+}
+
+// HTTPKMSClient is a KMSClient that POSTs JSON to the generateDataKey and decrypt paths of endpoint
+type HTTPKMSClient struct {
+	Endpoint string
+	client   *http.Client
+}
+
+// NewHTTPKMSClient returns a new HTTPKMSClient
+func NewHTTPKMSClient(endpoint string, timeout time.Duration) *HTTPKMSClient {
+	return &HTTPKMSClient{
+		Endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// GenerateDataKey returns a new data key of size bytes and the data key wrapped by the master key keyID
+func (c *HTTPKMSClient) GenerateDataKey(keyID string, size int) ([]byte, []byte, error) {
+	var response KMSGenerateDataKeyResponse
+	err := c.post("generateDataKey", &KMSGenerateDataKeyRequest{KeyID: keyID, NumberOfBytes: size}, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Plaintext, response.CiphertextBlob, nil
+}
+
+// Decrypt returns the data key wrapped by the master key keyID
+func (c *HTTPKMSClient) Decrypt(keyID string, wrappedDataKey []byte) ([]byte, error) {
+	var response KMSDecryptResponse
+	err := c.post("decrypt", &KMSDecryptRequest{KeyID: keyID, CiphertextBlob: wrappedDataKey}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Plaintext, nil
+}
+
+func (c *HTTPKMSClient) post(path string, request, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.client.Post(fmt.Sprintf("%s/%s", c.Endpoint, path), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("kms %s failed with status %d", path, res.StatusCode)
+	}
+	return json.NewDecoder(res.Body).Decode(response)
+}
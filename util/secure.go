@@ -1,15 +1,25 @@
 package util
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/wildlife-studios/crypto"
 )
 
 //EncryptData is a func that use wildlife crypto module to cipher the data
-// the key must have 32 bytes length
+// the key must have 32 bytes length. With a key provider set, data is ciphered
+// with a new data key from the provider and key is the master key
 func EncryptData(data string, key []byte) (string, error) {
+	if keyProvider != nil {
+		return encryptEnvelope(data, key)
+	}
+
 	if len(key) != 32 {
 		return "", &TokenSizeError{Msg: "The key length is different than 32"}
 	}
@@ -26,8 +36,13 @@ func EncryptData(data string, key []byte) (string, error) {
 }
 
 //DecryptData is a func that use wildlife crypto to decipher the data
-// the key must have 32 bytes length
+// the key must have 32 bytes length. Data ciphered with a data key is
+// deciphered with the data key unwrapped by the key provider
 func DecryptData(encodedData string, key []byte) (string, error) {
+	if strings.HasPrefix(encodedData, EnvelopePrefix) {
+		return decryptEnvelope(encodedData, key)
+	}
+
 	if len(key) != 32 {
 		return "", &TokenSizeError{Msg: "The key length is different than 32"}
 	}
@@ -45,3 +60,27 @@ func DecryptData(encodedData string, key []byte) (string, error) {
 
 	return fmt.Sprintf("%s", data), nil
 }
+
+//HashData returns the hex encoded HMAC-SHA256 of data keyed with key. Unlike EncryptData,
+// equal data always hashes to the same value, so hashes can be compared by the database
+func HashData(data string, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+//GenerateToken returns a hex encoded random token with size random bytes
+func GenerateToken(size int) (string, error) {
+	token := make([]byte, size)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+//HashToken returns the hex encoded SHA-256 of a random token. Random tokens are
+// long enough not to be guessed, so they are hashed without a key, unlike HashData
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
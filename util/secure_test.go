@@ -92,4 +92,35 @@ var _ = Describe("Security package", func() {
 			Expect(err.Error()).To(Equal("The key length is different than 32"))
 		})
 	})
+
+	Describe("HashData", func() {
+		It("Should return the same hash for the same data and key", func() {
+			Expect(HashData(data, encryptionKey)).To(Equal(HashData(data, encryptionKey)))
+			Expect(HashData(data, encryptionKey)).To(HaveLen(64))
+		})
+
+		It("Should return different hashes for different keys", func() {
+			Expect(HashData(data, encryptionKey[:32])).NotTo(Equal(HashData(data, encryptionKey[1:])))
+		})
+	})
+
+	Describe("GenerateToken", func() {
+		It("Should return random tokens with the given size", func() {
+			token, err := GenerateToken(32)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(HaveLen(64))
+
+			otherToken, err := GenerateToken(32)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherToken).NotTo(Equal(token))
+		})
+	})
+
+	Describe("HashToken", func() {
+		It("Should return the same hash for the same token", func() {
+			Expect(HashToken(data)).To(Equal(HashToken(data)))
+			Expect(HashToken(data)).To(HaveLen(64))
+			Expect(HashToken(data)).NotTo(Equal(HashToken(data + "x")))
+		})
+	})
 })